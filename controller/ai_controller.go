@@ -2,11 +2,14 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -16,7 +19,9 @@ import (
 
 // AIController - AI 관련 컨트롤러
 type AIController struct {
-	aiService *service.AIService
+	aiService   *service.AIService
+	gitService  *service.GitService
+	kubeService *service.KubeService
 }
 
 // NewAIController - AI 컨트롤러 생성자
@@ -29,8 +34,15 @@ func NewAIController() *AIController {
 
 	log.Printf("🤖 DeepSeek 서버 URL: %s", deepseekURL)
 
+	return NewAIControllerWithServices(service.NewAIService(deepseekURL), service.NewGitService(), service.NewKubeService())
+}
+
+// NewAIControllerWithServices - 지정한 서비스를 사용하는 AI 컨트롤러 생성자 (테스트에서 가짜 실행기 주입)
+func NewAIControllerWithServices(aiService *service.AIService, gitService *service.GitService, kubeService *service.KubeService) *AIController {
 	return &AIController{
-		aiService: service.NewAIService(deepseekURL),
+		aiService:   aiService,
+		gitService:  gitService,
+		kubeService: kubeService,
 	}
 }
 
@@ -50,7 +62,7 @@ func (ac *AIController) GenerateYaml(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response, err := ac.aiService.GenerateKubernetesYaml(request)
+	response, err := ac.aiService.GenerateKubernetesYaml(r.Context(), request)
 	if err != nil {
 		http.Error(w, "AI YAML 생성 실패: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -178,13 +190,39 @@ func (ac *AIController) buildSecretPrompt(params map[string]interface{}) string
 	if secretType, ok := params["type"].(string); ok {
 		prompt += "- Type: " + secretType + "\n"
 	}
+	// 값은 모델에 보내지 않고 키 이름만 전달 (실제 값은 POST /api/secrets 로 서버에서 인코딩)
 	if data, ok := params["data"].(map[string]interface{}); ok {
-		prompt += "- Data (base64 encoded): " + mapToString(data) + "\n"
+		keys := make([]string, 0, len(data))
+		for key := range data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		prompt += "- Data keys: " + strings.Join(keys, ", ") + "\n"
+		prompt += "- Leave every data value as an empty string \"\" placeholder; do not invent or encode values\n"
 	}
 
 	return prompt
 }
 
+// redactTemplateParameters - Secret 템플릿 응답에서 data 값 가리기
+func redactTemplateParameters(templateType string, params map[string]interface{}) map[string]interface{} {
+	data, ok := params["data"].(map[string]interface{})
+	if !strings.EqualFold(templateType, "secret") || !ok {
+		return params
+	}
+
+	redacted := make(map[string]interface{}, len(params))
+	for key, value := range params {
+		redacted[key] = value
+	}
+	redactedData := make(map[string]interface{}, len(data))
+	for key := range data {
+		redactedData[key] = "<redacted>"
+	}
+	redacted["data"] = redactedData
+	return redacted
+}
+
 // buildIngressPrompt - Ingress 템플릿 프롬프트
 func (ac *AIController) buildIngressPrompt(params map[string]interface{}) string {
 	prompt := "Create a Kubernetes Ingress YAML with:\n"
@@ -208,6 +246,30 @@ func (ac *AIController) buildIngressPrompt(params map[string]interface{}) string
 	return prompt
 }
 
+// substituteTemplateParameters - 문자열 파라미터의 ${VAR} 치환 (환경/변수가 없으면 nil 반환)
+func (ac *AIController) substituteTemplateParameters(variableService *service.VariableService, request *model.AITemplateRequest) (map[string]string, error) {
+	if request.Environment == "" && len(request.Variables) == 0 {
+		return nil, nil
+	}
+
+	resolvedVariables := make(map[string]string)
+	for key, value := range request.Parameters {
+		text, ok := value.(string)
+		if !ok {
+			continue
+		}
+		substituted, used, err := variableService.Apply(text, request.Environment, request.Variables)
+		if err != nil {
+			return nil, err
+		}
+		request.Parameters[key] = substituted
+		for name, usedValue := range used {
+			resolvedVariables[name] = usedValue
+		}
+	}
+	return resolvedVariables, nil
+}
+
 // 유틸리티 함수들
 func (ac *AIController) parametersToString(params map[string]interface{}) string {
 	result := ""
@@ -355,10 +417,7 @@ func (ac *AIController) GetAIExamples(w http.ResponseWriter, r *http.Request) {
 func (ac *AIController) ValidateYaml(w http.ResponseWriter, r *http.Request) {
 	log.Println("✅ POST /api/ai/validate - AI YAML 검증 요청")
 
-	var request struct {
-		YamlContent string `json:"yamlContent" binding:"required"`
-	}
-
+	var request model.SchemaValidateRequest
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
 		return
@@ -369,31 +428,29 @@ func (ac *AIController) ValidateYaml(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// YAML 유효성 검증
-	kubeService := service.NewKubeService()
-	err := kubeService.ValidateYaml(request.YamlContent)
+	// YAML 구문 및 스키마 검증
+	result, err := ac.kubeService.ValidateSchema(request.YamlContent, request.KubernetesVersion, request.CRDContent)
 
-	var response map[string]interface{}
+	var response model.SchemaValidationResponse
 	if err != nil {
-		response = map[string]interface{}{
-			"success": false,
-			"message": "YAML 검증 실패: " + err.Error(),
-			"data": map[string]interface{}{
-				"isValid":     false,
-				"errorDetail": err.Error(),
-				"checkedTime": time.Now().Format("2006-01-02 15:04:05"),
-			},
+		// 구문 오류 또는 지원하지 않는 버전
+		response.Success = false
+		response.Message = "YAML 검증 실패: " + err.Error()
+		response.Data = model.SchemaValidationResult{
+			KubernetesVersion: request.KubernetesVersion,
+			IsValid:           false,
+			Errors:            []model.SchemaValidationError{{Message: err.Error()}},
+			Warnings:          []model.SchemaValidationError{},
+			CheckedTime:       time.Now().Format("2006-01-02 15:04:05"),
 		}
+	} else if !result.IsValid {
+		response.Success = false
+		response.Message = fmt.Sprintf("YAML 검증 실패: 스키마 오류 %d건", len(result.Errors))
+		response.Data = *result
 	} else {
-		response = map[string]interface{}{
-			"success": true,
-			"message": "YAML 검증 성공",
-			"data": map[string]interface{}{
-				"isValid":     true,
-				"errorDetail": "",
-				"checkedTime": time.Now().Format("2006-01-02 15:04:05"),
-			},
-		}
+		response.Success = true
+		response.Message = "YAML 검증 성공"
+		response.Data = *result
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -401,6 +458,24 @@ func (ac *AIController) ValidateYaml(w http.ResponseWriter, r *http.Request) {
 
 }
 
+// writeSchemaValidationFailure - 스키마 검증 실패 에러면 422 응답을 쓰고 true 반환
+func writeSchemaValidationFailure(w http.ResponseWriter, err error) bool {
+	var failure *service.SchemaValidationFailedError
+	if !errors.As(err, &failure) {
+		return false
+	}
+
+	response := model.SchemaValidationResponse{}
+	response.Success = false
+	response.Message = failure.Error()
+	response.Data = *failure.Result
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	json.NewEncoder(w).Encode(response)
+	return true
+}
+
 func (ac *AIController) GenerateAndApplyEnhanced(w http.ResponseWriter, r *http.Request) {
 	log.Println("🚀 POST /api/ai/generate-apply - AI YAML 생성 및 적용 요청 (Git 지원)")
 
@@ -416,28 +491,19 @@ func (ac *AIController) GenerateAndApplyEnhanced(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// 🆕 Git 관련 키워드 감지
-	gitKeywords := []string{"레포지토리", "레포", "repository", "repo", "github", "gitlab", "bitbucket", "git"}
-	isGitRelated := false
-	lowerPrompt := strings.ToLower(request.Prompt)
-
-	for _, keyword := range gitKeywords {
-		if strings.Contains(lowerPrompt, keyword) {
-			isGitRelated = true
-			break
-		}
-	}
-
 	// Git 관련 요청이면 Git 컨트롤러로 리다이렉트
-	if isGitRelated {
+	if isGitRelatedPrompt(request.Prompt) {
 		log.Printf("🔄 Git 관련 요청 감지, Git 처리로 전환: %s", request.Prompt)
 		ac.handleGitRelatedPrompt(w, r, request)
 		return
 	}
 
 	// 기존 로직 유지 (Git이 아닌 일반 AI 처리)
-	response, err := ac.aiService.GenerateAndApplyYaml(request)
+	response, err := ac.aiService.GenerateAndApplyYaml(r.Context(), request)
 	if err != nil {
+		if writeCommandTimeout(w, err) || writeSchemaValidationFailure(w, err) || writePolicyViolation(w, err) || writePermissionDenied(w, err) {
+			return
+		}
 		http.Error(w, "AI YAML 생성 및 적용 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -446,12 +512,25 @@ func (ac *AIController) GenerateAndApplyEnhanced(w http.ResponseWriter, r *http.
 	json.NewEncoder(w).Encode(response)
 }
 
+// isGitRelatedPrompt - 🆕 Git 관련 키워드 감지
+func isGitRelatedPrompt(prompt string) bool {
+	gitKeywords := []string{"레포지토리", "레포", "repository", "repo", "github", "gitlab", "bitbucket", "git"}
+	lowerPrompt := strings.ToLower(prompt)
+
+	for _, keyword := range gitKeywords {
+		if strings.Contains(lowerPrompt, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
 // handleGitRelatedPrompt - Git 관련 프롬프트 처리
 func (ac *AIController) handleGitRelatedPrompt(w http.ResponseWriter, r *http.Request, request model.AIApplyRequest) {
 	log.Printf("📦 Git 관련 AI 프롬프트 처리: %s", request.Prompt)
 
 	// Git 프롬프트 파싱을 위한 AI 요청
-	parseResult, err := ac.parseGitPromptWithAI(request.Prompt)
+	parseResult, err := ac.parseGitPromptWithAI(r.Context(), request.Prompt)
 	if err != nil {
 		http.Error(w, "Git 프롬프트 파싱 실패: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -463,33 +542,40 @@ func (ac *AIController) handleGitRelatedPrompt(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// Git 서비스 생성
-	gitService := service.NewGitService()
-	defer gitService.CleanupAll() // 함수 종료 시 정리
+	defer ac.gitService.CleanupAll() // 함수 종료 시 정리
 
 	// Git 레포지토리 클론
-	repoDir, err := gitService.CloneRepository(parseResult.RepoURL, parseResult.Branch)
+	repoDir, err := ac.gitService.CloneRepository(r.Context(), parseResult.RepoURL, parseResult.Branch)
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "Git 레포지토리 클론 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
-	defer gitService.Cleanup(repoDir)
+	defer ac.gitService.Cleanup(repoDir)
 
 	var yamlFiles []model.GitYamlFile
 
 	// 파일 검색
 	if parseResult.Filename != "" {
 		// 특정 파일 검색
-		yamlFile, err := gitService.GetSpecificYamlFile(repoDir, parseResult.Filename)
+		yamlFile, err := ac.gitService.GetSpecificYamlFile(r.Context(), repoDir, parseResult.Filename)
 		if err != nil {
+			if writeCommandTimeout(w, err) {
+				return
+			}
 			http.Error(w, "파일 검색 실패: "+err.Error(), http.StatusNotFound)
 			return
 		}
 		yamlFiles = append(yamlFiles, *yamlFile)
 	} else {
-		// 모든 YAML 파일 검색
-		foundFiles, err := gitService.FindYamlFiles(repoDir)
+		// 모든 YAML 파일 검색 (kustomization은 빌드 결과를 적용)
+		foundFiles, _, err := ac.gitService.FindManifests(r.Context(), repoDir, "", true)
 		if err != nil {
+			if writeCommandTimeout(w, err) {
+				return
+			}
 			http.Error(w, "YAML 파일 검색 실패: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -502,8 +588,11 @@ func (ac *AIController) handleGitRelatedPrompt(w http.ResponseWriter, r *http.Re
 	}
 
 	// YAML 파일들 적용
-	applyResult, err := gitService.ApplyYamlFromGit(yamlFiles, parseResult.Namespace, parseResult.DryRun || request.DryRun)
+	applyResult, err := ac.gitService.ApplyYamlFromGit(r.Context(), yamlFiles, parseResult.Namespace, parseResult.DryRun || request.DryRun, "", nil)
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "YAML 적용 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -511,7 +600,7 @@ func (ac *AIController) handleGitRelatedPrompt(w http.ResponseWriter, r *http.Re
 	// AI 분석 추가 (선택적)
 	var aiAnalysis *model.AIYamlResponse
 	if len(yamlFiles) > 0 {
-		aiAnalysis, _ = ac.aiService.GenerateGitYamlWithAI(yamlFiles, "apply")
+		aiAnalysis, _ = ac.aiService.GenerateGitYamlWithAI(r.Context(), yamlFiles, "apply")
 	}
 
 	// 응답 구성 (Git + AI 결합)
@@ -540,7 +629,7 @@ func (ac *AIController) handleGitRelatedPrompt(w http.ResponseWriter, r *http.Re
 }
 
 // parseGitPromptWithAI - AI를 통한 Git 프롬프트 파싱
-func (ac *AIController) parseGitPromptWithAI(prompt string) (*model.GitParseResult, error) {
+func (ac *AIController) parseGitPromptWithAI(ctx context.Context, prompt string) (*model.GitParseResult, error) {
 	systemPrompt := `You are a Git repository parser. Extract information from user prompts about Git repositories and Kubernetes operations.
 
 IMPORTANT: Return ONLY a valid JSON object, no markdown formatting, no code blocks, no explanations.
@@ -576,7 +665,7 @@ Example responses:
 	}
 
 	// AI API 호출
-	response, err := ac.aiService.CallDeepSeekAPI(aiRequest)
+	response, err := ac.aiService.CallDeepSeekAPI(ctx, aiRequest)
 	if err != nil {
 		return nil, fmt.Errorf("AI API 호출 실패: %v", err)
 	}
@@ -811,7 +900,7 @@ func (ac *AIController) ProcessGitCommand(w http.ResponseWriter, r *http.Request
 	}
 
 	// AI를 통한 Git 프롬프트 처리
-	gitResponse, err := ac.aiService.ProcessGitPrompt(request.Prompt)
+	gitResponse, err := ac.aiService.ProcessGitPrompt(r.Context(), request.Prompt)
 	if err != nil {
 		http.Error(w, "Git 프롬프트 처리 실패: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -837,8 +926,11 @@ func (ac *AIController) QueryAI(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response, err := ac.aiService.QueryKubernetesAI(request)
+	response, err := ac.aiService.QueryKubernetesAI(r.Context(), request)
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "AI 질문 처리 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -908,15 +1000,33 @@ func (ac *AIController) GenerateTemplate(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// Secret 템플릿은 값을 모델에 보내지 않으므로 생성된 YAML의 data가 비어 있음 (적용하면 기존 값을 빈 값으로 덮어씀)
+	if strings.EqualFold(request.TemplateType, "secret") && !request.DryRun && request.Parameters["apply"] == true {
+		http.Error(w, "Secret 템플릿은 바로 적용할 수 없습니다. 값을 포함한 Secret은 POST /api/secrets 로 생성하세요", http.StatusBadRequest)
+		return
+	}
+
+	// 파라미터의 ${VAR} 치환 (환경/변수가 지정된 경우)
+	variableService := service.NewVariableService()
+	resolvedVariables, err := ac.substituteTemplateParameters(variableService, &request)
+	if err != nil {
+		if writeUndefinedVariables(w, err) {
+			return
+		}
+		http.Error(w, "템플릿 변수 치환 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	// 템플릿별 프롬프트 생성
 	prompt := ac.buildTemplatePrompt(request)
 
 	// AI YAML 생성 요청
 	yamlRequest := model.AIYamlRequest{
-		Prompt: prompt,
+		Prompt:            prompt,
+		KubernetesVersion: request.KubernetesVersion,
 	}
 
-	yamlResponse, err := ac.aiService.GenerateKubernetesYaml(yamlRequest)
+	yamlResponse, err := ac.aiService.GenerateKubernetesYaml(r.Context(), yamlRequest)
 	if err != nil {
 		http.Error(w, "템플릿 기반 YAML 생성 실패: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -930,24 +1040,57 @@ func (ac *AIController) GenerateTemplate(w http.ResponseWriter, r *http.Request)
 		},
 		Data: model.AITemplateResult{
 			TemplateType:  request.TemplateType,
-			Parameters:    request.Parameters,
+			Parameters:    redactTemplateParameters(request.TemplateType, request.Parameters),
 			GeneratedYaml: yamlResponse.Data.GeneratedYaml,
 			GeneratedTime: yamlResponse.Data.GeneratedTime,
 			Source:        yamlResponse.Data.Source,
+			Validation:    yamlResponse.Data.Validation,
 		},
 	}
 
+	// 생성된 YAML에 남아있는 ${VAR}도 치환
+	if resolvedVariables != nil {
+		generatedYaml, used, err := variableService.Apply(yamlResponse.Data.GeneratedYaml, request.Environment, request.Variables)
+		if err != nil {
+			if writeUndefinedVariables(w, err) {
+				return
+			}
+			http.Error(w, "템플릿 변수 치환 실패: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		for name, value := range used {
+			resolvedVariables[name] = value
+		}
+		response.Data.GeneratedYaml = generatedYaml
+		response.Data.Environment = request.Environment
+		response.Data.ResolvedVariables = resolvedVariables
+	}
+
 	// 즉시 적용이 요청된 경우
 	if !request.DryRun && request.Parameters["apply"] == true {
+		// 치환이 끝난 최종 YAML을 적용 전에 스키마 검증
+		validation, err := ac.kubeService.ValidateSchema(response.Data.GeneratedYaml, request.KubernetesVersion, "")
+		if err != nil {
+			http.Error(w, "템플릿 YAML 검증 실패: "+err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		response.Data.Validation = validation
+		if !validation.IsValid {
+			writeSchemaValidationFailure(w, &service.SchemaValidationFailedError{Result: validation})
+			return
+		}
+
 		applyRequest := model.ApplyYamlRequest{
-			YamlContent: yamlResponse.Data.GeneratedYaml,
+			YamlContent: response.Data.GeneratedYaml,
 			Namespace:   request.Namespace,
 			DryRun:      false,
 		}
 
-		kubeService := service.NewKubeService()
-		applyResult, err := kubeService.ApplyYaml(applyRequest)
+		applyResult, err := ac.kubeService.ApplyYaml(r.Context(), applyRequest)
 		if err != nil {
+			if writePolicyViolation(w, err) || writePermissionDenied(w, err) {
+				return
+			}
 			log.Printf("⚠️ 템플릿 YAML 적용 실패: %v", err)
 		} else {
 			response.Data.ApplyResult = applyResult
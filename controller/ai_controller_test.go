@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"mykubeapp/model"
+)
+
+func TestGenerateAndApply(t *testing.T) {
+	env := newTestEnv(t)
+	env.llm.reply("Here is the manifest:\n```yaml\n" + testDeploymentYaml + "```")
+	env.executor.
+		On("kubectl config current-context", "dev\n").
+		On("kubectl apply", "deployment.apps/web created\n")
+	onPermissionCheck(t, env.executor, "")
+
+	recorder := env.do(t, http.MethodPost, "/api/ai/generate-apply", model.AIApplyRequest{Prompt: "nginx 디플로이먼트 2개 만들어줘", Namespace: "demo"})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.AIApplyResponse
+	decodeResponse(t, recorder, &response)
+	if !strings.HasPrefix(response.Data.GeneratedYaml, "apiVersion: apps/v1") {
+		t.Fatalf("설명과 코드 블록이 제거되어야 합니다:\n%s", response.Data.GeneratedYaml)
+	}
+	if response.Data.Validation == nil || !response.Data.Validation.IsValid {
+		t.Fatalf("스키마 검증 결과가 유효해야 합니다: %+v", response.Data.Validation)
+	}
+	if !reflect.DeepEqual(response.Data.ApplyResult.Resources, []string{"deployment.apps/web"}) {
+		t.Fatalf("적용 리소스 = %v", response.Data.ApplyResult.Resources)
+	}
+}
+
+func TestGenerateAndApplySchemaFailure(t *testing.T) {
+	env := newTestEnv(t)
+	env.llm.reply(strings.Replace(testDeploymentYaml, "replicas: 2", "replicas: two", 1))
+
+	recorder := env.do(t, http.MethodPost, "/api/ai/generate-apply", model.AIApplyRequest{Prompt: "nginx 디플로이먼트 만들어줘"})
+	expectStatus(t, recorder, http.StatusUnprocessableEntity)
+	expectNoCalls(t, env.executor, "kubectl apply")
+}
+
+func TestGenerateAndApplyModelFailure(t *testing.T) {
+	env := newTestEnv(t)
+
+	recorder := env.do(t, http.MethodPost, "/api/ai/generate-apply", model.AIApplyRequest{Prompt: "nginx 디플로이먼트 만들어줘"})
+	expectStatus(t, recorder, http.StatusInternalServerError)
+	if len(env.executor.Calls()) != 0 {
+		t.Fatalf("AI 호출 실패 시 명령이 실행되지 않아야 합니다")
+	}
+}
+
+func TestGenerateAndApplyRequiresPrompt(t *testing.T) {
+	env := newTestEnv(t)
+
+	recorder := env.do(t, http.MethodPost, "/api/ai/generate-apply", model.AIApplyRequest{Prompt: " "})
+	expectStatus(t, recorder, http.StatusBadRequest)
+}
+
+func TestGenerateAndApplyDeletePrompt(t *testing.T) {
+	env := newTestEnv(t)
+	env.llm.reply("service/web\ndeployment/web\n")
+	env.executor.On("kubectl delete", "deleted\n")
+
+	recorder := env.do(t, http.MethodPost, "/api/ai/generate-apply", model.AIApplyRequest{Prompt: "web 서비스와 디플로이먼트 삭제", Namespace: "demo"})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var commands []string
+	for _, call := range env.executor.CallsTo("kubectl delete") {
+		commands = append(commands, call.Command())
+	}
+	expected := []string{"kubectl delete service/web -n demo", "kubectl delete deployment/web -n demo"}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Fatalf("삭제 명령 = %v, 기대값 %v", commands, expected)
+	}
+}
+
+func TestGenerateAndApplyGitPrompt(t *testing.T) {
+	env := newTestEnv(t)
+	env.llm.reply(`{"repoUrl": "github.com/example/demo", "branch": "main", "filename": "deployment.yaml", "action": "apply", "dryRun": true, "namespace": "demo", "confidence": 0.9}`)
+	onClone(env.executor, map[string]string{"k8s/deployment.yaml": testDeploymentYaml})
+	env.executor.
+		On("kubectl config current-context", "dev\n").
+		On("kubectl apply", "deployment.apps/web created (dry run)\n")
+	onPermissionCheck(t, env.executor, "")
+
+	recorder := env.do(t, http.MethodPost, "/api/ai/generate-apply", model.AIApplyRequest{Prompt: "github.com/example/demo 레포의 deployment.yaml 적용해줘"})
+	expectStatus(t, recorder, http.StatusOK)
+
+	clone := env.executor.CallsTo("git clone")
+	if len(clone) != 1 || !strings.Contains(clone[0].Command(), "https://github.com/example/demo.git") {
+		t.Fatalf("정규화된 URL로 클론해야 합니다: %v", clone)
+	}
+	apply := env.executor.CallsTo("kubectl apply")
+	if len(apply) != 1 || !strings.Contains(apply[0].Command(), "--dry-run=client") || !strings.Contains(apply[0].Command(), "-n demo") {
+		t.Fatalf("AI가 파싱한 옵션으로 적용해야 합니다: %v", apply)
+	}
+}
+
+func TestValidateYaml(t *testing.T) {
+	env := newTestEnv(t)
+
+	recorder := env.do(t, http.MethodPost, "/api/ai/validate", model.SchemaValidateRequest{YamlContent: testDeploymentYaml})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.SchemaValidationResponse
+	decodeResponse(t, recorder, &response)
+	if !response.Success || !response.Data.IsValid {
+		t.Fatalf("유효한 YAML이 실패로 판정되었습니다: %+v", response.Data.Errors)
+	}
+	if len(env.executor.Calls()) != 0 {
+		t.Fatalf("스키마 검증은 클러스터 명령을 실행하지 않아야 합니다")
+	}
+
+	// 필드 오타는 부분 검증이어도 실패로 판정
+	recorder = env.do(t, http.MethodPost, "/api/ai/validate", model.SchemaValidateRequest{YamlContent: strings.Replace(testDeploymentYaml, "replicas: 2", `replica: "three"`, 1)})
+	expectStatus(t, recorder, http.StatusOK)
+	response = model.SchemaValidationResponse{}
+	decodeResponse(t, recorder, &response)
+	if response.Success || response.Data.IsValid || !response.Data.Partial {
+		t.Fatalf("spec.replica 오타가 통과되었습니다: %+v", response.Data)
+	}
+}
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// ConfigMapController - 설정 파일로 ConfigMap을 생성하는 컨트롤러
+type ConfigMapController struct {
+	configMapService *service.ConfigMapService
+}
+
+// NewConfigMapController - ConfigMap 컨트롤러 생성자
+func NewConfigMapController() *ConfigMapController {
+	return NewConfigMapControllerWithService(service.NewConfigMapService())
+}
+
+// NewConfigMapControllerWithService - 지정한 서비스를 사용하는 컨트롤러 생성자 (테스트에서 가짜 실행기 주입)
+func NewConfigMapControllerWithService(configMapService *service.ConfigMapService) *ConfigMapController {
+	return &ConfigMapController{
+		configMapService: configMapService,
+	}
+}
+
+// UploadConfigMap - 업로드한 설정 파일로 ConfigMap 생성 (POST /api/configmaps/upload)
+// multipart 필드: name, namespace, context, apply, dryRun, rollout, file(여러 개, 파일 이름이 키)
+func (cc *ConfigMapController) UploadConfigMap(w http.ResponseWriter, r *http.Request) {
+	log.Println("🗂️ POST /api/configmaps/upload - 업로드 파일로 ConfigMap 생성 요청")
+
+	request, files, err := parseConfigMapUpload(r)
+	if err != nil {
+		http.Error(w, "업로드 처리 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cc.createConfigMap(w, r, request, files, "")
+}
+
+// CreateConfigMapFromGit - Git 레포지토리의 파일/디렉토리로 ConfigMap 생성 (POST /api/configmaps/git)
+func (cc *ConfigMapController) CreateConfigMapFromGit(w http.ResponseWriter, r *http.Request) {
+	log.Println("🗂️ POST /api/configmaps/git - Git 경로로 ConfigMap 생성 요청")
+
+	var request model.ConfigMapGitRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	files, source, err := cc.configMapService.FilesFromGit(r.Context(), request)
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "Git 파일 읽기 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cc.createConfigMap(w, r, request.ConfigMapRequest, files, source)
+}
+
+// createConfigMap - ConfigMap 생성 후 (apply=true면) 적용 및 의존 Deployment 어노테이션
+func (cc *ConfigMapController) createConfigMap(w http.ResponseWriter, r *http.Request, request model.ConfigMapRequest, files map[string][]byte, source string) {
+	result, err := cc.configMapService.BuildConfigMap(request, files)
+	if err != nil {
+		http.Error(w, "ConfigMap 생성 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	result.Source = source
+
+	message := fmt.Sprintf("ConfigMap 생성 완료 (%s/%s, 키 %d개)", result.Namespace, result.Name, len(result.Keys)+len(result.BinaryKeys))
+	if request.Apply {
+		if err := cc.configMapService.ApplyConfigMap(r.Context(), request, result); err != nil {
+			if writeCommandTimeout(w, err) || writePolicyViolation(w, err) || writePermissionDenied(w, err) {
+				return
+			}
+			http.Error(w, "ConfigMap 적용 실패: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if request.DryRun {
+			message = fmt.Sprintf("ConfigMap dry-run 완료 (%s/%s, Deployment %d개)", result.Namespace, result.Name, len(result.Rollouts))
+		} else {
+			message = fmt.Sprintf("ConfigMap 적용 완료 (%s/%s, Deployment %d개)", result.Namespace, result.Name, len(result.Rollouts))
+		}
+	}
+
+	response := model.ConfigMapResponse{}
+	response.Success = true
+	response.Message = message
+	response.Data = *result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// parseConfigMapUpload - multipart 폼을 ConfigMap 요청과 파일 목록으로 변환
+func parseConfigMapUpload(r *http.Request) (model.ConfigMapRequest, map[string][]byte, error) {
+	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+		return model.ConfigMapRequest{}, nil, fmt.Errorf("multipart 요청 파싱 실패: %v", err)
+	}
+
+	apply, _ := strconv.ParseBool(r.FormValue("apply"))
+	dryRun, _ := strconv.ParseBool(r.FormValue("dryRun"))
+	rollout, _ := strconv.ParseBool(r.FormValue("rollout"))
+	request := model.ConfigMapRequest{
+		Name:      r.FormValue("name"),
+		Namespace: r.FormValue("namespace"),
+		Context:   r.FormValue("context"),
+		Apply:     apply,
+		DryRun:    dryRun,
+		Rollout:   rollout,
+	}
+
+	files := map[string][]byte{}
+	for _, header := range r.MultipartForm.File["file"] {
+		key := filepath.Base(header.Filename)
+		if _, exists := files[key]; exists {
+			return request, nil, fmt.Errorf("중복된 파일 이름입니다: %s", key)
+		}
+		content, err := readUploadedFile(header)
+		if err != nil {
+			return request, nil, err
+		}
+		files[key] = content
+	}
+	return request, files, nil
+}
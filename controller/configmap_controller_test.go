@@ -0,0 +1,208 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/base64"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+)
+
+// testConsumerDeployments - ConfigMap "app-config"를 볼륨/envFrom으로 참조하는 Deployment와 무관한 Deployment
+const testConsumerDeployments = `{"items": [
+  {"metadata": {"name": "web"}, "spec": {"template": {"spec": {
+    "volumes": [{"name": "config", "configMap": {"name": "app-config"}}],
+    "containers": [{"name": "web"}]}}}},
+  {"metadata": {"name": "worker"}, "spec": {"template": {"spec": {
+    "containers": [{"name": "worker", "envFrom": [{"configMapRef": {"name": "app-config"}}]}]}}}},
+  {"metadata": {"name": "cache"}, "spec": {"template": {"spec": {
+    "containers": [{"name": "cache", "envFrom": [{"configMapRef": {"name": "cache-config"}}]}]}}}}
+]}`
+
+// appliedConfigMap - kubectl apply 시 임시 파일의 ConfigMap
+type appliedConfigMap struct {
+	Data       map[string]string `yaml:"data"`
+	BinaryData map[string]string `yaml:"binaryData"`
+}
+
+// uploadConfigMap - multipart 요청으로 ConfigMap 업로드
+func uploadConfigMap(t *testing.T, env *testEnv, fields map[string]string, files map[string][]byte) *httptest.ResponseRecorder {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for key, value := range fields {
+		writer.WriteField(key, value)
+	}
+	for name, content := range files {
+		part, _ := writer.CreateFormFile("file", name)
+		part.Write(content)
+	}
+	writer.Close()
+
+	request := httptest.NewRequest(http.MethodPost, "/api/configmaps/upload", &body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	recorder := httptest.NewRecorder()
+	env.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestUploadConfigMapSplitsBinaryData(t *testing.T) {
+	env := newTestEnv(t)
+	binary := []byte{0x89, 'P', 'N', 'G', 0xff, 0x00}
+
+	recorder := uploadConfigMap(t, env, map[string]string{"name": "app-config", "namespace": "demo"}, map[string][]byte{
+		"application.yml": []byte("server:\n  port: 8080\n"),
+		"logo.png":        binary,
+	})
+	expectStatus(t, recorder, http.StatusOK)
+	expectNoCalls(t, env.executor, "kubectl apply")
+
+	var response model.ConfigMapResponse
+	decodeResponse(t, recorder, &response)
+	data := response.Data
+	if !reflect.DeepEqual(data.Keys, []string{"application.yml"}) || !reflect.DeepEqual(data.BinaryKeys, []string{"logo.png"}) {
+		t.Fatalf("키 분류 = %v / %v", data.Keys, data.BinaryKeys)
+	}
+	if len(data.Hash) != 64 || data.HashAnnotation != "checksum.mykubeapp.io/app-config" {
+		t.Fatalf("해시 정보 = %s %s", data.Hash, data.HashAnnotation)
+	}
+
+	var manifest appliedConfigMap
+	if err := yaml.Unmarshal([]byte(data.YamlContent), &manifest); err != nil {
+		t.Fatalf("매니페스트 파싱 실패: %v", err)
+	}
+	if manifest.Data["application.yml"] != "server:\n  port: 8080\n" || manifest.BinaryData["logo.png"] != base64.StdEncoding.EncodeToString(binary) {
+		t.Fatalf("매니페스트 = %s", data.YamlContent)
+	}
+}
+
+func TestUploadConfigMapApplyAndRollout(t *testing.T) {
+	env := newTestEnv(t)
+	onPermissionCheck(t, env.executor, "")
+	applies := onManifest(t, env.executor, "kubectl apply", nil)
+	env.executor.On("kubectl get --raw /apis/apps/v1/namespaces/demo/deployments", testConsumerDeployments)
+
+	recorder := uploadConfigMap(t, env, map[string]string{"name": "app-config", "namespace": "demo", "apply": "true", "rollout": "true"}, map[string][]byte{
+		"application.yml": []byte("server:\n  port: 8080\n"),
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.ConfigMapResponse
+	decodeResponse(t, recorder, &response)
+	var applied appliedConfigMap
+	decodeManifest(t, applies.last(t), &applied)
+	if applied.Data["application.yml"] == "" || response.Data.ApplyResult == nil {
+		t.Fatalf("ConfigMap이 적용되어야 합니다: %+v", response.Data)
+	}
+	if len(response.Data.Rollouts) != 2 {
+		t.Fatalf("참조하는 Deployment만 어노테이션되어야 합니다: %+v", response.Data.Rollouts)
+	}
+
+	patches := env.executor.CallsTo("kubectl patch deployments.apps")
+	if len(patches) != 2 {
+		t.Fatalf("patch 호출 = %d, 기대값 2", len(patches))
+	}
+	for _, call := range patches {
+		args := strings.Join(call.Args, " ")
+		if strings.Contains(args, "cache") || !strings.HasSuffix(args, "-n demo") || !strings.Contains(args, `"checksum.mykubeapp.io/app-config":"`+response.Data.Hash+`"`) {
+			t.Fatalf("patch 인자 = %s", args)
+		}
+	}
+}
+
+func TestUploadConfigMapRolloutSkipsUnchangedAndDryRun(t *testing.T) {
+	env := newTestEnv(t)
+	onPermissionCheck(t, env.executor, "")
+	onManifest(t, env.executor, "kubectl apply", nil)
+	content := map[string][]byte{"application.yml": []byte("server:\n  port: 8080\n")}
+
+	// 먼저 해시를 구한 뒤 web에는 같은 해시가 이미 붙어 있는 상태로 구성
+	recorder := uploadConfigMap(t, env, map[string]string{"name": "app-config"}, content)
+	var preview model.ConfigMapResponse
+	decodeResponse(t, recorder, &preview)
+	deployments := strings.Replace(testConsumerDeployments, `{"template": {"spec": {
+    "volumes"`, `{"template": {"metadata": {"annotations": {"checksum.mykubeapp.io/app-config": "`+preview.Data.Hash+`"}}, "spec": {
+    "volumes"`, 1)
+	env.executor.On("kubectl get --raw /apis/apps/v1/namespaces/default/deployments", deployments)
+
+	recorder = uploadConfigMap(t, env, map[string]string{"name": "app-config", "apply": "true", "dryRun": "true", "rollout": "true"}, content)
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.ConfigMapResponse
+	decodeResponse(t, recorder, &response)
+	statuses := map[string]string{}
+	for _, rollout := range response.Data.Rollouts {
+		statuses[rollout.Deployment] = rollout.Status
+	}
+	if statuses["web"] != model.ConfigMapRolloutUnchanged || statuses["worker"] != model.ConfigMapRolloutWouldAnnotate {
+		t.Fatalf("롤아웃 상태 = %v", statuses)
+	}
+	expectNoCalls(t, env.executor, "kubectl patch")
+}
+
+func TestCreateConfigMapFromGitDirectory(t *testing.T) {
+	env := newTestEnv(t)
+	onClone(env.executor, map[string]string{
+		"config/app.properties": "feature.enabled=true\n",
+		"config/logging.xml":    "<configuration/>\n",
+		"config/.hidden":        "skip\n",
+		"config/nested/ignored": "skip\n",
+		"README.md":             "# demo\n",
+	})
+
+	recorder := env.do(t, http.MethodPost, "/api/configmaps/git", model.ConfigMapGitRequest{
+		ConfigMapRequest: model.ConfigMapRequest{Name: "app-config"},
+		RepoURL:          "https://github.com/example/demo.git",
+		Path:             "config",
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.ConfigMapResponse
+	decodeResponse(t, recorder, &response)
+	if !reflect.DeepEqual(response.Data.Keys, []string{"app.properties", "logging.xml"}) {
+		t.Fatalf("디렉토리 바로 아래 파일만 키가 되어야 합니다: %v", response.Data.Keys)
+	}
+	if response.Data.Source != "https://github.com/example/demo.git@main:config" {
+		t.Fatalf("출처 = %s", response.Data.Source)
+	}
+}
+
+func TestCreateConfigMapValidation(t *testing.T) {
+	env := newTestEnv(t)
+	onClone(env.executor, map[string]string{"config/app.properties": "a=b\n"})
+
+	for _, path := range []string{"../etc", "/etc/passwd", "missing"} {
+		recorder := env.do(t, http.MethodPost, "/api/configmaps/git", model.ConfigMapGitRequest{
+			ConfigMapRequest: model.ConfigMapRequest{Name: "app-config"},
+			RepoURL:          "https://github.com/example/demo.git",
+			Path:             path,
+		})
+		expectStatus(t, recorder, http.StatusBadRequest)
+	}
+
+	content := map[string][]byte{"app.properties": []byte("a=b\n")}
+	for _, fields := range []map[string]string{
+		{"name": "Bad_Name"},
+		{"name": "app-config", "rollout": "true"},
+	} {
+		recorder := uploadConfigMap(t, env, fields, content)
+		expectStatus(t, recorder, http.StatusBadRequest)
+	}
+	expectStatus(t, uploadConfigMap(t, env, map[string]string{"name": "app-config"}, nil), http.StatusBadRequest)
+
+	// kubectl apply의 last-applied-configuration 어노테이션 제한(256KiB)을 넘는 데이터
+	large := map[string][]byte{"large.txt": bytes.Repeat([]byte("a"), 300<<10)}
+	recorder := uploadConfigMap(t, env, map[string]string{"name": "app-config", "apply": "true"}, large)
+	expectStatus(t, recorder, http.StatusBadRequest)
+	if !strings.Contains(recorder.Body.String(), "너무 큽니다") {
+		t.Fatalf("크기 제한 오류가 아닙니다: %s", recorder.Body.String())
+	}
+	expectNoCalls(t, env.executor, "kubectl apply")
+}
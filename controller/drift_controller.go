@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// DriftController - Git과 클러스터 간 드리프트 탐지 컨트롤러
+type DriftController struct {
+	driftService *service.DriftService
+}
+
+// NewDriftController - 드리프트 컨트롤러 생성자
+func NewDriftController() *DriftController {
+	return &DriftController{
+		driftService: service.NewDriftService(),
+	}
+}
+
+// DetectDrift - Git 매니페스트와 클러스터 상태 비교 (POST /api/git/drift)
+func (dc *DriftController) DetectDrift(w http.ResponseWriter, r *http.Request) {
+	log.Println("🧭 POST /api/git/drift - 드리프트 탐지 요청")
+
+	var request model.DriftRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	if strings.TrimSpace(request.RepoURL) == "" {
+		http.Error(w, "레포지토리 URL은 필수입니다", http.StatusBadRequest)
+		return
+	}
+
+	report, err := dc.driftService.DetectDrift(r.Context(), request)
+	if err != nil {
+		if writeCommandTimeout(w, err) || writeUndefinedVariables(w, err) {
+			return
+		}
+		http.Error(w, "드리프트 탐지 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.DriftReportResponse{}
+	response.Success = true
+	if report.InSync {
+		response.Message = "클러스터가 Git과 일치합니다"
+	} else {
+		response.Message = "클러스터와 Git 사이에 차이가 있습니다"
+	}
+	response.Data = *report
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// EnvironmentController - 환경별 변수 세트 및 ${VAR} 치환 컨트롤러
+type EnvironmentController struct {
+	variableService *service.VariableService
+}
+
+// NewEnvironmentController - 환경 컨트롤러 생성자
+func NewEnvironmentController() *EnvironmentController {
+	return &EnvironmentController{
+		variableService: service.NewVariableService(),
+	}
+}
+
+// GetEnvironments - 환경 변수 세트 조회 (GET /api/environments)
+func (ec *EnvironmentController) GetEnvironments(w http.ResponseWriter, r *http.Request) {
+	log.Println("🌍 GET /api/environments - 환경 변수 세트 조회 요청")
+
+	config, err := ec.variableService.GetEnvironments()
+	if err != nil {
+		http.Error(w, "환경 설정 조회 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.EnvironmentConfigResponse{}
+	response.Success = true
+	response.Message = "환경 설정 조회 성공"
+	response.Data = *config
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// UpdateEnvironments - 환경 변수 세트 저장 (PUT /api/environments)
+func (ec *EnvironmentController) UpdateEnvironments(w http.ResponseWriter, r *http.Request) {
+	log.Println("🌍 PUT /api/environments - 환경 변수 세트 변경 요청")
+
+	var request model.EnvironmentConfig
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	if err := ec.variableService.SaveEnvironments(request); err != nil {
+		http.Error(w, "환경 설정 저장 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.BaseResponse{
+		Success: true,
+		Message: "환경 설정이 성공적으로 저장되었습니다",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Substitute - ${VAR} 치환 결과 미리보기 (POST /api/environments/substitute)
+func (ec *EnvironmentController) Substitute(w http.ResponseWriter, r *http.Request) {
+	log.Println("🔤 POST /api/environments/substitute - 변수 치환 미리보기 요청")
+
+	var request model.SubstituteRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	if strings.TrimSpace(request.YamlContent) == "" {
+		http.Error(w, "YAML 내용은 필수입니다", http.StatusBadRequest)
+		return
+	}
+
+	resolved, err := ec.variableService.ResolveVariables(request.Environment, request.Variables)
+	if err != nil {
+		http.Error(w, "변수 조회 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	content, used, err := ec.variableService.Substitute(request.YamlContent, resolved)
+	if err != nil {
+		if writeUndefinedVariables(w, err) {
+			return
+		}
+		http.Error(w, "변수 치환 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.SubstituteResponse{}
+	response.Success = true
+	response.Message = "변수 치환 완료"
+	response.Data = model.SubstituteResult{
+		YamlContent:       content,
+		Environment:       request.Environment,
+		ResolvedVariables: used,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// writeUndefinedVariables - 미정의 변수 에러이면 400과 변수 목록을 응답하고 true 반환
+func writeUndefinedVariables(w http.ResponseWriter, err error) bool {
+	var undefined *service.UndefinedVariablesError
+	if !errors.As(err, &undefined) {
+		return false
+	}
+
+	response := map[string]interface{}{
+		"success": false,
+		"message": undefined.Error(),
+		"data": map[string]interface{}{
+			"undefinedVariables": undefined.Names,
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(response)
+	return true
+}
@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"log"
+	"mykubeapp/terminal"
+	"net/http"
+)
+
+// EventController - 클러스터 이벤트 스트리밍 컨트롤러
+type EventController struct{}
+
+// NewEventController - 이벤트 컨트롤러 생성자
+func NewEventController() *EventController {
+	return &EventController{}
+}
+
+// StreamEvents - 이벤트 스트리밍 (GET /api/events, WebSocket 또는 SSE)
+func (ec *EventController) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	log.Println("📣 GET /api/events - 이벤트 스트리밍 요청")
+	terminal.ClusterEventsHandler(w, r)
+}
@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"log"
+	"mykubeapp/terminal"
+	"net/http"
+)
+
+// ExecController - 파드 대화형 exec 컨트롤러
+type ExecController struct{}
+
+// NewExecController - exec 컨트롤러 생성자
+func NewExecController() *ExecController {
+	return &ExecController{}
+}
+
+// ExecPod - 파드 컨테이너 대화형 셸 (GET /api/exec, WebSocket)
+func (ec *ExecController) ExecPod(w http.ResponseWriter, r *http.Request) {
+	log.Println("🖥️ GET /api/exec - 파드 exec 세션 요청")
+	terminal.PodExecHandler(w, r)
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// ExportController - 리소스 내보내기 컨트롤러
+type ExportController struct {
+	exportService *service.ExportService
+}
+
+// NewExportController - 내보내기 컨트롤러 생성자
+func NewExportController() *ExportController {
+	return &ExportController{
+		exportService: service.NewExportService(),
+	}
+}
+
+// ExportResources - 실행 중인 리소스를 재적용 가능한 YAML로 내보내기 (POST /api/export)
+func (ec *ExportController) ExportResources(w http.ResponseWriter, r *http.Request) {
+	log.Println("📤 POST /api/export - 리소스 내보내기 요청")
+
+	var request model.ExportRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	result, err := ec.exportService.ExportResources(r.Context(), request)
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "리소스 내보내기 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.ExportResponse{}
+	response.Success = true
+	response.Message = "리소스 내보내기 완료"
+	response.Data = *result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -20,9 +21,17 @@ type GitController struct {
 
 // NewGitController - Git 컨트롤러 생성자
 func NewGitController() *GitController {
+	return NewGitControllerWithServices(
+		service.NewGitService(),
+		service.NewAIService("http://localhost:11434"), // DeepSeek URL
+	)
+}
+
+// NewGitControllerWithServices - 지정한 서비스를 사용하는 Git 컨트롤러 생성자
+func NewGitControllerWithServices(gitService *service.GitService, aiService *service.AIService) *GitController {
 	return &GitController{
-		gitService: service.NewGitService(),
-		aiService:  service.NewAIService("http://localhost:11434"), // DeepSeek URL
+		gitService: gitService,
+		aiService:  aiService,
 	}
 }
 
@@ -48,31 +57,42 @@ func (gc *GitController) GetYamlFromGit(w http.ResponseWriter, r *http.Request)
 	}
 
 	// Git 레포지토리 클론
-	repoDir, err := gc.gitService.CloneRepository(request.RepoURL, request.Branch)
+	repoDir, err := gc.gitService.CloneRepository(r.Context(), request.RepoURL, request.Branch)
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "Git 레포지토리 클론 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 	defer gc.gitService.Cleanup(repoDir) // 함수 종료 시 정리
 
 	var yamlFiles []model.GitYamlFile
+	var kustomizations []model.KustomizeTarget
 
-	if request.Filename != "" {
+	if request.Filename != "" && request.KustomizePath == "" {
 		// 특정 파일 검색
-		yamlFile, err := gc.gitService.GetSpecificYamlFile(repoDir, request.Filename)
+		yamlFile, err := gc.gitService.GetSpecificYamlFile(r.Context(), repoDir, request.Filename)
 		if err != nil {
+			if writeCommandTimeout(w, err) {
+				return
+			}
 			http.Error(w, "파일 검색 실패: "+err.Error(), http.StatusNotFound)
 			return
 		}
 		yamlFiles = append(yamlFiles, *yamlFile)
 	} else {
-		// 모든 YAML 파일 검색
-		foundFiles, err := gc.gitService.FindYamlFiles(repoDir)
+		// 모든 YAML 파일 검색 (kustomization은 빌드 결과로 표시)
+		foundFiles, targets, err := gc.gitService.FindManifests(r.Context(), repoDir, request.KustomizePath, false)
 		if err != nil {
+			if writeCommandTimeout(w, err) {
+				return
+			}
 			http.Error(w, "YAML 파일 검색 실패: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
 		yamlFiles = foundFiles
+		kustomizations = targets
 	}
 
 	// 응답 구성
@@ -87,6 +107,8 @@ func (gc *GitController) GetYamlFromGit(w http.ResponseWriter, r *http.Request)
 			YamlFiles:   yamlFiles,
 			TotalFiles:  len(yamlFiles),
 			RetrievedAt: time.Now().Format("2006-01-02 15:04:05"),
+
+			Kustomizations: kustomizations,
 		},
 	}
 
@@ -116,8 +138,11 @@ func (gc *GitController) ApplyYamlFromGit(w http.ResponseWriter, r *http.Request
 	}
 
 	// Git 레포지토리 클론
-	repoDir, err := gc.gitService.CloneRepository(request.RepoURL, request.Branch)
+	repoDir, err := gc.gitService.CloneRepository(r.Context(), request.RepoURL, request.Branch)
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "Git 레포지토리 클론 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -125,18 +150,24 @@ func (gc *GitController) ApplyYamlFromGit(w http.ResponseWriter, r *http.Request
 
 	var yamlFiles []model.GitYamlFile
 
-	if request.Filename != "" {
+	if request.Filename != "" && request.KustomizePath == "" {
 		// 특정 파일 적용
-		yamlFile, err := gc.gitService.GetSpecificYamlFile(repoDir, request.Filename)
+		yamlFile, err := gc.gitService.GetSpecificYamlFile(r.Context(), repoDir, request.Filename)
 		if err != nil {
+			if writeCommandTimeout(w, err) {
+				return
+			}
 			http.Error(w, "파일 검색 실패: "+err.Error(), http.StatusNotFound)
 			return
 		}
 		yamlFiles = append(yamlFiles, *yamlFile)
 	} else {
-		// 모든 YAML 파일 적용
-		foundFiles, err := gc.gitService.FindYamlFiles(repoDir)
+		// 모든 YAML 파일 적용 (kustomization은 빌드 결과를 적용)
+		foundFiles, _, err := gc.gitService.FindManifests(r.Context(), repoDir, request.KustomizePath, true)
 		if err != nil {
+			if writeCommandTimeout(w, err) {
+				return
+			}
 			http.Error(w, "YAML 파일 검색 실패: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -144,8 +175,11 @@ func (gc *GitController) ApplyYamlFromGit(w http.ResponseWriter, r *http.Request
 	}
 
 	// YAML 파일들 적용
-	applyResult, err := gc.gitService.ApplyYamlFromGit(yamlFiles, request.Namespace, request.DryRun)
+	applyResult, err := gc.gitService.ApplyYamlFromGit(r.Context(), yamlFiles, request.Namespace, request.DryRun, request.Environment, request.Variables)
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "YAML 적용 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -185,7 +219,7 @@ func (gc *GitController) ProcessGitWithAI(w http.ResponseWriter, r *http.Request
 	}
 
 	// AI를 통해 프롬프트 파싱
-	parseResult, err := gc.parseGitPromptWithAI(request.Prompt)
+	parseResult, err := gc.parseGitPromptWithAI(r.Context(), request.Prompt)
 	if err != nil {
 		http.Error(w, "AI 프롬프트 파싱 실패: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -210,8 +244,11 @@ func (gc *GitController) ProcessGitWithAI(w http.ResponseWriter, r *http.Request
 			Filename: parseResult.Filename,
 		}
 
-		yamlData, err := gc.executeYamlRetrieval(yamlRequest)
+		yamlData, err := gc.executeYamlRetrieval(r.Context(), yamlRequest)
 		if err != nil {
+			if writeCommandTimeout(w, err) {
+				return
+			}
 			http.Error(w, "YAML 조회 실패: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -228,8 +265,11 @@ func (gc *GitController) ProcessGitWithAI(w http.ResponseWriter, r *http.Request
 			DryRun:    parseResult.DryRun,
 		}
 
-		applyData, err := gc.executeYamlApplication(applyRequest)
+		applyData, err := gc.executeYamlApplication(r.Context(), applyRequest)
 		if err != nil {
+			if writeCommandTimeout(w, err) {
+				return
+			}
 			http.Error(w, "YAML 적용 실패: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -267,7 +307,7 @@ func (gc *GitController) ProcessGitWithAI(w http.ResponseWriter, r *http.Request
 }
 
 // parseGitPromptWithAI - AI를 통해 Git 프롬프트 파싱
-func (gc *GitController) parseGitPromptWithAI(prompt string) (*model.GitParseResult, error) {
+func (gc *GitController) parseGitPromptWithAI(ctx context.Context, prompt string) (*model.GitParseResult, error) {
 	log.Printf("🤖 AI Git 프롬프트 파싱: %s", prompt)
 
 	// AI 시스템 프롬프트 구성
@@ -319,7 +359,7 @@ Examples:
 	}
 
 	// AI API 호출
-	response, err := gc.aiService.CallDeepSeekAPI(aiRequest)
+	response, err := gc.aiService.CallDeepSeekAPI(ctx, aiRequest)
 	if err != nil {
 		return nil, fmt.Errorf("AI API 호출 실패: %v", err)
 	}
@@ -518,30 +558,32 @@ func (gc *GitController) cleanAIResponse(response string) string {
 }
 
 // executeYamlRetrieval - YAML 조회 실행
-func (gc *GitController) executeYamlRetrieval(request model.GitYamlRequest) (*model.GitYamlData, error) {
+func (gc *GitController) executeYamlRetrieval(ctx context.Context, request model.GitYamlRequest) (*model.GitYamlData, error) {
 	// Git 레포지토리 클론
-	repoDir, err := gc.gitService.CloneRepository(request.RepoURL, request.Branch)
+	repoDir, err := gc.gitService.CloneRepository(ctx, request.RepoURL, request.Branch)
 	if err != nil {
 		return nil, fmt.Errorf("Git 레포지토리 클론 실패: %v", err)
 	}
 	defer gc.gitService.Cleanup(repoDir)
 
 	var yamlFiles []model.GitYamlFile
+	var kustomizations []model.KustomizeTarget
 
-	if request.Filename != "" {
+	if request.Filename != "" && request.KustomizePath == "" {
 		// 특정 파일 검색
-		yamlFile, err := gc.gitService.GetSpecificYamlFile(repoDir, request.Filename)
+		yamlFile, err := gc.gitService.GetSpecificYamlFile(ctx, repoDir, request.Filename)
 		if err != nil {
 			return nil, fmt.Errorf("파일 검색 실패: %v", err)
 		}
 		yamlFiles = append(yamlFiles, *yamlFile)
 	} else {
-		// 모든 YAML 파일 검색
-		foundFiles, err := gc.gitService.FindYamlFiles(repoDir)
+		// 모든 YAML 파일 검색 (kustomization은 빌드 결과로 표시)
+		foundFiles, targets, err := gc.gitService.FindManifests(ctx, repoDir, request.KustomizePath, false)
 		if err != nil {
 			return nil, fmt.Errorf("YAML 파일 검색 실패: %v", err)
 		}
 		yamlFiles = foundFiles
+		kustomizations = targets
 	}
 
 	return &model.GitYamlData{
@@ -550,13 +592,15 @@ func (gc *GitController) executeYamlRetrieval(request model.GitYamlRequest) (*mo
 		YamlFiles:   yamlFiles,
 		TotalFiles:  len(yamlFiles),
 		RetrievedAt: time.Now().Format("2006-01-02 15:04:05"),
+
+		Kustomizations: kustomizations,
 	}, nil
 }
 
 // executeYamlApplication - YAML 적용 실행
-func (gc *GitController) executeYamlApplication(request model.GitApplyRequest) (*model.GitApplyData, error) {
+func (gc *GitController) executeYamlApplication(ctx context.Context, request model.GitApplyRequest) (*model.GitApplyData, error) {
 	// Git 레포지토리 클론
-	repoDir, err := gc.gitService.CloneRepository(request.RepoURL, request.Branch)
+	repoDir, err := gc.gitService.CloneRepository(ctx, request.RepoURL, request.Branch)
 	if err != nil {
 		return nil, fmt.Errorf("Git 레포지토리 클론 실패: %v", err)
 	}
@@ -564,16 +608,16 @@ func (gc *GitController) executeYamlApplication(request model.GitApplyRequest) (
 
 	var yamlFiles []model.GitYamlFile
 
-	if request.Filename != "" {
+	if request.Filename != "" && request.KustomizePath == "" {
 		// 특정 파일 적용
-		yamlFile, err := gc.gitService.GetSpecificYamlFile(repoDir, request.Filename)
+		yamlFile, err := gc.gitService.GetSpecificYamlFile(ctx, repoDir, request.Filename)
 		if err != nil {
 			return nil, fmt.Errorf("파일 검색 실패: %v", err)
 		}
 		yamlFiles = append(yamlFiles, *yamlFile)
 	} else {
-		// 모든 YAML 파일 적용
-		foundFiles, err := gc.gitService.FindYamlFiles(repoDir)
+		// 모든 YAML 파일 적용 (kustomization은 빌드 결과를 적용)
+		foundFiles, _, err := gc.gitService.FindManifests(ctx, repoDir, request.KustomizePath, true)
 		if err != nil {
 			return nil, fmt.Errorf("YAML 파일 검색 실패: %v", err)
 		}
@@ -581,7 +625,7 @@ func (gc *GitController) executeYamlApplication(request model.GitApplyRequest) (
 	}
 
 	// YAML 파일들 적용
-	applyResult, err := gc.gitService.ApplyYamlFromGit(yamlFiles, request.Namespace, request.DryRun)
+	applyResult, err := gc.gitService.ApplyYamlFromGit(ctx, yamlFiles, request.Namespace, request.DryRun, request.Environment, request.Variables)
 	if err != nil {
 		return nil, fmt.Errorf("YAML 적용 실패: %v", err)
 	}
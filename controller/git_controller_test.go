@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+	"mykubeapp/utils/utilstest"
+)
+
+const testDeploymentYaml = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: web
+        image: nginx:1.25
+`
+
+const testServiceYaml = `apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  selector:
+    app: web
+  ports:
+  - port: 80
+`
+
+// onClone - git clone 호출 시 클론 대상 디렉토리(마지막 인자)에 파일을 만들어 레포지토리를 흉내냄
+func onClone(executor *utilstest.FakeExecutor, files map[string]string) {
+	executor.OnFunc("git clone", func(call utilstest.FakeCommandCall) (string, error) {
+		cloneDir := call.Args[len(call.Args)-1]
+		for path, content := range files {
+			if err := utils.WriteFile(filepath.Join(cloneDir, filepath.FromSlash(path)), content); err != nil {
+				return "", err
+			}
+		}
+		return "Cloning into '" + cloneDir + "'...", nil
+	})
+}
+
+func TestGetYamlFromGit(t *testing.T) {
+	env := newTestEnv(t)
+	onClone(env.executor, map[string]string{
+		"k8s/deployment.yaml": testDeploymentYaml,
+		"k8s/service.yml":     testServiceYaml,
+		"ci/pipeline.yaml":    "stages:\n- build\n",
+		"README.md":           "# demo\n",
+	})
+
+	recorder := env.do(t, http.MethodPost, "/api/git/yaml", model.GitYamlRequest{RepoURL: "https://github.com/example/demo.git"})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.GitYamlResponse
+	decodeResponse(t, recorder, &response)
+	if response.Data.Branch != "main" {
+		t.Fatalf("기본 브랜치 = %q, 기대값 main", response.Data.Branch)
+	}
+	paths := map[string]bool{}
+	for _, file := range response.Data.YamlFiles {
+		paths[filepath.ToSlash(file.Path)] = true
+	}
+	if !paths["k8s/deployment.yaml"] || !paths["k8s/service.yml"] || paths["ci/pipeline.yaml"] {
+		t.Fatalf("쿠버네티스 YAML만 조회되어야 합니다: %v", paths)
+	}
+
+	call := env.executor.CallsTo("git clone")[0]
+	if !strings.Contains(call.Command(), "--depth 1 https://github.com/example/demo.git") {
+		t.Fatalf("얕은 클론 인자가 없습니다: %s", call.Command())
+	}
+}
+
+func TestGetYamlFromGitRequiresRepoURL(t *testing.T) {
+	env := newTestEnv(t)
+
+	recorder := env.do(t, http.MethodPost, "/api/git/yaml", model.GitYamlRequest{})
+	expectStatus(t, recorder, http.StatusBadRequest)
+	expectNoCalls(t, env.executor, "git")
+}
+
+func TestGetYamlFromGitCloneFailure(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.OnError("git clone", errors.New("repository not found"))
+
+	recorder := env.do(t, http.MethodPost, "/api/git/yaml", model.GitYamlRequest{RepoURL: "https://github.com/example/missing.git"})
+	expectStatus(t, recorder, http.StatusInternalServerError)
+
+	env.executor.OnError("git clone", &utils.CommandTimeoutError{Command: "git clone", Timeout: 3 * time.Minute})
+	recorder = env.do(t, http.MethodPost, "/api/git/yaml", model.GitYamlRequest{RepoURL: "https://github.com/example/slow.git"})
+	expectStatus(t, recorder, http.StatusGatewayTimeout)
+}
+
+func TestGetYamlFromGitKustomization(t *testing.T) {
+	env := newTestEnv(t)
+	onClone(env.executor, map[string]string{
+		"deploy/kustomization.yaml": "resources:\n- deployment.yaml\n",
+		"deploy/deployment.yaml":    testDeploymentYaml,
+	})
+	env.executor.On("kubectl kustomize", testDeploymentYaml)
+
+	recorder := env.do(t, http.MethodPost, "/api/git/yaml", model.GitYamlRequest{RepoURL: "https://github.com/example/demo.git"})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.GitYamlResponse
+	decodeResponse(t, recorder, &response)
+	if len(response.Data.YamlFiles) != 1 || response.Data.YamlFiles[0].KustomizePath != "deploy" {
+		t.Fatalf("kustomization 빌드 결과만 조회되어야 합니다: %+v", response.Data.YamlFiles)
+	}
+	if len(env.executor.CallsTo("kubectl kustomize")) != 1 {
+		t.Fatalf("kubectl kustomize가 한 번 호출되어야 합니다")
+	}
+}
+
+func TestApplyYamlFromGit(t *testing.T) {
+	env := newTestEnv(t)
+	onClone(env.executor, map[string]string{
+		"k8s/deployment.yaml": testDeploymentYaml,
+		"k8s/service.yaml":    testServiceYaml,
+	})
+	env.executor.On("kubectl config current-context", "dev\n")
+	onPermissionCheck(t, env.executor, "")
+	onManifest(t, env.executor, "kubectl apply", func(call utilstest.FakeCommandCall, manifest string) (string, error) {
+		if strings.Contains(manifest, "kind: Service") {
+			return "", errors.New("service \"web\" is invalid")
+		}
+		return kubectlOutputs["apply"](call, manifest)
+	})
+
+	recorder := env.do(t, http.MethodPost, "/api/git/apply", model.GitApplyRequest{
+		RepoURL:   "https://github.com/example/demo.git",
+		Branch:    "release",
+		Namespace: "demo",
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.GitApplyResponse
+	decodeResponse(t, recorder, &response)
+	result := response.Data.ApplyResult
+	if result.TotalFiles != 2 || result.SuccessFiles != 1 || result.FailedFiles != 1 {
+		t.Fatalf("적용 결과 = 전체 %d, 성공 %d, 실패 %d", result.TotalFiles, result.SuccessFiles, result.FailedFiles)
+	}
+	if len(result.AllResources) != 1 || result.AllResources[0] != "deployment.apps/web" {
+		t.Fatalf("적용 리소스 = %v", result.AllResources)
+	}
+
+	clone := env.executor.CallsTo("git clone")[0]
+	if !strings.Contains(clone.Command(), "-b release") {
+		t.Fatalf("브랜치 인자가 없습니다: %s", clone.Command())
+	}
+	for _, call := range env.executor.CallsTo("kubectl apply") {
+		if !strings.Contains(call.Command(), "-n demo") {
+			t.Fatalf("네임스페이스 인자가 없습니다: %s", call.Command())
+		}
+	}
+}
+
+func TestApplyYamlFromGitSpecificFile(t *testing.T) {
+	env := newTestEnv(t)
+	onClone(env.executor, map[string]string{
+		"k8s/deployment.yaml": testDeploymentYaml,
+		"k8s/service.yaml":    testServiceYaml,
+	})
+	env.executor.
+		On("kubectl config current-context", "dev\n").
+		On("kubectl apply", "service/web created (dry run)\n")
+	onPermissionCheck(t, env.executor, "")
+
+	recorder := env.do(t, http.MethodPost, "/api/git/apply", model.GitApplyRequest{
+		RepoURL:  "https://github.com/example/demo.git",
+		Filename: "service.yaml",
+		DryRun:   true,
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	calls := env.executor.CallsTo("kubectl apply")
+	if len(calls) != 1 || !strings.Contains(calls[0].Command(), "--dry-run=client") {
+		t.Fatalf("지정한 파일 하나만 dry-run으로 적용되어야 합니다: %d회", len(calls))
+	}
+
+	recorder = env.do(t, http.MethodPost, "/api/git/apply", model.GitApplyRequest{
+		RepoURL:  "https://github.com/example/demo.git",
+		Filename: "missing.yaml",
+	})
+	expectStatus(t, recorder, http.StatusNotFound)
+}
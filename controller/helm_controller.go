@@ -0,0 +1,291 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+	"mykubeapp/utils"
+)
+
+// HelmController - Helm 차트 렌더링/설치 컨트롤러
+type HelmController struct {
+	helmService *service.HelmService
+	gitService  *service.GitService
+}
+
+// NewHelmController - Helm 컨트롤러 생성자
+func NewHelmController() *HelmController {
+	return &HelmController{
+		helmService: service.NewHelmService(),
+		gitService:  service.NewGitService(),
+	}
+}
+
+// RenderFromGit - Git 레포지토리의 차트 렌더링 미리보기 (POST /api/helm/git/template)
+func (hc *HelmController) RenderFromGit(w http.ResponseWriter, r *http.Request) {
+	log.Println("🎨 POST /api/helm/git/template - Git 차트 렌더링 요청")
+
+	request, ok := hc.decodeGitRequest(w, r)
+	if !ok {
+		return
+	}
+
+	repoDir, err := hc.gitService.CloneRepository(r.Context(), request.RepoURL, request.Branch)
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "Git 레포지토리 클론 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer hc.gitService.Cleanup(repoDir)
+
+	hc.render(r.Context(), w, repoDir, request)
+}
+
+// InstallFromGit - Git 레포지토리의 차트 설치/업그레이드 (POST /api/helm/git/install)
+func (hc *HelmController) InstallFromGit(w http.ResponseWriter, r *http.Request) {
+	log.Println("🚀 POST /api/helm/git/install - Git 차트 설치 요청")
+
+	request, ok := hc.decodeGitRequest(w, r)
+	if !ok {
+		return
+	}
+
+	repoDir, err := hc.gitService.CloneRepository(r.Context(), request.RepoURL, request.Branch)
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "Git 레포지토리 클론 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer hc.gitService.Cleanup(repoDir)
+
+	hc.install(r.Context(), w, repoDir, request)
+}
+
+// RenderUpload - 업로드한 차트 아카이브 렌더링 미리보기 (POST /api/helm/upload/template)
+// multipart 필드: archive(.tgz 등), chartPath, releaseName, namespace, values(JSON 또는 YAML)
+func (hc *HelmController) RenderUpload(w http.ResponseWriter, r *http.Request) {
+	log.Println("🎨 POST /api/helm/upload/template - 업로드 차트 렌더링 요청")
+
+	workDir, request, ok := hc.decodeUploadRequest(w, r)
+	if !ok {
+		return
+	}
+	defer os.RemoveAll(workDir)
+
+	hc.render(r.Context(), w, workDir, request)
+}
+
+// InstallUpload - 업로드한 차트 아카이브 설치/업그레이드 (POST /api/helm/upload/install)
+// multipart 필드: archive(.tgz 등), chartPath, releaseName, namespace, values(JSON 또는 YAML), dryRun
+func (hc *HelmController) InstallUpload(w http.ResponseWriter, r *http.Request) {
+	log.Println("🚀 POST /api/helm/upload/install - 업로드 차트 설치 요청")
+
+	workDir, request, ok := hc.decodeUploadRequest(w, r)
+	if !ok {
+		return
+	}
+	defer os.RemoveAll(workDir)
+
+	hc.install(r.Context(), w, workDir, request)
+}
+
+// ListReleases - 릴리스 목록 조회 (GET /api/helm/releases?namespace=)
+func (hc *HelmController) ListReleases(w http.ResponseWriter, r *http.Request) {
+	log.Println("📋 GET /api/helm/releases - Helm 릴리스 목록 조회 요청")
+
+	releases, err := hc.helmService.ListReleases(r.Context(), r.URL.Query().Get("namespace"))
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "릴리스 목록 조회 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.HelmReleasesResponse{}
+	response.Success = true
+	response.Message = fmt.Sprintf("릴리스 목록 조회 성공 (총 %d개)", len(releases))
+	response.Data = releases
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetReleaseHistory - 릴리스 리비전 이력 조회 (GET /api/helm/releases/{namespace}/{name}/history)
+func (hc *HelmController) GetReleaseHistory(w http.ResponseWriter, r *http.Request) {
+	log.Println("📜 GET /api/helm/releases/{namespace}/{name}/history - Helm 릴리스 이력 조회 요청")
+
+	vars := mux.Vars(r)
+	history, err := hc.helmService.GetReleaseHistory(r.Context(), vars["namespace"], vars["name"])
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "릴리스 이력 조회 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.HelmHistoryResponse{}
+	response.Success = true
+	response.Message = "릴리스 이력 조회 성공"
+	response.Data = history
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// render - 차트 선택 후 렌더링 결과 응답
+func (hc *HelmController) render(ctx context.Context, w http.ResponseWriter, rootDir string, request model.HelmGitRequest) {
+	charts, chart, ok := hc.selectChart(w, rootDir, request.ChartPath)
+	if !ok {
+		return
+	}
+
+	rendered, err := hc.helmService.Render(ctx, rootDir, *chart, request.ReleaseName, request.Namespace, request.Values)
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "차트 렌더링 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := hc.helmService.BuildRenderResult(charts, *chart, request.ReleaseName, request.Namespace, rendered)
+	if err != nil {
+		http.Error(w, "차트 렌더링 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.HelmRenderResponse{}
+	response.Success = true
+	response.Message = fmt.Sprintf("차트 렌더링 완료 (%d개 리소스)", len(result.Resources))
+	response.Data = *result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// install - 차트 선택 후 설치/업그레이드 결과 응답
+func (hc *HelmController) install(ctx context.Context, w http.ResponseWriter, rootDir string, request model.HelmGitRequest) {
+	_, chart, ok := hc.selectChart(w, rootDir, request.ChartPath)
+	if !ok {
+		return
+	}
+
+	result, err := hc.helmService.UpgradeInstall(ctx, rootDir, *chart, request.ReleaseName, request.Namespace, request.Values, request.DryRun)
+	if err != nil {
+		if writeCommandTimeout(w, err) || writePolicyViolation(w, err) || writePermissionDenied(w, err) {
+			return
+		}
+		http.Error(w, "차트 설치 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.HelmInstallResponse{}
+	response.Success = true
+	if request.DryRun {
+		response.Message = "차트 설치 dry-run 완료: " + request.ReleaseName
+	} else {
+		response.Message = "차트 설치/업그레이드 완료: " + request.ReleaseName
+	}
+	response.Data = *result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// selectChart - 차트 탐색 및 선택 (실패 시 에러 응답 후 false)
+func (hc *HelmController) selectChart(w http.ResponseWriter, rootDir, chartPath string) ([]model.HelmChartInfo, *model.HelmChartInfo, bool) {
+	charts, err := hc.helmService.FindCharts(rootDir)
+	if err != nil {
+		http.Error(w, "차트 검색 실패: "+err.Error(), http.StatusInternalServerError)
+		return nil, nil, false
+	}
+
+	chart, err := hc.helmService.SelectChart(charts, chartPath)
+	if err != nil {
+		http.Error(w, "차트 선택 실패: "+err.Error(), http.StatusNotFound)
+		return nil, nil, false
+	}
+
+	return charts, chart, true
+}
+
+// decodeGitRequest - Git 차트 요청 파싱 및 검증
+func (hc *HelmController) decodeGitRequest(w http.ResponseWriter, r *http.Request) (model.HelmGitRequest, bool) {
+	var request model.HelmGitRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return request, false
+	}
+
+	if strings.TrimSpace(request.RepoURL) == "" {
+		http.Error(w, "레포지토리 URL은 필수입니다", http.StatusBadRequest)
+		return request, false
+	}
+	if strings.TrimSpace(request.ReleaseName) == "" {
+		http.Error(w, "릴리스 이름은 필수입니다", http.StatusBadRequest)
+		return request, false
+	}
+	if request.Branch == "" {
+		request.Branch = "main"
+	}
+
+	return request, true
+}
+
+// decodeUploadRequest - 업로드 차트 요청 파싱 (아카이브 압축 해제 포함)
+func (hc *HelmController) decodeUploadRequest(w http.ResponseWriter, r *http.Request) (string, model.HelmGitRequest, bool) {
+	workDir, err := extractUploadedArchive(r, "archive")
+	if err != nil {
+		http.Error(w, "업로드 처리 실패: "+err.Error(), http.StatusBadRequest)
+		return "", model.HelmGitRequest{}, false
+	}
+
+	request := model.HelmGitRequest{
+		ChartPath:   r.FormValue("chartPath"),
+		ReleaseName: r.FormValue("releaseName"),
+		Namespace:   r.FormValue("namespace"),
+	}
+	request.DryRun, _ = strconv.ParseBool(r.FormValue("dryRun"))
+
+	if strings.TrimSpace(request.ReleaseName) == "" {
+		os.RemoveAll(workDir)
+		http.Error(w, "릴리스 이름은 필수입니다", http.StatusBadRequest)
+		return "", request, false
+	}
+
+	// values는 JSON 또는 YAML 문자열 (YAML은 JSON의 상위 집합)
+	if rawValues := strings.TrimSpace(r.FormValue("values")); rawValues != "" {
+		var values interface{}
+		if err := yaml.Unmarshal([]byte(rawValues), &values); err != nil {
+			os.RemoveAll(workDir)
+			http.Error(w, "values 파싱 실패: "+err.Error(), http.StatusBadRequest)
+			return "", request, false
+		}
+		valuesMap, ok := utils.NormalizeYamlValue(values).(map[string]interface{})
+		if !ok {
+			os.RemoveAll(workDir)
+			http.Error(w, "values는 객체 형식이어야 합니다", http.StatusBadRequest)
+			return "", request, false
+		}
+		request.Values = valuesMap
+	}
+
+	return workDir, request, true
+}
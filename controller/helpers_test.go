@@ -0,0 +1,418 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+	"mykubeapp/utils"
+	"mykubeapp/utils/utilstest"
+)
+
+func TestMain(m *testing.M) {
+	// 서비스의 이모지 로그가 테스트 출력을 덮지 않도록 숨김
+	log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+// testEnv - 가짜 실행기와 가짜 AI 서버로 구성한 테스트용 API 서버
+type testEnv struct {
+	home     string                  // 테스트 전용 HOME 디렉토리
+	executor *utilstest.FakeExecutor // kubectl/git 가짜 실행기
+	llm      *fakeLLM                // DeepSeek 가짜 서버
+	router   *mux.Router
+}
+
+// newTestEnv - HOME/TMPDIR/정책/환경 설정 경로를 임시 디렉토리로 격리하고 main.go와 같은 RegisterRoutes로 라우터 구성
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	home := t.TempDir()
+	tempDir := filepath.Join(home, "tmp")
+	if err := os.MkdirAll(tempDir, 0755); err != nil {
+		t.Fatalf("임시 디렉토리 생성 실패: %v", err)
+	}
+	t.Setenv("HOME", home)
+	t.Setenv("TMPDIR", tempDir)
+	t.Setenv("POLICY_CONFIG", filepath.Join(home, "policy.yaml"))
+	t.Setenv("ENVIRONMENTS_CONFIG", filepath.Join(home, "environments.yaml"))
+	t.Setenv("CONTEXT_GROUPS_CONFIG", filepath.Join(home, "context-groups.yaml"))
+	t.Setenv("KUBECONFIG", "")
+	t.Setenv("KUBE_BACKEND", "")
+
+	executor := utilstest.NewFakeExecutor()
+	llm := newFakeLLM(t)
+
+	kubeService := service.NewKubeServiceWithExecutor(executor)
+	gitService := service.NewGitServiceWithExecutor(executor)
+	aiService := service.NewAIServiceWithExecutor(llm.server.URL, executor)
+
+	// main.go와 같은 라우트 정의를 사용하고, 가짜 실행기를 주입한 컨트롤러만 등록
+	router := mux.NewRouter()
+	RegisterRoutes(router, &Controllers{
+		Kube:         NewKubeControllerWithService(kubeService),
+		Git:          NewGitControllerWithServices(gitService, aiService),
+		AI:           NewAIControllerWithServices(aiService, gitService, kubeService),
+		MultiCluster: NewMultiClusterControllerWithService(service.NewMultiClusterServiceWithKubeService(kubeService)),
+		Secret:       NewSecretControllerWithService(service.NewSecretServiceWithKubeService(kubeService)),
+		ConfigMap:    NewConfigMapControllerWithService(service.NewConfigMapServiceWithServices(kubeService, gitService)),
+		Permission:   NewPermissionControllerWithService(service.NewPermissionServiceWithKubeService(kubeService)),
+		Workload:     NewWorkloadControllerWithService(service.NewWorkloadServiceWithExecutor(executor)),
+	})
+
+	return &testEnv{home: home, executor: executor, llm: llm, router: router}
+}
+
+// do - JSON 본문으로 요청을 보내고 응답 기록 반환 (body가 string이면 그대로 전송)
+func (env *testEnv) do(t *testing.T, method, path string, body interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var reader io.Reader
+	switch value := body.(type) {
+	case nil:
+	case string:
+		reader = strings.NewReader(value)
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			t.Fatalf("요청 직렬화 실패: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	request := httptest.NewRequest(method, path, reader)
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	env.router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+// expectStatus - 응답 상태 코드 확인
+func expectStatus(t *testing.T, recorder *httptest.ResponseRecorder, status int) {
+	t.Helper()
+	if recorder.Code != status {
+		t.Fatalf("상태 코드 = %d, 기대값 %d (본문: %s)", recorder.Code, status, recorder.Body.String())
+	}
+}
+
+// decodeResponse - JSON 응답 본문 파싱
+func decodeResponse(t *testing.T, recorder *httptest.ResponseRecorder, target interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(recorder.Body.Bytes(), target); err != nil {
+		t.Fatalf("응답 파싱 실패: %v (본문: %s)", err, recorder.Body.String())
+	}
+}
+
+// expectNoCalls - 명령 접두어와 일치하는 호출이 없었는지 확인
+func expectNoCalls(t *testing.T, executor *utilstest.FakeExecutor, command string) {
+	t.Helper()
+	if calls := executor.CallsTo(command); len(calls) > 0 {
+		t.Fatalf("%q 호출이 없어야 하지만 %d번 호출됨: %s", command, len(calls), calls[0].Command())
+	}
+}
+
+// readAppliedFile - kubectl apply/delete -f 로 전달된 임시 파일 내용 (호출 시점에만 존재)
+func readAppliedFile(t *testing.T, call utilstest.FakeCommandCall) string {
+	t.Helper()
+	path := manifestPath(call)
+	if path == "" {
+		t.Errorf("-f 인자가 없습니다: %s", call.Command())
+		return ""
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("적용 파일 읽기 실패: %v", err)
+		return ""
+	}
+	return string(content)
+}
+
+// manifestPath - -f 인자로 전달된 파일 경로 (없으면 빈 값)
+func manifestPath(call utilstest.FakeCommandCall) string {
+	for i, arg := range call.Args {
+		if arg == "-f" && i+1 < len(call.Args) {
+			return call.Args[i+1]
+		}
+	}
+	return ""
+}
+
+// kubectlSubcommand - --context 인자를 건너뛴 kubectl 하위 명령 (apply, create, get 등)
+func kubectlSubcommand(call utilstest.FakeCommandCall) string {
+	args := call.Args
+	if len(args) >= 2 && args[0] == "--context" {
+		args = args[2:]
+	}
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
+
+// capturedManifest - -f로 전달된 매니페스트 호출 기록
+type capturedManifest struct {
+	call    utilstest.FakeCommandCall
+	content string      // 임시 파일 내용
+	mode    os.FileMode // 임시 파일 권한
+}
+
+// manifestRecorder - 매니페스트를 받는 명령의 호출 기록 (임시 파일은 호출이 끝나면 삭제되므로 호출 시점에 읽음)
+type manifestRecorder struct {
+	mutex     sync.Mutex
+	manifests []capturedManifest
+}
+
+// all - 기록된 전체 매니페스트 (호출 순서)
+func (r *manifestRecorder) all() []capturedManifest {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]capturedManifest(nil), r.manifests...)
+}
+
+// last - 마지막으로 전달된 매니페스트 (호출이 없으면 테스트 실패)
+func (r *manifestRecorder) last(t *testing.T) capturedManifest {
+	t.Helper()
+	manifests := r.all()
+	if len(manifests) == 0 {
+		t.Fatalf("매니페스트를 받은 호출이 없습니다")
+	}
+	return manifests[len(manifests)-1]
+}
+
+// manifestResponder - 기록한 매니페스트로 가짜 kubectl 출력 생성
+type manifestResponder func(call utilstest.FakeCommandCall, manifest string) (string, error)
+
+// kubectlOutputs - 하위 명령별 기본 가짜 출력 (kubectl 출력 형식은 이 표에서만 흉내냄)
+var kubectlOutputs = map[string]manifestResponder{
+	"apply": func(call utilstest.FakeCommandCall, manifest string) (string, error) {
+		action := "created"
+		if slices.ContainsFunc(call.Args, func(arg string) bool { return strings.HasPrefix(arg, "--dry-run") }) {
+			action += " (dry run)"
+		}
+		return objectLines(manifest, action)
+	},
+	"delete": func(call utilstest.FakeCommandCall, manifest string) (string, error) {
+		return objectLines(manifest, "deleted")
+	},
+}
+
+// onManifest - 명령 접두어 호출의 -f 매니페스트를 기록하고 respond로 응답 (nil이면 kubectlOutputs의 하위 명령 출력)
+func onManifest(t *testing.T, executor *utilstest.FakeExecutor, command string, respond manifestResponder) *manifestRecorder {
+	recorder := &manifestRecorder{}
+	executor.OnFunc(command, func(call utilstest.FakeCommandCall) (string, error) {
+		captured := capturedManifest{call: call, content: readAppliedFile(t, call)}
+		if info, err := os.Stat(manifestPath(call)); err == nil {
+			captured.mode = info.Mode().Perm()
+		}
+		recorder.mutex.Lock()
+		recorder.manifests = append(recorder.manifests, captured)
+		recorder.mutex.Unlock()
+
+		handler := respond
+		if handler == nil {
+			handler = kubectlOutputs[kubectlSubcommand(call)]
+		}
+		if handler == nil {
+			t.Errorf("가짜 출력이 없는 하위 명령입니다: %s", call.Command())
+			return "", nil
+		}
+		return handler(call, captured.content)
+	})
+	return recorder
+}
+
+// testAPIResources - 가짜 kubectl api-resources 출력
+const testAPIResources = `deployments   deploy   apps/v1   true    Deployment
+services      svc      v1        true    Service
+secrets                v1        true    Secret
+configmaps    cm       v1        true    ConfigMap
+namespaces    ns       v1        false   Namespace
+`
+
+// kubectlCommand - context가 있으면 --context 인자를 붙인 kubectl 명령 접두어
+func kubectlCommand(kubeContext, command string) string {
+	if kubeContext == "" {
+		return "kubectl " + command
+	}
+	return "kubectl --context " + kubeContext + " " + command
+}
+
+// onPermissionCheck - 권한 사전 검사가 통과하도록 리소스 종류, 기본 네임스페이스, 존재 여부(모두 없음), 권한(모두 허용) 응답 등록
+func onPermissionCheck(t *testing.T, executor *utilstest.FakeExecutor, kubeContext string) {
+	executor.
+		On(kubectlCommand(kubeContext, "api-resources"), testAPIResources).
+		On(kubectlCommand(kubeContext, "config view --minify"), "default")
+	onExistingObjects(t, executor, kubeContext)
+	onAccessReview(t, executor, kubeContext, "*")
+}
+
+// onExistingObjects - kubectl get -f 존재 여부 조회에 existing(kind[.group]/name)에 있는 오브젝트만 있다고 응답
+func onExistingObjects(t *testing.T, executor *utilstest.FakeExecutor, kubeContext string, existing ...string) *manifestRecorder {
+	return onManifest(t, executor, kubectlCommand(kubeContext, "get -f"), func(call utilstest.FakeCommandCall, manifest string) (string, error) {
+		documents, err := utils.ParseYamlDocuments(manifest)
+		if err != nil {
+			return "", err
+		}
+		var output strings.Builder
+		for _, document := range documents {
+			for _, item := range utils.GetNestedSlice(document, "items") {
+				object, _ := item.(map[string]interface{})
+				if !slices.Contains(existing, objectRef(object)) {
+					continue
+				}
+				namespace := utils.GetNestedString(object, "metadata", "namespace")
+				if namespace == "" {
+					namespace = "<none>"
+				}
+				fmt.Fprintf(&output, "%s   %s   %s   %s\n", utils.GetNestedString(object, "apiVersion"), utils.GetNestedString(object, "kind"), namespace, utils.GetNestedString(object, "metadata", "name"))
+			}
+		}
+		return output.String(), nil
+	})
+}
+
+// onAccessReview - List로 묶인 SelfSubjectAccessReview 요청을 "verb resource" 허용 목록("*"이면 전체 허용)으로 응답
+// (검사한 항목을 "verb resource namespace" 형식으로 반환)
+func onAccessReview(t *testing.T, executor *utilstest.FakeExecutor, kubeContext string, allowed ...string) *[]string {
+	var mutex sync.Mutex
+	reviewed := &[]string{}
+	onManifest(t, executor, kubectlCommand(kubeContext, "create -f"), func(call utilstest.FakeCommandCall, manifest string) (string, error) {
+		var list struct {
+			Kind  string `json:"kind"`
+			Items []struct {
+				Kind string `json:"kind"`
+				Spec struct {
+					ResourceAttributes struct {
+						Verb      string `json:"verb"`
+						Resource  string `json:"resource"`
+						Namespace string `json:"namespace"`
+					} `json:"resourceAttributes"`
+				} `json:"spec"`
+			} `json:"items"`
+		}
+		if err := json.Unmarshal([]byte(manifest), &list); err != nil || list.Kind != "List" {
+			t.Errorf("SelfSubjectAccessReview 목록 파싱 실패: %v", err)
+		}
+
+		// kubectl create -o json은 생성한 오브젝트마다 JSON을 이어서 출력
+		var output strings.Builder
+		for _, item := range list.Items {
+			if item.Kind != "SelfSubjectAccessReview" {
+				t.Errorf("검사 항목 = %+v", item)
+			}
+			attributes := item.Spec.ResourceAttributes
+			entry := attributes.Verb + " " + attributes.Resource
+			mutex.Lock()
+			*reviewed = append(*reviewed, strings.TrimSpace(entry+" "+attributes.Namespace))
+			mutex.Unlock()
+			if slices.Contains(allowed, "*") || slices.Contains(allowed, entry) {
+				output.WriteString(`{"kind": "SelfSubjectAccessReview", "status": {"allowed": true}}` + "\n")
+			} else {
+				output.WriteString(`{"kind": "SelfSubjectAccessReview", "status": {"allowed": false, "reason": "no RBAC policy matched"}}` + "\n")
+			}
+		}
+		return output.String(), nil
+	})
+	return reviewed
+}
+
+// objectRef - kubectl -o name 형식의 오브젝트 이름 (kind[.group]/name)
+func objectRef(document map[string]interface{}) string {
+	ref := strings.ToLower(utils.GetNestedString(document, "kind"))
+	if apiVersion := utils.GetNestedString(document, "apiVersion"); strings.Contains(apiVersion, "/") {
+		ref += "." + apiVersion[:strings.Index(apiVersion, "/")]
+	}
+	return ref + "/" + utils.GetNestedString(document, "metadata", "name")
+}
+
+// objectLines - 매니페스트 오브젝트마다 "kind[.group]/name action" 한 줄씩
+func objectLines(manifest, action string) (string, error) {
+	documents, err := utils.ParseYamlDocuments(manifest)
+	if err != nil {
+		return "", err
+	}
+	var output strings.Builder
+	for _, document := range documents {
+		output.WriteString(objectRef(document) + " " + action + "\n")
+	}
+	return output.String(), nil
+}
+
+// decodeManifest - 기록한 매니페스트를 YAML로 파싱
+func decodeManifest(t *testing.T, manifest capturedManifest, target interface{}) {
+	t.Helper()
+	if err := yaml.Unmarshal([]byte(manifest.content), target); err != nil {
+		t.Fatalf("매니페스트 파싱 실패: %v\n%s", err, manifest.content)
+	}
+}
+
+// fakeLLM - 스크립트된 응답을 순서대로 돌려주는 DeepSeek 호환 가짜 서버
+type fakeLLM struct {
+	server   *httptest.Server
+	mutex    sync.Mutex
+	replies  []string
+	messages []string // 받은 메시지 내용 (모델로 전송된 내용 검사용)
+}
+
+// newFakeLLM - 가짜 AI 서버 생성 (응답이 남아 있지 않으면 500)
+func newFakeLLM(t *testing.T) *fakeLLM {
+	llm := &fakeLLM{}
+	llm.server = httptest.NewServer(http.HandlerFunc(llm.handle))
+	t.Cleanup(llm.server.Close)
+	return llm
+}
+
+// reply - 다음 호출에 돌려줄 응답 추가
+func (llm *fakeLLM) reply(contents ...string) {
+	llm.mutex.Lock()
+	defer llm.mutex.Unlock()
+	llm.replies = append(llm.replies, contents...)
+}
+
+// sent - 지금까지 모델로 전송된 메시지 전체
+func (llm *fakeLLM) sent() string {
+	llm.mutex.Lock()
+	defer llm.mutex.Unlock()
+	return strings.Join(llm.messages, "\n")
+}
+
+// handle - /v1/chat/completions 요청 처리
+func (llm *fakeLLM) handle(w http.ResponseWriter, r *http.Request) {
+	var request model.DeepSeekRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || len(request.Messages) == 0 {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	llm.mutex.Lock()
+	for _, message := range request.Messages {
+		llm.messages = append(llm.messages, message.Content)
+	}
+	if len(llm.replies) == 0 {
+		llm.mutex.Unlock()
+		http.Error(w, "model unavailable", http.StatusInternalServerError)
+		return
+	}
+	content := llm.replies[0]
+	llm.replies = llm.replies[1:]
+	llm.mutex.Unlock()
+
+	json.NewEncoder(w).Encode(model.DeepSeekResponse{
+		Choices: []model.DeepSeekChoice{{Message: model.DeepSeekMessage{Role: "assistant", Content: content}}},
+	})
+}
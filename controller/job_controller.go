@@ -0,0 +1,264 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+	"mykubeapp/terminal"
+)
+
+// JobController - 비동기 작업 컨트롤러 (Git 적용, AI 생성 및 적용을 작업으로 실행)
+type JobController struct {
+	jobService *service.JobService
+	gitService *service.GitService
+	aiService  *service.AIService
+}
+
+// NewJobController - 작업 컨트롤러 생성자
+func NewJobController() *JobController {
+	return &JobController{
+		jobService: service.NewJobService(),
+		gitService: service.NewGitService(),
+		aiService:  service.NewAIService("http://localhost:11434"), // DeepSeek URL
+	}
+}
+
+// SubmitGitApply - Git 레포지토리 YAML 적용 작업 제출 (POST /api/jobs/git-apply)
+func (jc *JobController) SubmitGitApply(w http.ResponseWriter, r *http.Request) {
+	log.Println("🧵 POST /api/jobs/git-apply - Git 적용 작업 제출 요청")
+
+	var request model.GitApplyRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(request.RepoURL) == "" {
+		http.Error(w, "레포지토리 URL은 필수입니다", http.StatusBadRequest)
+		return
+	}
+
+	job, err := jc.jobService.Submit(model.JobTypeGitApply, request.RepoURL, func(run *service.JobRun) (interface{}, error) {
+		// 취소되어 일부만 적용된 경우에도 적용 결과를 남김
+		data, err := jc.gitService.ApplyRepositoryJob(run, request)
+		if data == nil {
+			return nil, err
+		}
+		return data, err
+	})
+	jc.writeSubmitted(w, job, err)
+}
+
+// SubmitAIGenerateApply - AI YAML 생성 및 적용 작업 제출 (POST /api/jobs/ai-generate-apply)
+func (jc *JobController) SubmitAIGenerateApply(w http.ResponseWriter, r *http.Request) {
+	log.Println("🧵 POST /api/jobs/ai-generate-apply - AI 생성 및 적용 작업 제출 요청")
+
+	var request model.AIApplyRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(request.Prompt) == "" {
+		http.Error(w, "프롬프트는 필수입니다", http.StatusBadRequest)
+		return
+	}
+
+	job, err := jc.jobService.Submit(model.JobTypeAIGenerateApply, request.Prompt, func(run *service.JobRun) (interface{}, error) {
+		if isGitRelatedPrompt(request.Prompt) {
+			return jc.runGitPrompt(run, request)
+		}
+		response, err := jc.aiService.GenerateAndApplyYamlJob(run, request)
+		if err != nil {
+			// 스키마 검증 실패는 오류 목록을 결과로 남김
+			var schemaFailure *service.SchemaValidationFailedError
+			if errors.As(err, &schemaFailure) {
+				return schemaFailure.Result, err
+			}
+			return nil, err
+		}
+		return response.Data, nil
+	})
+	jc.writeSubmitted(w, job, err)
+}
+
+// runGitPrompt - Git 관련 프롬프트는 AI로 레포지토리 정보를 파싱한 뒤 Git 적용 작업으로 실행
+func (jc *JobController) runGitPrompt(run *service.JobRun, request model.AIApplyRequest) (interface{}, error) {
+	run.Logf("Git 관련 프롬프트 감지, 레포지토리 정보 파싱")
+
+	parsed, err := jc.aiService.ProcessGitPrompt(run.Context(), request.Prompt)
+	if err != nil {
+		return nil, err
+	}
+	parseResult := parsed.Data.ParsedRequest
+	if parseResult.RepoURL == "" {
+		return nil, errors.New("레포지토리 URL을 찾을 수 없습니다. 명확한 레포지토리 주소를 입력해주세요.")
+	}
+
+	data, err := jc.gitService.ApplyRepositoryJob(run, model.GitApplyRequest{
+		RepoURL:   parseResult.RepoURL,
+		Branch:    parseResult.Branch,
+		Filename:  parseResult.Filename,
+		Namespace: parseResult.Namespace,
+		DryRun:    parseResult.DryRun || request.DryRun,
+	})
+	if data == nil {
+		return nil, err
+	}
+	return data, err
+}
+
+// writeSubmitted - 작업 제출 응답 (202 Accepted)
+func (jc *JobController) writeSubmitted(w http.ResponseWriter, job *model.Job, err error) {
+	if err != nil {
+		http.Error(w, "작업 제출 실패: "+err.Error(), http.StatusTooManyRequests)
+		return
+	}
+
+	response := model.JobResponse{}
+	response.Success = true
+	response.Message = "작업이 제출되었습니다"
+	response.Data = *job
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/api/jobs/"+job.ID)
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListJobs - 작업 목록 조회 (GET /api/jobs)
+func (jc *JobController) ListJobs(w http.ResponseWriter, r *http.Request) {
+	log.Println("🧵 GET /api/jobs - 작업 목록 조회 요청")
+
+	response := model.JobListResponse{}
+	response.Success = true
+	response.Message = "작업 목록 조회 성공"
+	response.Data = jc.jobService.ListJobs()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetJob - 작업 상태 및 진행 상황 조회 (GET /api/jobs/{id})
+func (jc *JobController) GetJob(w http.ResponseWriter, r *http.Request) {
+	log.Println("🧵 GET /api/jobs/{id} - 작업 상태 조회 요청")
+
+	job, err := jc.jobService.GetJob(mux.Vars(r)["id"])
+	if err != nil {
+		writeJobError(w, err)
+		return
+	}
+
+	response := model.JobResponse{}
+	response.Success = true
+	response.Message = "작업 상태 조회 성공"
+	response.Data = *job
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetJobLogs - 작업 로그 조회 (GET /api/jobs/{id}/logs?since=N)
+func (jc *JobController) GetJobLogs(w http.ResponseWriter, r *http.Request) {
+	log.Println("🧵 GET /api/jobs/{id}/logs - 작업 로그 조회 요청")
+
+	since := 0
+	if value := r.URL.Query().Get("since"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			http.Error(w, "since 값이 올바르지 않습니다: "+value, http.StatusBadRequest)
+			return
+		}
+		since = parsed
+	}
+
+	logs, err := jc.jobService.GetLogs(mux.Vars(r)["id"], since)
+	if err != nil {
+		writeJobError(w, err)
+		return
+	}
+
+	response := model.JobLogsResponse{}
+	response.Success = true
+	response.Message = "작업 로그 조회 성공"
+	response.Data = logs
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetJobResult - 종료된 작업의 결과 조회 (GET /api/jobs/{id}/result)
+func (jc *JobController) GetJobResult(w http.ResponseWriter, r *http.Request) {
+	log.Println("🧵 GET /api/jobs/{id}/result - 작업 결과 조회 요청")
+
+	job, result, err := jc.jobService.GetResult(mux.Vars(r)["id"])
+	if err != nil {
+		writeJobError(w, err)
+		return
+	}
+
+	response := model.JobResultResponse{}
+	response.Success = job.Status == model.JobStatusSucceeded
+	response.Message = "작업 결과 조회 성공"
+	response.Status = job.Status
+	response.Error = job.Error
+	response.Data = result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// CancelJob - 작업 취소 (POST /api/jobs/{id}/cancel)
+func (jc *JobController) CancelJob(w http.ResponseWriter, r *http.Request) {
+	log.Println("🛑 POST /api/jobs/{id}/cancel - 작업 취소 요청")
+
+	job, err := jc.jobService.CancelJob(mux.Vars(r)["id"])
+	if err != nil {
+		writeJobError(w, err)
+		return
+	}
+
+	response := model.JobResponse{}
+	response.Success = true
+	response.Message = "작업 취소를 요청했습니다"
+	if job.FinishedTime != "" {
+		response.Message = "이미 종료된 작업입니다"
+	}
+	response.Data = *job
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// StreamJobEvents - 작업 진행 이벤트 스트리밍 (GET /api/jobs/{id}/events, SSE 또는 WebSocket)
+func (jc *JobController) StreamJobEvents(w http.ResponseWriter, r *http.Request) {
+	log.Println("📡 GET /api/jobs/{id}/events - 작업 이벤트 스트리밍 요청")
+
+	job, events, unsubscribe, err := jc.jobService.Subscribe(mux.Vars(r)["id"])
+	if err != nil {
+		writeJobError(w, err)
+		return
+	}
+	terminal.JobEventsHandler(w, r, *job, events, unsubscribe)
+}
+
+// writeJobError - 작업 조회 오류를 상태 코드로 변환 (없음 404, 미종료 409)
+func writeJobError(w http.ResponseWriter, err error) {
+	var notFound *service.JobNotFoundError
+	if errors.As(err, &notFound) {
+		http.Error(w, notFound.Error(), http.StatusNotFound)
+		return
+	}
+	var notFinished *service.JobNotFinishedError
+	if errors.As(err, &notFinished) {
+		http.Error(w, notFinished.Error(), http.StatusConflict)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
@@ -2,14 +2,17 @@ package controller
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/gorilla/mux"
 	"log"
 	"net/http"
 	"strings"
 
+	"mykubeapp/kubernetes"
 	"mykubeapp/model"
 	"mykubeapp/service"
+	"mykubeapp/utils"
 )
 
 // KubeController - Spring의 @RestController와 유사한 역할
@@ -19,8 +22,13 @@ type KubeController struct {
 
 // NewKubeController - 컨트롤러 생성자 (Spring의 @Autowired 역할)
 func NewKubeController() *KubeController {
+	return NewKubeControllerWithService(service.NewKubeService())
+}
+
+// NewKubeControllerWithService - 지정한 서비스를 사용하는 컨트롤러 생성자 (테스트에서 가짜 실행기 주입)
+func NewKubeControllerWithService(kubeService *service.KubeService) *KubeController {
 	return &KubeController{
-		kubeService: service.NewKubeService(),
+		kubeService: kubeService,
 	}
 }
 
@@ -53,8 +61,11 @@ func (kc *KubeController) AddConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := kc.kubeService.AddConfig(request)
+	err := kc.kubeService.AddConfig(r.Context(), request)
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "Config 추가 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -72,8 +83,11 @@ func (kc *KubeController) AddConfig(w http.ResponseWriter, r *http.Request) {
 func (kc *KubeController) GetContexts(w http.ResponseWriter, r *http.Request) {
 	log.Println("📋 GET /api/contexts - context 목록 조회 요청")
 
-	contexts, err := kc.kubeService.GetContexts()
+	contexts, err := kc.kubeService.GetContexts(r.Context())
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "Context 목록을 가져올 수 없습니다: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -97,8 +111,11 @@ func (kc *KubeController) UseContext(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := kc.kubeService.UseContext(request.ContextName)
+	err := kc.kubeService.UseContext(r.Context(), request.ContextName)
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "Context 변경 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -128,8 +145,11 @@ func (kc *KubeController) DeleteContext(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	err := kc.kubeService.DeleteContext(request.ContextName)
+	err := kc.kubeService.DeleteContext(r.Context(), request.ContextName)
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "Context 삭제 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -187,8 +207,14 @@ func (kc *KubeController) ApplyYaml(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := kc.kubeService.ApplyYaml(request)
+	result, err := kc.kubeService.ApplyYaml(r.Context(), request)
 	if err != nil {
+		if writeCommandTimeout(w, err) || writePolicyViolation(w, err) || writePermissionDenied(w, err) {
+			return
+		}
+		if writeUndefinedVariables(w, err) || writeApplyConflict(w, err) {
+			return
+		}
 		http.Error(w, "YAML 적용 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -222,8 +248,11 @@ func (kc *KubeController) DeleteYaml(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := kc.kubeService.DeleteYaml(request)
+	result, err := kc.kubeService.DeleteYaml(r.Context(), request)
 	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
 		http.Error(w, "YAML 삭제 실패: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -236,3 +265,24 @@ func (kc *KubeController) DeleteYaml(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
 }
+
+// writeCommandTimeout - kubectl/git/helm 실행 시간 초과이면 504를 응답하고 true 반환
+func writeCommandTimeout(w http.ResponseWriter, err error) bool {
+	var timeout *utils.CommandTimeoutError
+	if !errors.As(err, &timeout) {
+		return false
+	}
+
+	http.Error(w, "클러스터 또는 외부 명령 응답 시간 초과: "+timeout.Error(), http.StatusGatewayTimeout)
+	return true
+}
+
+// writeApplyConflict - server-side apply 필드 소유권 충돌이면 409를 응답하고 true 반환 (forceConflicts로 덮어쓸 수 있음)
+func writeApplyConflict(w http.ResponseWriter, err error) bool {
+	if !kubernetes.IsConflict(err) {
+		return false
+	}
+
+	http.Error(w, "YAML 적용 충돌: "+err.Error(), http.StatusConflict)
+	return true
+}
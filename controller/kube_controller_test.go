@@ -0,0 +1,368 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+const testConfigMapYaml = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: web-config
+data:
+  image: ${IMAGE}
+`
+
+func TestGetContexts(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.
+		On("kubectl config get-contexts", "dev\nprod\n").
+		On("kubectl config current-context", "prod\n")
+
+	recorder := env.do(t, http.MethodGet, "/api/contexts", nil)
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.ContextsResponse
+	decodeResponse(t, recorder, &response)
+	expected := []model.ContextInfo{{Name: "dev"}, {Name: "prod", IsCurrent: true}}
+	if !response.Success || !reflect.DeepEqual(response.Data, expected) {
+		t.Fatalf("context 목록 = %+v, 기대값 %+v", response.Data, expected)
+	}
+}
+
+func TestGetContextsCommandFailure(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.OnError("kubectl config get-contexts", errors.New("kubeconfig 없음"))
+
+	recorder := env.do(t, http.MethodGet, "/api/contexts", nil)
+	expectStatus(t, recorder, http.StatusInternalServerError)
+	if !strings.Contains(recorder.Body.String(), "kubeconfig 없음") {
+		t.Fatalf("오류 메시지에 원인이 없습니다: %s", recorder.Body.String())
+	}
+}
+
+func TestGetContextsTimeout(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.OnError("kubectl config get-contexts", &utils.CommandTimeoutError{
+		Command: "kubectl config get-contexts",
+		Timeout: 15 * time.Second,
+	})
+
+	recorder := env.do(t, http.MethodGet, "/api/contexts", nil)
+	expectStatus(t, recorder, http.StatusGatewayTimeout)
+}
+
+func TestUseContext(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.On("kubectl config use-context", "Switched to context \"prod\".")
+
+	recorder := env.do(t, http.MethodPost, "/api/context/use", model.UseContextRequest{ContextName: "prod"})
+	expectStatus(t, recorder, http.StatusOK)
+
+	calls := env.executor.CallsTo("kubectl config use-context prod")
+	if len(calls) != 1 {
+		t.Fatalf("use-context 호출 수 = %d, 기대값 1", len(calls))
+	}
+}
+
+func TestUseContextInvalidBody(t *testing.T) {
+	env := newTestEnv(t)
+
+	recorder := env.do(t, http.MethodPost, "/api/context/use", "{not json")
+	expectStatus(t, recorder, http.StatusBadRequest)
+	if calls := env.executor.Calls(); len(calls) != 0 {
+		t.Fatalf("명령이 실행되지 않아야 합니다: %s", calls[0].Command())
+	}
+}
+
+func TestDeleteContextRejectsCurrentContext(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.On("kubectl config current-context", "prod\n")
+
+	recorder := env.do(t, http.MethodDelete, "/api/context", model.DeleteContextRequest{ContextName: "prod"})
+	expectStatus(t, recorder, http.StatusInternalServerError)
+	if !strings.Contains(recorder.Body.String(), "현재 사용 중인 컨텍스트") {
+		t.Fatalf("현재 context 삭제 거부 메시지가 없습니다: %s", recorder.Body.String())
+	}
+	expectNoCalls(t, env.executor, "kubectl config delete-context")
+}
+
+func TestDeleteContextRequiresName(t *testing.T) {
+	env := newTestEnv(t)
+
+	recorder := env.do(t, http.MethodDelete, "/api/context", model.DeleteContextRequest{ContextName: " "})
+	expectStatus(t, recorder, http.StatusBadRequest)
+}
+
+func TestDeleteContext(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.
+		On("kubectl config current-context", "dev\n").
+		On("kubectl config get-contexts", "dev\nstaging\n").
+		On("kubectl config delete-context", "deleted context staging")
+
+	recorder := env.do(t, http.MethodDelete, "/api/context", model.DeleteContextRequest{ContextName: "staging"})
+	expectStatus(t, recorder, http.StatusOK)
+	if calls := env.executor.CallsTo("kubectl config delete-context staging"); len(calls) != 1 {
+		t.Fatalf("delete-context 호출 수 = %d, 기대값 1", len(calls))
+	}
+}
+
+func TestAddConfig(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.
+		On("kubectl config set-cluster", "").
+		On("kubectl config set-credentials", "").
+		On("kubectl config set-context", "")
+
+	recorder := env.do(t, http.MethodPost, "/api/config", model.AddConfigRequest{
+		ClusterName: "lab",
+		Server:      "https://10.0.0.1:6443",
+		ContextName: "lab",
+		User:        "admin",
+		Token:       "secret-token",
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var commands []string
+	for _, call := range env.executor.Calls() {
+		commands = append(commands, strings.Join(call.Args[:2], " "))
+	}
+	expected := []string{"config set-cluster", "config set-credentials", "config set-context"}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Fatalf("명령 순서 = %v, 기대값 %v", commands, expected)
+	}
+}
+
+func TestAddConfigStopsOnClusterFailure(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.OnError("kubectl config set-cluster", errors.New("invalid server"))
+
+	recorder := env.do(t, http.MethodPost, "/api/config", model.AddConfigRequest{ClusterName: "lab", Server: "bad", ContextName: "lab", User: "admin"})
+	expectStatus(t, recorder, http.StatusInternalServerError)
+	expectNoCalls(t, env.executor, "kubectl config set-credentials")
+}
+
+func TestGetConfig(t *testing.T) {
+	env := newTestEnv(t)
+
+	recorder := env.do(t, http.MethodGet, "/api/config", nil)
+	expectStatus(t, recorder, http.StatusInternalServerError)
+
+	writeKubeConfig(t, env.home)
+	recorder = env.do(t, http.MethodGet, "/api/config", nil)
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.ConfigResponse
+	decodeResponse(t, recorder, &response)
+	if !strings.Contains(response.Data, "current-context: dev") {
+		t.Fatalf("config 내용이 응답에 없습니다: %s", response.Data)
+	}
+}
+
+func TestGetContextDetail(t *testing.T) {
+	env := newTestEnv(t)
+	writeKubeConfig(t, env.home)
+
+	recorder := env.do(t, http.MethodGet, "/api/context/dev", nil)
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.ContextDetailResponse
+	decodeResponse(t, recorder, &response)
+	detail := response.Data
+	if !detail.IsCurrent || detail.Cluster.Server != "https://dev.example.com:6443" || detail.User.AuthenticationMethod != "Token" {
+		t.Fatalf("context 상세 정보가 올바르지 않습니다: %+v", detail)
+	}
+
+	recorder = env.do(t, http.MethodGet, "/api/context/missing", nil)
+	expectStatus(t, recorder, http.StatusInternalServerError)
+}
+
+func TestApplyYaml(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.On("kubectl config current-context", "dev\n")
+	onPermissionCheck(t, env.executor, "")
+	applies := onManifest(t, env.executor, "kubectl apply", nil)
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{
+		YamlContent: testConfigMapYaml,
+		Namespace:   "demo",
+		Variables:   map[string]string{"IMAGE": "nginx:1.25"},
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.ApplyYamlResponse
+	decodeResponse(t, recorder, &response)
+	if !reflect.DeepEqual(response.Data.Resources, []string{"configmap/web-config"}) {
+		t.Fatalf("적용 리소스 = %v", response.Data.Resources)
+	}
+	if response.Data.ResolvedVariables["IMAGE"] != "nginx:1.25" {
+		t.Fatalf("치환 변수가 응답에 없습니다: %+v", response.Data.ResolvedVariables)
+	}
+	applied := applies.last(t)
+	if !strings.Contains(applied.content, "image: nginx:1.25") {
+		t.Fatalf("치환된 YAML이 적용되지 않았습니다:\n%s", applied.content)
+	}
+	if call := applied.call; !strings.Contains(call.Command(), "-n demo") {
+		t.Fatalf("네임스페이스 인자가 없습니다: %s", call.Command())
+	}
+}
+
+func TestApplyYamlDryRunWithContext(t *testing.T) {
+	env := newTestEnv(t)
+	onPermissionCheck(t, env.executor, "prod")
+	env.executor.On("kubectl --context prod apply", "configmap/web-config created (dry run)\n")
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{
+		YamlContent: testConfigMapYaml,
+		Context:     "prod",
+		DryRun:      true,
+		Variables:   map[string]string{"IMAGE": "nginx:1.25"},
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	call := env.executor.CallsTo("kubectl --context prod apply")[0]
+	if !strings.Contains(call.Command(), "--dry-run=client") {
+		t.Fatalf("dry-run 인자가 없습니다: %s", call.Command())
+	}
+	expectNoCalls(t, env.executor, "kubectl config current-context")
+}
+
+func TestApplyYamlUndefinedVariable(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.On("kubectl config current-context", "dev\n")
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{
+		YamlContent: testConfigMapYaml,
+		Variables:   map[string]string{"TAG": "1.25"},
+	})
+	expectStatus(t, recorder, http.StatusBadRequest)
+	if !strings.Contains(recorder.Body.String(), "IMAGE") {
+		t.Fatalf("정의되지 않은 변수 이름이 응답에 없습니다: %s", recorder.Body.String())
+	}
+	expectNoCalls(t, env.executor, "kubectl apply")
+}
+
+func TestApplyYamlWithoutVariablesKeepsPlaceholders(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.On("kubectl config current-context", "dev\n")
+	onPermissionCheck(t, env.executor, "")
+	applies := onManifest(t, env.executor, "kubectl apply", nil)
+
+	// 환경/변수를 지정하지 않으면 ${IMAGE}를 치환하지 않고 그대로 적용
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{YamlContent: testConfigMapYaml, Namespace: "demo"})
+	expectStatus(t, recorder, http.StatusOK)
+	if applied := applies.last(t).content; !strings.Contains(applied, "${IMAGE}") {
+		t.Fatalf("원본 YAML이 그대로 적용되어야 합니다:\n%s", applied)
+	}
+}
+
+func TestApplyYamlPolicyViolation(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.On("kubectl config current-context", "dev\n")
+	policy := "default:\n  rules:\n    no-latest-tag: deny\n"
+	if err := os.WriteFile(os.Getenv("POLICY_CONFIG"), []byte(policy), 0644); err != nil {
+		t.Fatalf("정책 파일 작성 실패: %v", err)
+	}
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{YamlContent: `apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+spec:
+  containers:
+  - name: web
+    image: nginx:latest
+`})
+	expectStatus(t, recorder, http.StatusForbidden)
+	expectNoCalls(t, env.executor, "kubectl apply")
+}
+
+func TestApplyYamlRequiresContent(t *testing.T) {
+	env := newTestEnv(t)
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{YamlContent: "  "})
+	expectStatus(t, recorder, http.StatusBadRequest)
+}
+
+func TestApplyYamlRejectsInvalidNamespace(t *testing.T) {
+	env := newTestEnv(t)
+
+	// 플래그처럼 보이는 네임스페이스는 kubectl 인자로 해석되기 전에 거부
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{YamlContent: testConfigMapYaml, Namespace: "--kubeconfig=/tmp/x", CreateNamespace: true})
+	expectStatus(t, recorder, http.StatusInternalServerError)
+	if !strings.Contains(recorder.Body.String(), "잘못된 네임스페이스 이름입니다") {
+		t.Fatalf("응답 = %s", recorder.Body.String())
+	}
+	if calls := env.executor.Calls(); len(calls) != 0 {
+		t.Fatalf("kubectl이 호출되지 않아야 합니다: %v", calls)
+	}
+}
+
+func TestApplyYamlCommandFailureAndTimeout(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.
+		On("kubectl config current-context", "dev\n").
+		OnError("kubectl apply", errors.New("connection refused"))
+	onPermissionCheck(t, env.executor, "")
+	request := model.ApplyYamlRequest{YamlContent: testConfigMapYaml, Variables: map[string]string{"IMAGE": "nginx"}}
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", request)
+	expectStatus(t, recorder, http.StatusInternalServerError)
+
+	env.executor.OnError("kubectl apply", &utils.CommandTimeoutError{Command: "kubectl apply", Timeout: 3 * time.Minute})
+	recorder = env.do(t, http.MethodPost, "/api/apply", request)
+	expectStatus(t, recorder, http.StatusGatewayTimeout)
+}
+
+func TestDeleteYaml(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.On("kubectl delete", "configmap/web-config deleted\n")
+
+	recorder := env.do(t, http.MethodPost, "/api/delete", model.DeleteYamlRequest{YamlContent: testConfigMapYaml, Namespace: "demo"})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.ApplyYamlResponse
+	decodeResponse(t, recorder, &response)
+	if !reflect.DeepEqual(response.Data.Resources, []string{"configmap/web-config"}) {
+		t.Fatalf("삭제 리소스 = %v", response.Data.Resources)
+	}
+	call := env.executor.CallsTo("kubectl delete")[0]
+	if !strings.Contains(call.Command(), "--ignore-not-found=true") {
+		t.Fatalf("--ignore-not-found 인자가 없습니다: %s", call.Command())
+	}
+}
+
+// writeKubeConfig - 테스트 HOME에 dev/prod context가 있는 kubeconfig 작성
+func writeKubeConfig(t *testing.T, home string) {
+	t.Helper()
+	config := `apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://dev.example.com:6443
+contexts:
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: dev-user
+users:
+- name: dev-user
+  user:
+    token: abc
+`
+	if err := utils.WriteFile(filepath.Join(home, ".kube", "config"), config); err != nil {
+		t.Fatalf("kubeconfig 작성 실패: %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+	"mykubeapp/utils"
+)
+
+// 업로드 요청 최대 크기
+const maxUploadSize = 64 << 20 // 64MB
+
+// KustomizeController - 업로드된 kustomize 디렉토리 빌드/적용 컨트롤러
+type KustomizeController struct {
+	kustomizeService *service.KustomizeService
+	kubeService      *service.KubeService
+}
+
+// NewKustomizeController - kustomize 컨트롤러 생성자
+func NewKustomizeController() *KustomizeController {
+	return &KustomizeController{
+		kustomizeService: service.NewKustomizeService(),
+		kubeService:      service.NewKubeService(),
+	}
+}
+
+// BuildUpload - 업로드한 아카이브의 kustomization 빌드 미리보기 (POST /api/kustomize/build)
+// multipart 필드: archive(파일), path(overlay 경로, 선택)
+func (kc *KustomizeController) BuildUpload(w http.ResponseWriter, r *http.Request) {
+	log.Println("🏗️ POST /api/kustomize/build - kustomize 빌드 미리보기 요청")
+
+	workDir, err := extractUploadedArchive(r, "archive")
+	if err != nil {
+		http.Error(w, "업로드 처리 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer os.RemoveAll(workDir)
+
+	build, err := kc.build(r.Context(), workDir, r.FormValue("path"))
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "kustomize 빌드 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.KustomizeBuildResponse{}
+	response.Success = true
+	response.Message = fmt.Sprintf("kustomize 빌드 완료 (%d개 리소스)", len(build.Resources))
+	response.Data = *build
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ApplyUpload - 업로드한 아카이브의 kustomization 빌드 후 적용 (POST /api/kustomize/apply)
+// multipart 필드: archive(파일), path(overlay 경로, 선택), namespace, dryRun
+func (kc *KustomizeController) ApplyUpload(w http.ResponseWriter, r *http.Request) {
+	log.Println("🚀 POST /api/kustomize/apply - kustomize 빌드 및 적용 요청")
+
+	workDir, err := extractUploadedArchive(r, "archive")
+	if err != nil {
+		http.Error(w, "업로드 처리 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer os.RemoveAll(workDir)
+
+	build, err := kc.build(r.Context(), workDir, r.FormValue("path"))
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "kustomize 빌드 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	dryRun, _ := strconv.ParseBool(r.FormValue("dryRun"))
+	applyRequest := model.ApplyYamlRequest{
+		YamlContent: build.RenderedYaml,
+		Namespace:   r.FormValue("namespace"),
+		DryRun:      dryRun,
+	}
+
+	applyResult, err := kc.kubeService.ApplyYaml(r.Context(), applyRequest)
+	if err != nil {
+		if writeCommandTimeout(w, err) || writePolicyViolation(w, err) || writePermissionDenied(w, err) {
+			return
+		}
+		http.Error(w, "YAML 적용 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.KustomizeApplyResponse{}
+	response.Success = true
+	if dryRun {
+		response.Message = "kustomize 빌드 및 dry-run 완료"
+	} else {
+		response.Message = "kustomize 빌드 및 적용 완료"
+	}
+	response.Data = model.KustomizeApplyResult{
+		Build:       *build,
+		ApplyResult: *applyResult,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// build - 압축 해제된 디렉토리에서 빌드 대상을 선택하여 빌드
+func (kc *KustomizeController) build(ctx context.Context, workDir, path string) (*model.KustomizeBuildResult, error) {
+	targets, err := kc.kustomizeService.FindKustomizations(workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := kc.kustomizeService.SelectTarget(targets, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return kc.kustomizeService.Build(ctx, workDir, target.Path)
+}
+
+// extractUploadedArchive - multipart 업로드 아카이브를 임시 디렉토리에 압축 해제
+func extractUploadedArchive(r *http.Request, field string) (string, error) {
+	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+		return "", fmt.Errorf("multipart 요청 파싱 실패: %v", err)
+	}
+
+	file, header, err := r.FormFile(field)
+	if err != nil {
+		return "", fmt.Errorf("'%s' 파일이 필요합니다", field)
+	}
+	defer file.Close()
+
+	workDir, err := os.MkdirTemp("", "mykubeapp-upload-")
+	if err != nil {
+		return "", fmt.Errorf("임시 디렉토리 생성 실패: %v", err)
+	}
+
+	if err := utils.ExtractArchive(header.Filename, file, workDir); err != nil {
+		os.RemoveAll(workDir)
+		return "", err
+	}
+
+	log.Printf("📦 업로드 아카이브 압축 해제 완료: %s -> %s", header.Filename, workDir)
+	return workDir, nil
+}
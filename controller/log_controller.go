@@ -0,0 +1,21 @@
+package controller
+
+import (
+	"log"
+	"mykubeapp/terminal"
+	"net/http"
+)
+
+// LogController - 파드 로그 스트리밍 컨트롤러
+type LogController struct{}
+
+// NewLogController - 로그 컨트롤러 생성자
+func NewLogController() *LogController {
+	return &LogController{}
+}
+
+// StreamPodLogs - 파드 로그 스트리밍 (GET /api/logs, WebSocket 또는 SSE)
+func (lc *LogController) StreamPodLogs(w http.ResponseWriter, r *http.Request) {
+	log.Println("📜 GET /api/logs - 파드 로그 스트리밍 요청")
+	terminal.PodLogsHandler(w, r)
+}
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// MultiClusterController - 여러 context 동시 적용 및 context 그룹 컨트롤러
+type MultiClusterController struct {
+	multiClusterService *service.MultiClusterService
+}
+
+// NewMultiClusterController - 다중 context 컨트롤러 생성자
+func NewMultiClusterController() *MultiClusterController {
+	return NewMultiClusterControllerWithService(service.NewMultiClusterService())
+}
+
+// NewMultiClusterControllerWithService - 지정한 서비스를 사용하는 컨트롤러 생성자 (테스트에서 가짜 실행기 주입)
+func NewMultiClusterControllerWithService(multiClusterService *service.MultiClusterService) *MultiClusterController {
+	return &MultiClusterController{
+		multiClusterService: multiClusterService,
+	}
+}
+
+// GetContextGroups - context 그룹 조회 (GET /api/context-groups)
+func (mc *MultiClusterController) GetContextGroups(w http.ResponseWriter, r *http.Request) {
+	log.Println("🗂️ GET /api/context-groups - context 그룹 조회 요청")
+
+	config, err := mc.multiClusterService.GetContextGroups()
+	if err != nil {
+		http.Error(w, "context 그룹 조회 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.ContextGroupConfigResponse{}
+	response.Success = true
+	response.Message = "context 그룹 조회 성공"
+	response.Data = *config
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// UpdateContextGroups - context 그룹 저장 (PUT /api/context-groups)
+func (mc *MultiClusterController) UpdateContextGroups(w http.ResponseWriter, r *http.Request) {
+	log.Println("🗂️ PUT /api/context-groups - context 그룹 변경 요청")
+
+	var request model.ContextGroupConfig
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	if err := mc.multiClusterService.SaveContextGroups(request); err != nil {
+		http.Error(w, "context 그룹 저장 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.BaseResponse{
+		Success: true,
+		Message: "context 그룹이 성공적으로 저장되었습니다",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ApplyToContexts - 여러 context에 YAML 적용 (POST /api/apply/multi)
+// context별 성공/실패는 결과 매트릭스로 응답하며, 요청 자체가 잘못된 경우에만 4xx
+func (mc *MultiClusterController) ApplyToContexts(w http.ResponseWriter, r *http.Request) {
+	log.Println("🌐 POST /api/apply/multi - 다중 context YAML 적용 요청")
+
+	var request model.MultiClusterApplyRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	// YAML 내용 검증
+	if strings.TrimSpace(request.YamlContent) == "" {
+		http.Error(w, "YAML 내용은 필수입니다", http.StatusBadRequest)
+		return
+	}
+
+	result, err := mc.multiClusterService.ApplyToContexts(r.Context(), request)
+	if err != nil {
+		if writeCommandTimeout(w, err) || writeUndefinedVariables(w, err) {
+			return
+		}
+		http.Error(w, "다중 context 적용 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.MultiClusterApplyResponse{}
+	response.Success = result.Failed == 0
+	response.Message = fmt.Sprintf("다중 context 적용 완료 (성공 %d, 실패 %d, 건너뜀 %d)", result.Succeeded, result.Failed, result.Skipped)
+	response.Data = *result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
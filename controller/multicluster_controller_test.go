@@ -0,0 +1,242 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"mykubeapp/model"
+	"mykubeapp/utils/utilstest"
+)
+
+// appliedContext - kubectl --context X apply ... 호출의 대상 context
+func appliedContext(call utilstest.FakeCommandCall) string {
+	if len(call.Args) >= 2 && call.Args[0] == "--context" {
+		return call.Args[1]
+	}
+	return ""
+}
+
+// isApplyCall - kubectl --context X apply 호출 여부 (권한 사전 검사 호출 제외)
+func isApplyCall(call utilstest.FakeCommandCall) bool {
+	return len(call.Args) > 2 && call.Args[2] == "apply"
+}
+
+// applyCalls - context별 apply 호출 목록
+func applyCalls(executor *utilstest.FakeExecutor) []utilstest.FakeCommandCall {
+	var calls []utilstest.FakeCommandCall
+	for _, call := range executor.CallsTo("kubectl --context") {
+		if isApplyCall(call) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// testContexts - 가짜 kubeconfig의 context 목록
+var testContexts = []string{"dev", "stage", "prod-a", "prod-b"}
+
+// onApplyToContexts - context 목록 조회와 context별 권한 사전 검사, apply 응답 등록 (failing에 있는 context는 실패)
+func onApplyToContexts(t *testing.T, executor *utilstest.FakeExecutor, failing ...string) {
+	onContextApply(t, executor, func(call utilstest.FakeCommandCall, manifest string) (string, error) {
+		if slices.Contains(failing, appliedContext(call)) {
+			return "", errors.New("connection refused")
+		}
+		return kubectlOutputs["apply"](call, manifest)
+	})
+}
+
+// onContextApply - context 목록 조회와 모든 context의 권한 사전 검사(통과), apply 응답 등록
+func onContextApply(t *testing.T, executor *utilstest.FakeExecutor, respond manifestResponder) {
+	executor.On("kubectl config get-contexts", strings.Join(testContexts, "\n")+"\n")
+	for _, contextName := range testContexts {
+		onPermissionCheck(t, executor, contextName)
+		onManifest(t, executor, kubectlCommand(contextName, "apply"), respond)
+	}
+}
+
+func TestApplyToContexts(t *testing.T) {
+	env := newTestEnv(t)
+	onApplyToContexts(t, env.executor, "prod-b")
+
+	recorder := env.do(t, http.MethodPost, "/api/apply/multi", model.MultiClusterApplyRequest{
+		YamlContent: testDeploymentYaml,
+		Contexts:    []string{"dev", "prod-a", "prod-b", "dev"},
+		Namespace:   "web",
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.MultiClusterApplyResponse
+	decodeResponse(t, recorder, &response)
+	result := response.Data
+	if response.Success || result.Total != 3 || result.Succeeded != 2 || result.Failed != 1 {
+		t.Fatalf("결과 = 성공 여부 %t, 전체 %d, 성공 %d, 실패 %d", response.Success, result.Total, result.Succeeded, result.Failed)
+	}
+	for i, expected := range []string{"dev", "prod-a", "prod-b"} {
+		if result.Results[i].Context != expected {
+			t.Fatalf("결과 %d의 context = %s, 기대값 %s (중복 제거 후 요청 순서)", i, result.Results[i].Context, expected)
+		}
+	}
+	if result.Results[2].Status != model.MultiClusterStatusFailed || !strings.Contains(result.Results[2].Error, "connection refused") {
+		t.Fatalf("prod-b 결과 = %+v", result.Results[2])
+	}
+	if result.Results[0].Result == nil || result.Results[0].Result.Resources[0] != "deployment.apps/web" {
+		t.Fatalf("dev 적용 결과 = %+v", result.Results[0].Result)
+	}
+	for _, call := range applyCalls(env.executor) {
+		if !strings.Contains(call.Command(), "-n web") {
+			t.Fatalf("네임스페이스 인자가 없습니다: %s", call.Command())
+		}
+	}
+}
+
+func TestApplyToContextsCanaryFailure(t *testing.T) {
+	env := newTestEnv(t)
+	onApplyToContexts(t, env.executor, "stage")
+
+	recorder := env.do(t, http.MethodPost, "/api/apply/multi", model.MultiClusterApplyRequest{
+		YamlContent: testDeploymentYaml,
+		Contexts:    []string{"prod-a", "prod-b"},
+		Canary:      "stage",
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.MultiClusterApplyResponse
+	decodeResponse(t, recorder, &response)
+	result := response.Data
+	if !result.Results[0].Canary || result.Results[0].Context != "stage" || result.Failed != 1 || result.Skipped != 2 {
+		t.Fatalf("카나리 실패 시 나머지는 건너뛰어야 합니다: %+v", result)
+	}
+	if calls := applyCalls(env.executor); len(calls) != 1 {
+		t.Fatalf("카나리만 적용되어야 하지만 %d번 적용됨", len(calls))
+	}
+}
+
+func TestApplyToContextsStopOnFailure(t *testing.T) {
+	env := newTestEnv(t)
+	onApplyToContexts(t, env.executor, "stage")
+
+	recorder := env.do(t, http.MethodPost, "/api/apply/multi", model.MultiClusterApplyRequest{
+		YamlContent:   testDeploymentYaml,
+		Contexts:      []string{"dev", "stage", "prod-a", "prod-b"},
+		Concurrency:   1,
+		StopOnFailure: true,
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.MultiClusterApplyResponse
+	decodeResponse(t, recorder, &response)
+	statuses := []string{}
+	for _, result := range response.Data.Results {
+		statuses = append(statuses, result.Status)
+	}
+	if strings.Join(statuses, ",") != "succeeded,failed,skipped,skipped" {
+		t.Fatalf("상태 = %v", statuses)
+	}
+}
+
+func TestApplyToContextsBoundedConcurrency(t *testing.T) {
+	env := newTestEnv(t)
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+	onContextApply(t, env.executor, func(call utilstest.FakeCommandCall, manifest string) (string, error) {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		return kubectlOutputs["apply"](call, manifest)
+	})
+
+	recorder := env.do(t, http.MethodPost, "/api/apply/multi", model.MultiClusterApplyRequest{
+		YamlContent: testDeploymentYaml,
+		Contexts:    []string{"dev", "stage", "prod-a", "prod-b"},
+		Concurrency: 2,
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	if maxRunning != 2 {
+		t.Fatalf("최대 동시 적용 수 = %d, 기대값 2", maxRunning)
+	}
+}
+
+func TestApplyToContextsGroup(t *testing.T) {
+	env := newTestEnv(t)
+	onApplyToContexts(t, env.executor)
+
+	recorder := env.do(t, http.MethodPut, "/api/context-groups", model.ContextGroupConfig{Groups: map[string][]string{"prod": {"prod-a", "prod-b"}}})
+	expectStatus(t, recorder, http.StatusOK)
+
+	recorder = env.do(t, http.MethodPost, "/api/apply/multi", model.MultiClusterApplyRequest{
+		YamlContent: testDeploymentYaml,
+		Group:       "prod",
+		DryRun:      true,
+	})
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.MultiClusterApplyResponse
+	decodeResponse(t, recorder, &response)
+	if !response.Success || response.Data.Total != 2 || response.Data.Group != "prod" {
+		t.Fatalf("그룹 적용 결과 = %+v", response.Data)
+	}
+	for _, call := range applyCalls(env.executor) {
+		if !strings.Contains(call.Command(), "--dry-run=client") {
+			t.Fatalf("dry-run 인자가 없습니다: %s", call.Command())
+		}
+	}
+}
+
+func TestApplyToContextsRejectsInvalidTargets(t *testing.T) {
+	env := newTestEnv(t)
+	onApplyToContexts(t, env.executor)
+
+	for _, request := range []model.MultiClusterApplyRequest{
+		{YamlContent: testDeploymentYaml},
+		{YamlContent: testDeploymentYaml, Contexts: []string{"dev", "missing"}},
+		{YamlContent: testDeploymentYaml, Group: "unknown"},
+		{Contexts: []string{"dev"}},
+	} {
+		recorder := env.do(t, http.MethodPost, "/api/apply/multi", request)
+		expectStatus(t, recorder, http.StatusBadRequest)
+	}
+	expectNoCalls(t, env.executor, "kubectl --context")
+}
+
+func TestApplyToContextsVariables(t *testing.T) {
+	env := newTestEnv(t)
+	onApplyToContexts(t, env.executor)
+
+	// 변수를 지정했는데 누락되면 어느 context에도 적용하지 않음
+	recorder := env.do(t, http.MethodPost, "/api/apply/multi", model.MultiClusterApplyRequest{
+		YamlContent: testConfigMapYaml,
+		Contexts:    []string{"dev", "stage"},
+		Variables:   map[string]string{"TAG": "1.25"},
+	})
+	expectStatus(t, recorder, http.StatusBadRequest)
+	if calls := applyCalls(env.executor); len(calls) != 0 {
+		t.Fatalf("apply 호출 수 = %d, 기대값 0", len(calls))
+	}
+
+	// 변수를 지정하지 않으면 ApplyYaml과 같이 ${IMAGE}를 그대로 두고 모든 context에 적용
+	recorder = env.do(t, http.MethodPost, "/api/apply/multi", model.MultiClusterApplyRequest{
+		YamlContent: testConfigMapYaml,
+		Contexts:    []string{"dev", "stage"},
+	})
+	expectStatus(t, recorder, http.StatusOK)
+	var response model.MultiClusterApplyResponse
+	decodeResponse(t, recorder, &response)
+	if !response.Success || response.Data.Succeeded != 2 {
+		t.Fatalf("결과 = %+v", response.Data)
+	}
+}
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// NamespaceController - 네임스페이스 관리 컨트롤러
+type NamespaceController struct {
+	namespaceService *service.NamespaceService
+}
+
+// NewNamespaceController - 네임스페이스 컨트롤러 생성자
+func NewNamespaceController() *NamespaceController {
+	return &NamespaceController{
+		namespaceService: service.NewNamespaceService(),
+	}
+}
+
+// ListNamespaces - 네임스페이스 목록 조회 (GET /api/namespaces)
+func (nc *NamespaceController) ListNamespaces(w http.ResponseWriter, r *http.Request) {
+	log.Println("📁 GET /api/namespaces - 네임스페이스 목록 조회 요청")
+
+	namespaces, err := nc.namespaceService.ListNamespaces(r.Context())
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "네임스페이스 목록 조회 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.NamespaceListResponse{}
+	response.Success = true
+	response.Message = "네임스페이스 목록 조회 성공"
+	response.Data = namespaces
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetNamespace - 네임스페이스 상세 조회 (GET /api/namespaces/{name})
+func (nc *NamespaceController) GetNamespace(w http.ResponseWriter, r *http.Request) {
+	log.Println("📁 GET /api/namespaces/{name} - 네임스페이스 조회 요청")
+
+	namespace, err := nc.namespaceService.GetNamespace(r.Context(), mux.Vars(r)["name"])
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "네임스페이스 조회 실패: "+err.Error(), http.StatusNotFound)
+		return
+	}
+
+	response := model.NamespaceResponse{}
+	response.Success = true
+	response.Message = "네임스페이스 조회 성공"
+	response.Data = *namespace
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// CreateNamespace - 네임스페이스 생성 (POST /api/namespaces)
+func (nc *NamespaceController) CreateNamespace(w http.ResponseWriter, r *http.Request) {
+	log.Println("📁 POST /api/namespaces - 네임스페이스 생성 요청")
+
+	var request model.CreateNamespaceRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	request.Name = strings.TrimSpace(request.Name)
+	if request.Name == "" {
+		http.Error(w, "네임스페이스 이름은 필수입니다", http.StatusBadRequest)
+		return
+	}
+
+	namespace, err := nc.namespaceService.CreateNamespace(r.Context(), request)
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "네임스페이스 생성 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.NamespaceResponse{}
+	response.Success = true
+	response.Message = "네임스페이스 생성 완료"
+	response.Data = *namespace
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// PreviewDeleteNamespace - 삭제 시 제거될 리소스 미리보기 (GET /api/namespaces/{name}/delete-preview)
+func (nc *NamespaceController) PreviewDeleteNamespace(w http.ResponseWriter, r *http.Request) {
+	log.Println("📁 GET /api/namespaces/{name}/delete-preview - 네임스페이스 삭제 미리보기 요청")
+
+	preview, err := nc.namespaceService.PreviewDelete(r.Context(), mux.Vars(r)["name"])
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "삭제 미리보기 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.NamespaceDeletePreviewResponse{}
+	response.Success = true
+	response.Message = "삭제 미리보기 조회 성공"
+	response.Data = *preview
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// DeleteNamespace - 네임스페이스 삭제 (DELETE /api/namespaces/{name}?confirm={name})
+func (nc *NamespaceController) DeleteNamespace(w http.ResponseWriter, r *http.Request) {
+	log.Println("📁 DELETE /api/namespaces/{name} - 네임스페이스 삭제 요청")
+
+	name := mux.Vars(r)["name"]
+	result, err := nc.namespaceService.DeleteNamespace(r.Context(), name, r.URL.Query().Get("confirm"))
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		// 확인 값이 없으면 삭제될 리소스 목록과 함께 409 반환
+		var confirmation *service.NamespaceConfirmationRequiredError
+		if errors.As(err, &confirmation) {
+			response := model.NamespaceDeletePreviewResponse{}
+			response.Success = false
+			response.Message = confirmation.Error()
+			response.Data = *confirmation.Preview
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(response)
+			return
+		}
+		http.Error(w, "네임스페이스 삭제 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.NamespaceDeleteResponse{}
+	response.Success = true
+	response.Message = "네임스페이스 삭제 요청 완료"
+	response.Data = *result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
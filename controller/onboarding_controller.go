@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// OnboardingController - 팀 온보딩 컨트롤러
+type OnboardingController struct {
+	onboardingService *service.OnboardingService
+}
+
+// NewOnboardingController - 온보딩 컨트롤러 생성자
+func NewOnboardingController() *OnboardingController {
+	return &OnboardingController{
+		onboardingService: service.NewOnboardingService(),
+	}
+}
+
+// OnboardTeam - 팀 네임스페이스 번들 생성/갱신 (POST /api/onboarding/team)
+func (oc *OnboardingController) OnboardTeam(w http.ResponseWriter, r *http.Request) {
+	log.Println("👥 POST /api/onboarding/team - 팀 온보딩 요청")
+
+	var request model.TeamOnboardingRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	result, err := oc.onboardingService.OnboardTeam(r.Context(), request)
+	if err != nil {
+		if writeCommandTimeout(w, err) || writePolicyViolation(w, err) || writePermissionDenied(w, err) {
+			return
+		}
+		http.Error(w, "팀 온보딩 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.TeamOnboardingResponse{}
+	response.Success = true
+	if result.DryRun {
+		response.Message = "팀 온보딩 매니페스트 생성 완료 (dry-run)"
+	} else {
+		response.Message = "팀 온보딩 완료"
+	}
+	response.Data = *result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// OverviewController - 클러스터 상태 요약 컨트롤러
+type OverviewController struct {
+	overviewService *service.OverviewService
+}
+
+// NewOverviewController - 클러스터 요약 컨트롤러 생성자
+func NewOverviewController() *OverviewController {
+	return &OverviewController{
+		overviewService: service.NewOverviewService(),
+	}
+}
+
+// GetClusterOverview - 컨텍스트별 클러스터 상태 요약 (GET /api/context/{contextName}/overview?events=)
+func (oc *OverviewController) GetClusterOverview(w http.ResponseWriter, r *http.Request) {
+	log.Println("🩺 GET /api/context/{contextName}/overview - 클러스터 요약 조회 요청")
+
+	eventLimit := 0
+	if value := r.URL.Query().Get("events"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			http.Error(w, "events 값이 올바르지 않습니다", http.StatusBadRequest)
+			return
+		}
+		eventLimit = parsed
+	}
+
+	overview, err := oc.overviewService.GetClusterOverview(r.Context(), mux.Vars(r)["contextName"], eventLimit)
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "클러스터 요약 조회 실패: "+err.Error(), http.StatusNotFound)
+		return
+	}
+
+	response := model.ClusterOverviewResponse{}
+	response.Success = true
+	response.Message = "클러스터 요약 조회 성공"
+	response.Data = *overview
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
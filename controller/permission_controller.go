@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// PermissionController - 현재 자격 증명의 RBAC 권한 조회 컨트롤러
+type PermissionController struct {
+	permissionService *service.PermissionService
+}
+
+// NewPermissionController - 권한 컨트롤러 생성자
+func NewPermissionController() *PermissionController {
+	return NewPermissionControllerWithService(service.NewPermissionService())
+}
+
+// NewPermissionControllerWithService - 지정한 서비스를 사용하는 컨트롤러 생성자 (테스트에서 가짜 실행기 주입)
+func NewPermissionControllerWithService(permissionService *service.PermissionService) *PermissionController {
+	return &PermissionController{
+		permissionService: permissionService,
+	}
+}
+
+// GetPermissionMatrix - 동사 × 리소스 권한 매트릭스 (GET /api/permissions?context=&namespace=&resources=deployments,secrets)
+func (pc *PermissionController) GetPermissionMatrix(w http.ResponseWriter, r *http.Request) {
+	log.Println("🛡️ GET /api/permissions - 권한 매트릭스 조회 요청")
+
+	params := r.URL.Query()
+	query := model.PermissionMatrixQuery{
+		Context:   params.Get("context"),
+		Namespace: params.Get("namespace"),
+	}
+	for _, resource := range strings.Split(params.Get("resources"), ",") {
+		if resource = strings.TrimSpace(resource); resource != "" {
+			query.Resources = append(query.Resources, resource)
+		}
+	}
+
+	matrix, err := pc.permissionService.GetPermissionMatrix(r.Context(), query)
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "권한 매트릭스 조회 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.PermissionMatrixResponse{}
+	response.Success = true
+	response.Message = fmt.Sprintf("권한 매트릭스 조회 성공 (%s/%s, 리소스 %d개)", matrix.Context, matrix.Namespace, len(matrix.Rows))
+	if matrix.Incomplete {
+		response.Message += " - 규칙 목록이 불완전하여 실제 권한보다 적게 표시될 수 있습니다"
+	}
+	response.Data = *matrix
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// writePermissionDenied - 권한 사전 검사 실패 에러이면 403과 부족한 권한 목록을 응답하고 true 반환
+func writePermissionDenied(w http.ResponseWriter, err error) bool {
+	var denied *service.PermissionDeniedError
+	if !errors.As(err, &denied) {
+		return false
+	}
+
+	response := model.PermissionCheckResponse{}
+	response.Success = false
+	response.Message = denied.Error()
+	response.Data = *denied.Check
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	json.NewEncoder(w).Encode(response)
+	return true
+}
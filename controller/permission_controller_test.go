@@ -0,0 +1,211 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"mykubeapp/model"
+	"mykubeapp/utils/utilstest"
+)
+
+func TestGetPermissionMatrix(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.
+		On("kubectl config current-context", "dev\n").
+		On("kubectl config view", "team-a").
+		On("kubectl api-resources", testAPIResources)
+	reviews := onManifest(t, env.executor, "kubectl create --raw /apis/authorization.k8s.io/v1/selfsubjectrulesreviews", func(call utilstest.FakeCommandCall, manifest string) (string, error) {
+		return `{"status": {
+			"resourceRules": [
+				{"apiGroups": ["apps"], "resources": ["deployments"], "verbs": ["get", "list", "watch", "patch"]},
+				{"apiGroups": [""], "resources": ["*"], "verbs": ["get"]},
+				{"apiGroups": [""], "resources": ["secrets"], "verbs": ["get", "update", "create"], "resourceNames": ["app-config"]}
+			],
+			"incomplete": true
+		}}`, nil
+	})
+
+	recorder := env.do(t, http.MethodGet, "/api/permissions?resources=deploy,secrets", nil)
+	expectStatus(t, recorder, http.StatusOK)
+
+	var response model.PermissionMatrixResponse
+	decodeResponse(t, recorder, &response)
+	matrix := response.Data
+	if matrix.Context != "dev" || matrix.Namespace != "team-a" || !matrix.Incomplete || len(matrix.Rows) != 2 {
+		t.Fatalf("매트릭스 = %+v", matrix)
+	}
+
+	deployments, secrets := matrix.Rows[0].Verbs, matrix.Rows[1].Verbs
+	if deployments["patch"] != model.PermissionAllowed || deployments["create"] != model.PermissionDenied {
+		t.Fatalf("deployments 권한 = %v", deployments)
+	}
+	if secrets["get"] != model.PermissionAllowed || secrets["update"] != model.PermissionRestricted || secrets["create"] != model.PermissionDenied {
+		t.Fatalf("secrets 권한 = %v", secrets)
+	}
+
+	if reviewed := reviews.all(); len(reviewed) != 1 || !strings.Contains(reviewed[0].content, `"namespace":"team-a"`) {
+		t.Fatalf("SelfSubjectRulesReview는 대상 네임스페이스로 한 번 요청해야 합니다")
+	}
+}
+
+func TestGetPermissionMatrixRejectsUnknownResource(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.
+		On("kubectl config current-context", "dev\n").
+		On("kubectl api-resources", testAPIResources)
+
+	recorder := env.do(t, http.MethodGet, "/api/permissions?namespace=web&resources=widgets", nil)
+	expectStatus(t, recorder, http.StatusBadRequest)
+	expectNoCalls(t, env.executor, "kubectl create --raw")
+}
+
+func TestApplyReportsMissingPermissions(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.
+		On("kubectl config current-context", "dev\n").
+		On("kubectl api-resources", testAPIResources)
+	lookups := onExistingObjects(t, env.executor, "", "service/web")
+	reviewed := onAccessReview(t, env.executor, "", "get deployments", "get services", "patch services")
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{
+		YamlContent: testDeploymentYaml + "---\n" + testServiceYaml,
+		Namespace:   "web",
+	})
+	expectStatus(t, recorder, http.StatusForbidden)
+	expectNoCalls(t, env.executor, "kubectl apply")
+
+	var response model.PermissionCheckResponse
+	decodeResponse(t, recorder, &response)
+	check := response.Data
+	if check.Context != "dev" || check.Objects != 2 {
+		t.Fatalf("검사 결과 = %+v", check)
+	}
+	var missing []string
+	for _, permission := range check.Missing {
+		missing = append(missing, permission.Verb+" "+permission.Resource+" "+permission.Namespace+" "+permission.Object)
+	}
+	// 아직 없는 오브젝트는 create, 이미 있는 오브젝트는 patch 권한만 필요
+	if strings.Join(missing, ", ") != "create deployments.apps web deployment.apps/web" {
+		t.Fatalf("부족한 권한 = %v", missing)
+	}
+
+	// 권한 검사는 kubectl 한 번으로 묶어 요청
+	if calls := env.executor.CallsTo("kubectl create"); len(calls) != 1 || strings.Join(*reviewed, ", ") != "get deployments web, create deployments web, get services web, patch services web" {
+		t.Fatalf("권한 검사 호출 %d회, 항목 = %v", len(calls), *reviewed)
+	}
+	// 존재 여부도 한 번에 이름만 조회 (Secret 등의 본문이 로그에 남지 않도록)
+	if manifests := lookups.all(); len(manifests) != 1 || strings.Contains(manifests[0].content, "replicas") {
+		t.Fatalf("존재 여부 조회 = %d회 %v", len(manifests), manifests)
+	}
+	expectNoCalls(t, env.executor, "kubectl get --raw")
+}
+
+func TestApplyPermissionCheckAllowsExistingObjectWithoutCreate(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.On("kubectl api-resources", testAPIResources)
+	onExistingObjects(t, env.executor, "", "deployment.apps/web")
+	reviewed := onAccessReview(t, env.executor, "", "get deployments", "patch deployments")
+	applies := onManifest(t, env.executor, "kubectl apply", nil)
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{YamlContent: testDeploymentYaml, Namespace: "web"})
+	expectStatus(t, recorder, http.StatusOK)
+
+	// 이미 있는 오브젝트는 get, patch만 검사
+	if strings.Join(*reviewed, ", ") != "get deployments web, patch deployments web" {
+		t.Fatalf("권한 검사 항목 = %v, 기대값 get, patch", *reviewed)
+	}
+	if len(applies.all()) != 1 {
+		t.Fatalf("apply 호출 수 = %d, 기대값 1", len(applies.all()))
+	}
+}
+
+func TestApplyPermissionCheckRequiresBothVerbsWhenExistenceUnknown(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.
+		On("kubectl api-resources", testAPIResources).
+		OnError("kubectl get -f", errors.New("the server doesn't have a resource type"))
+	onAccessReview(t, env.executor, "", "get deployments", "patch deployments")
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{YamlContent: testDeploymentYaml, Namespace: "web"})
+	expectStatus(t, recorder, http.StatusForbidden)
+	expectNoCalls(t, env.executor, "kubectl apply")
+
+	// 존재 여부를 모르면 patch만 허용되어도 create 부족을 보고
+	var response model.PermissionCheckResponse
+	decodeResponse(t, recorder, &response)
+	if missing := response.Data.Missing; len(missing) != 1 || missing[0].Verb != "create" {
+		t.Fatalf("부족한 권한 = %+v", missing)
+	}
+}
+
+func TestApplyPermissionCheckIncludesNamespaceCreation(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.On("kubectl api-resources", testAPIResources)
+	onExistingObjects(t, env.executor, "", "deployment.apps/web")
+	reviewed := onAccessReview(t, env.executor, "", "get deployments", "patch deployments", "get namespaces")
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{YamlContent: testDeploymentYaml, Namespace: "web", CreateNamespace: true})
+	expectStatus(t, recorder, http.StatusForbidden)
+	expectNoCalls(t, env.executor, "kubectl create namespace")
+	expectNoCalls(t, env.executor, "kubectl apply")
+
+	// 없는 네임스페이스를 자동 생성하려면 namespaces create 권한 필요
+	var response model.PermissionCheckResponse
+	decodeResponse(t, recorder, &response)
+	if missing := response.Data.Missing; len(missing) != 1 || missing[0].Verb != "create" || missing[0].Object != "namespace/web" {
+		t.Fatalf("부족한 권한 = %+v", missing)
+	}
+	if !strings.Contains(strings.Join(*reviewed, ", "), "get namespaces, create namespaces") {
+		t.Fatalf("권한 검사 항목 = %v", *reviewed)
+	}
+}
+
+func TestApplyPermissionCheckFailureIsReported(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.
+		OnError("kubectl api-resources", errors.New("the server could not find the requested resource")).
+		On("kubectl apply", "deployment.apps/web created\n")
+
+	// 검사 자체가 실패하면 적용을 막지 않고 생략 사실과 원인을 결과에 표시
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{YamlContent: testDeploymentYaml, Namespace: "web"})
+	expectStatus(t, recorder, http.StatusOK)
+	var response model.ApplyYamlResponse
+	decodeResponse(t, recorder, &response)
+	if !response.Data.PermissionCheckSkipped || !strings.Contains(response.Data.PermissionCheckWarning, "the server could not find the requested resource") {
+		t.Fatalf("검사 실패가 결과에 표시되어야 합니다: %+v", response.Data)
+	}
+	if calls := env.executor.CallsTo("kubectl apply"); len(calls) != 1 {
+		t.Fatalf("apply 호출 수 = %d, 기대값 1", len(calls))
+	}
+
+	// skipPermissionCheck로 명시적으로 생략하면 검사 없이 적용하고 결과에 표시
+	recorder = env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{YamlContent: testDeploymentYaml, Namespace: "web", SkipPermissionCheck: true})
+	expectStatus(t, recorder, http.StatusOK)
+	response = model.ApplyYamlResponse{}
+	decodeResponse(t, recorder, &response)
+	if !response.Data.PermissionCheckSkipped || response.Data.PermissionCheckWarning != "" {
+		t.Fatalf("요청에 따른 생략만 표시되어야 합니다: %+v", response.Data)
+	}
+}
+
+func TestApplyClientDryRunChecksOnlyReadPermission(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.
+		On("kubectl api-resources", testAPIResources).
+		On("kubectl apply", "deployment.apps/web created (dry run)\n")
+	reviewed := onAccessReview(t, env.executor, "", "get deployments")
+
+	recorder := env.do(t, http.MethodPost, "/api/apply", model.ApplyYamlRequest{YamlContent: testDeploymentYaml, Namespace: "web", DryRun: true})
+	expectStatus(t, recorder, http.StatusOK)
+
+	// kubectl 백엔드의 --dry-run=client는 쓰기 권한이 필요 없으므로 존재 여부 조회 없이 get만 검사 (API 백엔드의 dryRun=All은 patch/create 필요)
+	expectNoCalls(t, env.executor, "kubectl get")
+	if len(*reviewed) != 1 {
+		t.Fatalf("권한 검사 항목 = %v, 기대값 get", *reviewed)
+	}
+	if applies := env.executor.CallsTo("kubectl apply"); len(applies) != 1 || !strings.Contains(applies[0].Command(), "--dry-run=client") {
+		t.Fatalf("dry-run apply 호출 = %v", applies)
+	}
+}
@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// PolicyController - 적용 전 정책 관련 컨트롤러
+type PolicyController struct {
+	policyService *service.PolicyService
+	kubeService   *service.KubeService
+}
+
+// NewPolicyController - 정책 컨트롤러 생성자
+func NewPolicyController() *PolicyController {
+	return &PolicyController{
+		policyService: service.NewPolicyService(),
+		kubeService:   service.NewKubeService(),
+	}
+}
+
+// GetPolicy - 정책 설정 조회 (GET /api/policy)
+func (pc *PolicyController) GetPolicy(w http.ResponseWriter, r *http.Request) {
+	log.Println("🛡️ GET /api/policy - 정책 설정 조회 요청")
+
+	config, err := pc.policyService.GetConfig()
+	if err != nil {
+		http.Error(w, "정책 조회 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.PolicyConfigResponse{}
+	response.Success = true
+	response.Message = "정책 조회 성공"
+	response.Data = *config
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// UpdatePolicy - 정책 설정 저장 (PUT /api/policy)
+func (pc *PolicyController) UpdatePolicy(w http.ResponseWriter, r *http.Request) {
+	log.Println("🛡️ PUT /api/policy - 정책 설정 변경 요청")
+
+	var request model.PolicyConfig
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	if err := pc.policyService.SaveConfig(request); err != nil {
+		http.Error(w, "정책 저장 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.BaseResponse{
+		Success: true,
+		Message: "정책이 성공적으로 저장되었습니다",
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// EvaluatePolicy - YAML을 적용하지 않고 정책만 평가 (POST /api/policy/evaluate)
+func (pc *PolicyController) EvaluatePolicy(w http.ResponseWriter, r *http.Request) {
+	log.Println("🛡️ POST /api/policy/evaluate - 정책 평가 요청")
+
+	var request model.PolicyEvaluateRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	if strings.TrimSpace(request.YamlContent) == "" {
+		http.Error(w, "YAML 내용은 필수입니다", http.StatusBadRequest)
+		return
+	}
+
+	contextName := request.Context
+	if contextName == "" {
+		contextName = pc.kubeService.GetCurrentContext(r.Context())
+	}
+
+	evaluation, err := pc.policyService.Evaluate(request.YamlContent, contextName, request.Namespace)
+	if err != nil {
+		http.Error(w, "정책 평가 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.PolicyEvaluationResponse{}
+	response.Success = true
+	if evaluation.Allowed {
+		response.Message = "정책 평가 완료: 적용 가능"
+	} else {
+		response.Message = "정책 평가 완료: deny 위반으로 적용 불가"
+	}
+	response.Data = *evaluation
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// writePolicyViolation - 정책 위반 에러이면 403과 위반 항목을 응답하고 true 반환
+func writePolicyViolation(w http.ResponseWriter, err error) bool {
+	var violation *service.PolicyViolationError
+	if !errors.As(err, &violation) {
+		return false
+	}
+
+	response := model.PolicyEvaluationResponse{}
+	response.Success = false
+	response.Message = violation.Error()
+	response.Data = *violation.Evaluation
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	json.NewEncoder(w).Encode(response)
+	return true
+}
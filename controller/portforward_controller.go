@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// PortForwardController - 포트포워드 프록시 컨트롤러
+type PortForwardController struct {
+	portForwardService *service.PortForwardService
+}
+
+// NewPortForwardController - 포트포워드 컨트롤러 생성자
+func NewPortForwardController() *PortForwardController {
+	return &PortForwardController{
+		portForwardService: service.NewPortForwardService(),
+	}
+}
+
+// StartPortForward - 포트포워드 시작 (POST /api/portforwards)
+func (pc *PortForwardController) StartPortForward(w http.ResponseWriter, r *http.Request) {
+	log.Println("🔀 POST /api/portforwards - 포트포워드 시작 요청")
+
+	var req model.PortForwardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	session, err := pc.portForwardService.StartPortForward(req)
+	if err != nil {
+		http.Error(w, "포트포워드 시작 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.PortForwardResponse{}
+	response.Success = true
+	response.Message = "포트포워드가 시작되었습니다"
+	response.Data = *session
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListPortForwards - 활성 포트포워드 목록 (GET /api/portforwards)
+func (pc *PortForwardController) ListPortForwards(w http.ResponseWriter, r *http.Request) {
+	log.Println("🔀 GET /api/portforwards - 포트포워드 목록 조회 요청")
+
+	response := model.PortForwardListResponse{}
+	response.Success = true
+	response.Message = "포트포워드 목록 조회 성공"
+	response.Data = pc.portForwardService.ListPortForwards()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetPortForward - 포트포워드 세션 조회 (GET /api/portforwards/{id})
+func (pc *PortForwardController) GetPortForward(w http.ResponseWriter, r *http.Request) {
+	log.Println("🔀 GET /api/portforwards/{id} - 포트포워드 조회 요청")
+
+	session, err := pc.portForwardService.GetPortForward(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	response := model.PortForwardResponse{}
+	response.Success = true
+	response.Message = "포트포워드 조회 성공"
+	response.Data = *session
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// StopPortForward - 포트포워드 중지 (DELETE /api/portforwards/{id})
+func (pc *PortForwardController) StopPortForward(w http.ResponseWriter, r *http.Request) {
+	log.Println("🔀 DELETE /api/portforwards/{id} - 포트포워드 중지 요청")
+
+	session, err := pc.portForwardService.StopPortForward(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	response := model.PortForwardResponse{}
+	response.Success = true
+	response.Message = "포트포워드가 중지되었습니다"
+	response.Data = *session
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Proxy - 포트포워드 대상으로 HTTP/WebSocket 중계 (/api/portforwards/{id}/proxy/...)
+func (pc *PortForwardController) Proxy(w http.ResponseWriter, r *http.Request) {
+	err := pc.portForwardService.ServeProxy(mux.Vars(r)["id"], w, r)
+
+	var notFound *service.PortForwardNotFoundError
+	if errors.As(err, &notFound) {
+		http.Error(w, notFound.Error(), http.StatusNotFound)
+	}
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// PromotionController - 컨텍스트 간 리소스 복사/승격 컨트롤러
+type PromotionController struct {
+	promotionService *service.PromotionService
+}
+
+// NewPromotionController - 승격 컨트롤러 생성자
+func NewPromotionController() *PromotionController {
+	return &PromotionController{
+		promotionService: service.NewPromotionService(),
+	}
+}
+
+// Promote - 리소스 복사/승격 (POST /api/promote, apply=false면 diff 미리보기와 dry-run만)
+func (pc *PromotionController) Promote(w http.ResponseWriter, r *http.Request) {
+	log.Println("🚚 POST /api/promote - 리소스 승격 요청")
+
+	var request model.PromotionRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	result, err := pc.promotionService.Promote(r.Context(), request)
+	if err != nil {
+		if writeCommandTimeout(w, err) || writePolicyViolation(w, err) || writePermissionDenied(w, err) {
+			return
+		}
+		http.Error(w, "리소스 승격 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.PromotionResponse{}
+	response.Success = true
+	if request.Apply {
+		response.Message = "리소스 승격 완료"
+	} else {
+		response.Message = "리소스 승격 미리보기 완료 (apply=true로 적용)"
+	}
+	response.Data = *result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
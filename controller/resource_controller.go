@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// ResourceController - 읽기 전용 리소스 조회 컨트롤러
+type ResourceController struct {
+	resourceService *service.ResourceService
+}
+
+// NewResourceController - 리소스 조회 컨트롤러 생성자
+func NewResourceController() *ResourceController {
+	return &ResourceController{
+		resourceService: service.NewResourceService(),
+	}
+}
+
+// ListKinds - 조회 가능한 리소스 종류 목록 (GET /api/resources?context=)
+func (rc *ResourceController) ListKinds(w http.ResponseWriter, r *http.Request) {
+	log.Println("🔎 GET /api/resources - 리소스 종류 목록 조회 요청")
+
+	kinds, err := rc.resourceService.ListKinds(r.Context(), r.URL.Query().Get("context"))
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "리소스 종류 조회 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.ResourceKindsResponse{}
+	response.Success = true
+	response.Message = "리소스 종류 조회 성공"
+	response.Data = kinds
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListResources - 종류별 리소스 목록 (GET /api/resources/{kind}?context=&namespace=&labelSelector=&fieldSelector=&limit=&continue=)
+func (rc *ResourceController) ListResources(w http.ResponseWriter, r *http.Request) {
+	log.Println("🔎 GET /api/resources/{kind} - 리소스 목록 조회 요청")
+
+	params := r.URL.Query()
+	query := model.ResourceListQuery{
+		Context:       params.Get("context"),
+		Kind:          mux.Vars(r)["kind"],
+		Namespace:     params.Get("namespace"),
+		LabelSelector: params.Get("labelSelector"),
+		FieldSelector: params.Get("fieldSelector"),
+		Continue:      params.Get("continue"),
+	}
+	if limit := params.Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil || value < 0 {
+			http.Error(w, "limit 값이 올바르지 않습니다", http.StatusBadRequest)
+			return
+		}
+		query.Limit = value
+	}
+
+	result, err := rc.resourceService.ListResources(r.Context(), query)
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "리소스 목록 조회 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.ResourceListResponse{}
+	response.Success = true
+	response.Message = "리소스 목록 조회 성공"
+	response.Data = *result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetResource - 리소스 단건 조회 (GET /api/resources/{kind}/{name}?context=&namespace=)
+func (rc *ResourceController) GetResource(w http.ResponseWriter, r *http.Request) {
+	log.Println("🔎 GET /api/resources/{kind}/{name} - 리소스 조회 요청")
+
+	vars := mux.Vars(r)
+	detail, err := rc.resourceService.GetResource(r.Context(), r.URL.Query().Get("context"), vars["kind"], r.URL.Query().Get("namespace"), vars["name"])
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "리소스 조회 실패: "+err.Error(), http.StatusNotFound)
+		return
+	}
+
+	response := model.ResourceDetailResponse{}
+	response.Success = true
+	response.Message = "리소스 조회 성공"
+	response.Data = *detail
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
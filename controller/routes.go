@@ -0,0 +1,256 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// Controllers - API 라우트에 연결하는 컨트롤러 묶음
+// main은 NewControllers로 전부 만들고, 테스트는 가짜 실행기를 주입한 컨트롤러만 채워서 사용합니다.
+type Controllers struct {
+	Kube         *KubeController
+	Terminal     *TerminalController
+	AI           *AIController
+	Git          *GitController
+	Drift        *DriftController
+	Policy       *PolicyController
+	Kustomize    *KustomizeController
+	Helm         *HelmController
+	Environment  *EnvironmentController
+	Namespace    *NamespaceController
+	Onboarding   *OnboardingController
+	Resource     *ResourceController
+	Log          *LogController
+	Exec         *ExecController
+	PortForward  *PortForwardController
+	Event        *EventController
+	Workload     *WorkloadController
+	Overview     *OverviewController
+	Export       *ExportController
+	Promotion    *PromotionController
+	MultiCluster *MultiClusterController
+	Secret       *SecretController
+	ConfigMap    *ConfigMapController
+	Permission   *PermissionController
+	Job          *JobController
+}
+
+// NewControllers - 기본 서비스로 모든 컨트롤러 생성
+func NewControllers() *Controllers {
+	return &Controllers{
+		Kube:         NewKubeController(),
+		Terminal:     NewTerminalController(),
+		AI:           NewAIController(),
+		Git:          NewGitController(),
+		Drift:        NewDriftController(),
+		Policy:       NewPolicyController(),
+		Kustomize:    NewKustomizeController(),
+		Helm:         NewHelmController(),
+		Environment:  NewEnvironmentController(),
+		Namespace:    NewNamespaceController(),
+		Onboarding:   NewOnboardingController(),
+		Resource:     NewResourceController(),
+		Log:          NewLogController(),
+		Exec:         NewExecController(),
+		PortForward:  NewPortForwardController(),
+		Event:        NewEventController(),
+		Workload:     NewWorkloadController(),
+		Overview:     NewOverviewController(),
+		Export:       NewExportController(),
+		Promotion:    NewPromotionController(),
+		MultiCluster: NewMultiClusterController(),
+		Secret:       NewSecretController(),
+		ConfigMap:    NewConfigMapController(),
+		Permission:   NewPermissionController(),
+		Job:          NewJobController(),
+	}
+}
+
+// RegisterRoutes - /health와 /api 하위 라우트 등록 (main과 테스트가 같은 경로를 사용, nil인 컨트롤러의 라우트는 등록하지 않음)
+func RegisterRoutes(router *mux.Router, c *Controllers) {
+	// API 라우트 설정 (Spring의 @RequestMapping과 유사)
+	api := router.PathPrefix("/api").Subrouter()
+
+	// Health check endpoint
+	router.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"UP","message":"쿠버네티스 관리 애플리케이션이 정상 동작 중입니다"}`))
+	}).Methods("GET")
+
+	// 쿠버네티스 관련 API
+	if c.Kube != nil {
+		api.HandleFunc("/config", c.Kube.GetConfig).Methods("GET", "OPTIONS")
+		api.HandleFunc("/config", c.Kube.AddConfig).Methods("POST", "OPTIONS")
+		api.HandleFunc("/contexts", c.Kube.GetContexts).Methods("GET", "OPTIONS")
+		api.HandleFunc("/context/use", c.Kube.UseContext).Methods("POST", "OPTIONS")
+		api.HandleFunc("/context", c.Kube.DeleteContext).Methods("DELETE", "OPTIONS")
+		api.HandleFunc("/context/{contextName}", c.Kube.GetContextDetail).Methods("GET", "OPTIONS")
+		api.HandleFunc("/apply", c.Kube.ApplyYaml).Methods("POST", "OPTIONS")
+		api.HandleFunc("/delete", c.Kube.DeleteYaml).Methods("POST", "OPTIONS")
+	}
+	if c.Terminal != nil {
+		api.HandleFunc("/kubectl", c.Terminal.KubectlTerminal)
+	}
+
+	// AI 관련 API
+	if c.AI != nil {
+		api.HandleFunc("/ai/health", c.AI.CheckAIHealth).Methods("GET", "OPTIONS")
+		api.HandleFunc("/ai/generate-yaml", c.AI.GenerateYaml).Methods("POST", "OPTIONS")
+		api.HandleFunc("/ai/generate-apply", c.AI.GenerateAndApplyEnhanced).Methods("POST", "OPTIONS") // 🆕 Enhanced 버전 사용
+		api.HandleFunc("/ai/query", c.AI.QueryAI).Methods("POST", "OPTIONS")
+		api.HandleFunc("/ai/template", c.AI.GenerateTemplate).Methods("POST", "OPTIONS")
+		api.HandleFunc("/ai/validate", c.AI.ValidateYaml).Methods("POST", "OPTIONS")
+		api.HandleFunc("/ai/examples", c.AI.GetAIExamples).Methods("GET", "OPTIONS")
+		api.HandleFunc("/ai/git", c.AI.ProcessGitCommand).Methods("POST", "OPTIONS") // 🆕 Git 전용 엔드포인트 추가
+	}
+
+	// 🆕 Git 관련 API 추가
+	if c.Git != nil {
+		api.HandleFunc("/git/yaml", c.Git.GetYamlFromGit).Methods("POST", "OPTIONS")    // Git에서 YAML 조회
+		api.HandleFunc("/git/apply", c.Git.ApplyYamlFromGit).Methods("POST", "OPTIONS") // Git에서 YAML 적용
+		api.HandleFunc("/git/ai", c.Git.ProcessGitWithAI).Methods("POST", "OPTIONS")    // AI를 통한 Git 연동
+		api.HandleFunc("/git/cleanup", c.Git.CleanupGitTemp).Methods("GET", "OPTIONS")  // Git 임시 파일 정리
+	}
+	if c.Drift != nil {
+		api.HandleFunc("/git/drift", c.Drift.DetectDrift).Methods("POST", "OPTIONS") // 🆕 Git과 클러스터 드리프트 탐지
+	}
+
+	// 🆕 정책 관련 API
+	if c.Policy != nil {
+		api.HandleFunc("/policy", c.Policy.GetPolicy).Methods("GET", "OPTIONS")
+		api.HandleFunc("/policy", c.Policy.UpdatePolicy).Methods("PUT", "OPTIONS")
+		api.HandleFunc("/policy/evaluate", c.Policy.EvaluatePolicy).Methods("POST", "OPTIONS")
+	}
+
+	// 🆕 kustomize 업로드 API
+	if c.Kustomize != nil {
+		api.HandleFunc("/kustomize/build", c.Kustomize.BuildUpload).Methods("POST", "OPTIONS")
+		api.HandleFunc("/kustomize/apply", c.Kustomize.ApplyUpload).Methods("POST", "OPTIONS")
+	}
+
+	// 🆕 Helm 관련 API
+	if c.Helm != nil {
+		api.HandleFunc("/helm/git/template", c.Helm.RenderFromGit).Methods("POST", "OPTIONS")
+		api.HandleFunc("/helm/git/install", c.Helm.InstallFromGit).Methods("POST", "OPTIONS")
+		api.HandleFunc("/helm/upload/template", c.Helm.RenderUpload).Methods("POST", "OPTIONS")
+		api.HandleFunc("/helm/upload/install", c.Helm.InstallUpload).Methods("POST", "OPTIONS")
+		api.HandleFunc("/helm/releases", c.Helm.ListReleases).Methods("GET", "OPTIONS")
+		api.HandleFunc("/helm/releases/{namespace}/{name}/history", c.Helm.GetReleaseHistory).Methods("GET", "OPTIONS")
+	}
+
+	// 🆕 환경별 변수 세트 API
+	if c.Environment != nil {
+		api.HandleFunc("/environments", c.Environment.GetEnvironments).Methods("GET", "OPTIONS")
+		api.HandleFunc("/environments", c.Environment.UpdateEnvironments).Methods("PUT", "OPTIONS")
+		api.HandleFunc("/environments/substitute", c.Environment.Substitute).Methods("POST", "OPTIONS")
+	}
+
+	// 🆕 네임스페이스 관리 API
+	if c.Namespace != nil {
+		api.HandleFunc("/namespaces", c.Namespace.ListNamespaces).Methods("GET", "OPTIONS")
+		api.HandleFunc("/namespaces", c.Namespace.CreateNamespace).Methods("POST", "OPTIONS")
+		api.HandleFunc("/namespaces/{name}", c.Namespace.GetNamespace).Methods("GET", "OPTIONS")
+		api.HandleFunc("/namespaces/{name}", c.Namespace.DeleteNamespace).Methods("DELETE", "OPTIONS")
+		api.HandleFunc("/namespaces/{name}/delete-preview", c.Namespace.PreviewDeleteNamespace).Methods("GET", "OPTIONS")
+	}
+
+	// 🆕 팀 온보딩 API
+	if c.Onboarding != nil {
+		api.HandleFunc("/onboarding/team", c.Onboarding.OnboardTeam).Methods("POST", "OPTIONS")
+	}
+
+	// 🆕 리소스 조회 API (읽기 전용)
+	if c.Resource != nil {
+		api.HandleFunc("/resources", c.Resource.ListKinds).Methods("GET", "OPTIONS")
+		api.HandleFunc("/resources/{kind}", c.Resource.ListResources).Methods("GET", "OPTIONS")
+		api.HandleFunc("/resources/{kind}/{name}", c.Resource.GetResource).Methods("GET", "OPTIONS")
+	}
+
+	// 🆕 파드 로그 스트리밍 (WebSocket 또는 SSE)
+	if c.Log != nil {
+		api.HandleFunc("/logs", c.Log.StreamPodLogs).Methods("GET", "OPTIONS")
+	}
+
+	// 🆕 파드 대화형 exec (WebSocket, TTY)
+	if c.Exec != nil {
+		api.HandleFunc("/exec", c.Exec.ExecPod).Methods("GET", "OPTIONS")
+	}
+
+	// 🆕 포트포워드 프록시 (세션 관리 + HTTP/WebSocket 리버스 프록시)
+	if c.PortForward != nil {
+		api.HandleFunc("/portforwards", c.PortForward.ListPortForwards).Methods("GET", "OPTIONS")
+		api.HandleFunc("/portforwards", c.PortForward.StartPortForward).Methods("POST", "OPTIONS")
+		api.HandleFunc("/portforwards/{id}", c.PortForward.GetPortForward).Methods("GET", "OPTIONS")
+		api.HandleFunc("/portforwards/{id}", c.PortForward.StopPortForward).Methods("DELETE", "OPTIONS")
+		api.PathPrefix("/portforwards/{id}/proxy/").HandlerFunc(c.PortForward.Proxy)
+	}
+
+	// 🆕 클러스터 이벤트 스트리밍 (WebSocket 또는 SSE)
+	if c.Event != nil {
+		api.HandleFunc("/events", c.Event.StreamEvents).Methods("GET", "OPTIONS")
+	}
+
+	// 🆕 워크로드 운영 작업 (Deployment/StatefulSet/DaemonSet)
+	if c.Workload != nil {
+		api.HandleFunc("/workloads/operations", c.Workload.ListOperations).Methods("GET", "OPTIONS")
+		api.HandleFunc("/workloads/{kind}/{name}/scale", c.Workload.Scale).Methods("POST", "OPTIONS")
+		api.HandleFunc("/workloads/{kind}/{name}/restart", c.Workload.Restart).Methods("POST", "OPTIONS")
+		api.HandleFunc("/workloads/{kind}/{name}/pause", c.Workload.Pause).Methods("POST", "OPTIONS")
+		api.HandleFunc("/workloads/{kind}/{name}/resume", c.Workload.Resume).Methods("POST", "OPTIONS")
+		api.HandleFunc("/workloads/{kind}/{name}/history", c.Workload.History).Methods("GET", "OPTIONS")
+		api.HandleFunc("/workloads/{kind}/{name}/undo", c.Workload.Undo).Methods("POST", "OPTIONS")
+	}
+
+	// 🆕 컨텍스트별 클러스터 상태 요약
+	if c.Overview != nil {
+		api.HandleFunc("/context/{contextName}/overview", c.Overview.GetClusterOverview).Methods("GET", "OPTIONS")
+	}
+
+	// 🆕 실행 중인 리소스를 재적용 가능한 YAML로 내보내기
+	if c.Export != nil {
+		api.HandleFunc("/export", c.Export.ExportResources).Methods("POST", "OPTIONS")
+	}
+
+	// 🆕 컨텍스트 간 리소스 복사/승격 (변환, diff 미리보기, dry-run)
+	if c.Promotion != nil {
+		api.HandleFunc("/promote", c.Promotion.Promote).Methods("POST", "OPTIONS")
+	}
+
+	// 🆕 여러 context에 같은 매니페스트 적용 (카나리 우선, 제한된 동시성) 및 context 그룹
+	if c.MultiCluster != nil {
+		api.HandleFunc("/apply/multi", c.MultiCluster.ApplyToContexts).Methods("POST", "OPTIONS")
+		api.HandleFunc("/context-groups", c.MultiCluster.GetContextGroups).Methods("GET", "OPTIONS")
+		api.HandleFunc("/context-groups", c.MultiCluster.UpdateContextGroups).Methods("PUT", "OPTIONS")
+	}
+
+	// 🆕 Secret 생성 (서버에서 인코딩, 값은 응답/로그에 남기지 않음)
+	if c.Secret != nil {
+		api.HandleFunc("/secrets", c.Secret.CreateSecret).Methods("POST", "OPTIONS")
+		api.HandleFunc("/secrets/upload", c.Secret.UploadSecret).Methods("POST", "OPTIONS")
+	}
+
+	// 🆕 ConfigMap 생성 (업로드 파일 또는 Git 경로, 의존 Deployment 해시 롤아웃)
+	if c.ConfigMap != nil {
+		api.HandleFunc("/configmaps/upload", c.ConfigMap.UploadConfigMap).Methods("POST", "OPTIONS")
+		api.HandleFunc("/configmaps/git", c.ConfigMap.CreateConfigMapFromGit).Methods("POST", "OPTIONS")
+	}
+
+	// 🆕 현재 자격 증명의 RBAC 권한 매트릭스 (동사 × 리소스)
+	if c.Permission != nil {
+		api.HandleFunc("/permissions", c.Permission.GetPermissionMatrix).Methods("GET", "OPTIONS")
+	}
+
+	// 🆕 비동기 작업 (제출 후 상태/진행 상황/로그/결과 조회, 취소, 이벤트 스트림)
+	if c.Job != nil {
+		api.HandleFunc("/jobs", c.Job.ListJobs).Methods("GET", "OPTIONS")
+		api.HandleFunc("/jobs/git-apply", c.Job.SubmitGitApply).Methods("POST", "OPTIONS")
+		api.HandleFunc("/jobs/ai-generate-apply", c.Job.SubmitAIGenerateApply).Methods("POST", "OPTIONS")
+		api.HandleFunc("/jobs/{id}", c.Job.GetJob).Methods("GET", "OPTIONS")
+		api.HandleFunc("/jobs/{id}/logs", c.Job.GetJobLogs).Methods("GET", "OPTIONS")
+		api.HandleFunc("/jobs/{id}/result", c.Job.GetJobResult).Methods("GET", "OPTIONS")
+		api.HandleFunc("/jobs/{id}/events", c.Job.StreamJobEvents).Methods("GET", "OPTIONS")
+		api.HandleFunc("/jobs/{id}/cancel", c.Job.CancelJob).Methods("POST", "OPTIONS")
+	}
+}
@@ -0,0 +1,168 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// SecretController - Secret 생성 컨트롤러 (값은 응답/로그에 포함하지 않음)
+type SecretController struct {
+	secretService *service.SecretService
+}
+
+// NewSecretController - Secret 컨트롤러 생성자
+func NewSecretController() *SecretController {
+	return NewSecretControllerWithService(service.NewSecretService())
+}
+
+// NewSecretControllerWithService - 지정한 서비스를 사용하는 컨트롤러 생성자 (테스트에서 가짜 실행기 주입)
+func NewSecretControllerWithService(secretService *service.SecretService) *SecretController {
+	return &SecretController{
+		secretService: secretService,
+	}
+}
+
+// CreateSecret - JSON 요청으로 Secret 생성 (POST /api/secrets)
+func (sc *SecretController) CreateSecret(w http.ResponseWriter, r *http.Request) {
+	log.Println("🔐 POST /api/secrets - Secret 생성 요청")
+
+	var request model.SecretRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+		return
+	}
+
+	sc.createSecret(w, r, request)
+}
+
+// UploadSecret - multipart 업로드로 Secret 생성 (POST /api/secrets/upload)
+// multipart 필드: name, namespace, context, type, dryRun, literal(key=value, 여러 개),
+// file(여러 개, 파일 이름이 키), tlsCert/tlsKey(파일 또는 값), dockerServer, dockerUsername, dockerPassword, dockerEmail
+func (sc *SecretController) UploadSecret(w http.ResponseWriter, r *http.Request) {
+	log.Println("🔐 POST /api/secrets/upload - Secret 업로드 생성 요청")
+
+	request, err := parseSecretUpload(r)
+	if err != nil {
+		http.Error(w, "업로드 처리 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sc.createSecret(w, r, request)
+}
+
+// createSecret - Secret 생성/적용 후 값이 빠진 결과 응답
+func (sc *SecretController) createSecret(w http.ResponseWriter, r *http.Request, request model.SecretRequest) {
+	built, err := sc.secretService.BuildSecret(request)
+	if err != nil {
+		http.Error(w, "Secret 생성 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := sc.secretService.ApplySecret(r.Context(), request, built)
+	if err != nil {
+		if writeCommandTimeout(w, err) || writePolicyViolation(w, err) || writePermissionDenied(w, err) {
+			return
+		}
+		http.Error(w, "Secret 적용 실패: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := model.SecretResponse{}
+	response.Success = true
+	if request.DryRun {
+		response.Message = fmt.Sprintf("Secret dry-run 완료 (%s/%s)", result.Namespace, result.Name)
+	} else {
+		response.Message = fmt.Sprintf("Secret 적용 완료 (%s/%s)", result.Namespace, result.Name)
+	}
+	response.Data = *result
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// parseSecretUpload - multipart 폼을 Secret 요청으로 변환
+func parseSecretUpload(r *http.Request) (model.SecretRequest, error) {
+	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+		return model.SecretRequest{}, fmt.Errorf("multipart 요청 파싱 실패: %v", err)
+	}
+
+	dryRun, _ := strconv.ParseBool(r.FormValue("dryRun"))
+	request := model.SecretRequest{
+		Name:           r.FormValue("name"),
+		Namespace:      r.FormValue("namespace"),
+		Context:        r.FormValue("context"),
+		Type:           r.FormValue("type"),
+		DryRun:         dryRun,
+		DockerServer:   r.FormValue("dockerServer"),
+		DockerUsername: r.FormValue("dockerUsername"),
+		DockerPassword: r.FormValue("dockerPassword"),
+		DockerEmail:    r.FormValue("dockerEmail"),
+		TLSCert:        r.FormValue("tlsCert"),
+		TLSKey:         r.FormValue("tlsKey"),
+		Literals:       map[string]string{},
+		Files:          map[string][]byte{},
+	}
+
+	for _, literal := range r.MultipartForm.Value["literal"] {
+		key, value, ok := strings.Cut(literal, "=")
+		if !ok || key == "" {
+			return request, fmt.Errorf("literal은 key=value 형식이어야 합니다")
+		}
+		if _, exists := request.Literals[key]; exists {
+			return request, fmt.Errorf("중복된 키입니다: %s", key)
+		}
+		request.Literals[key] = value
+	}
+
+	for _, header := range r.MultipartForm.File["file"] {
+		key := filepath.Base(header.Filename)
+		if _, exists := request.Files[key]; exists {
+			return request, fmt.Errorf("중복된 파일 이름입니다: %s", key)
+		}
+		content, err := readUploadedFile(header)
+		if err != nil {
+			return request, err
+		}
+		request.Files[key] = content
+	}
+
+	// tlsCert/tlsKey는 파일로 올려도 되고 폼 값으로 보내도 됨
+	for field, target := range map[string]*string{"tlsCert": &request.TLSCert, "tlsKey": &request.TLSKey} {
+		headers := r.MultipartForm.File[field]
+		if len(headers) == 0 {
+			continue
+		}
+		content, err := readUploadedFile(headers[0])
+		if err != nil {
+			return request, err
+		}
+		*target = string(content)
+	}
+
+	return request, nil
+}
+
+// readUploadedFile - 업로드 파일 내용 읽기
+func readUploadedFile(header *multipart.FileHeader) ([]byte, error) {
+	file, err := header.Open()
+	if err != nil {
+		return nil, fmt.Errorf("업로드 파일 열기 실패 (%s): %v", header.Filename, err)
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("업로드 파일 읽기 실패 (%s): %v", header.Filename, err)
+	}
+	return content, nil
+}
@@ -0,0 +1,266 @@
+package controller
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"mykubeapp/model"
+)
+
+const testSecretValue = "s3cr3t-p@ssw0rd"
+
+// appliedSecret - kubectl apply 시 임시 파일의 Secret
+type appliedSecret struct {
+	Type     string            `yaml:"type"`
+	Data     map[string]string `yaml:"data"`
+	Metadata struct {
+		Name      string `yaml:"name"`
+		Namespace string `yaml:"namespace"`
+	} `yaml:"metadata"`
+}
+
+// appliedSecretOf - 마지막으로 적용된 Secret (임시 파일은 0600이어야 함)
+func appliedSecretOf(t *testing.T, applies *manifestRecorder) *appliedSecret {
+	t.Helper()
+	manifest := applies.last(t)
+	if manifest.mode != 0600 {
+		t.Errorf("임시 파일 권한 = %v, 기대값 0600", manifest.mode)
+	}
+	secret := &appliedSecret{}
+	decodeManifest(t, manifest, secret)
+	return secret
+}
+
+// decodedValue - base64 data 값 디코딩
+func decodedValue(t *testing.T, secret *appliedSecret, key string) string {
+	t.Helper()
+	value, err := base64.StdEncoding.DecodeString(secret.Data[key])
+	if err != nil {
+		t.Fatalf("%s 값이 올바른 base64가 아닙니다: %v", key, err)
+	}
+	return string(value)
+}
+
+// expectNoSecretValue - 응답 본문에 평문/인코딩된 값이 없는지 확인
+func expectNoSecretValue(t *testing.T, recorder *httptest.ResponseRecorder, values ...string) {
+	t.Helper()
+	body := recorder.Body.String()
+	for _, value := range values {
+		if strings.Contains(body, value) || strings.Contains(body, base64.StdEncoding.EncodeToString([]byte(value))) {
+			t.Fatalf("응답에 Secret 값이 포함되어 있습니다: %s", body)
+		}
+	}
+}
+
+// testCertificate - 테스트용 자체 서명 인증서/키 PEM
+func testCertificate(t *testing.T, notAfter time.Time) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("키 생성 실패: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "web.example.com"},
+		DNSNames:     []string{"web.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("인증서 생성 실패: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("키 직렬화 실패: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestCreateGenericSecret(t *testing.T) {
+	env := newTestEnv(t)
+	onPermissionCheck(t, env.executor, "")
+	applies := onManifest(t, env.executor, "kubectl apply", nil)
+
+	recorder := env.do(t, http.MethodPost, "/api/secrets", model.SecretRequest{
+		Name:      "db-credentials",
+		Namespace: "demo",
+		Literals:  map[string]string{"password": testSecretValue},
+		Files:     map[string][]byte{"config.json": []byte(`{"token": "abc123"}`)},
+	})
+	expectStatus(t, recorder, http.StatusOK)
+	expectNoSecretValue(t, recorder, testSecretValue, `{"token": "abc123"}`)
+
+	var response model.SecretResponse
+	decodeResponse(t, recorder, &response)
+	if response.Data.Type != "Opaque" || !reflect.DeepEqual(response.Data.Keys, []string{"config.json", "password"}) {
+		t.Fatalf("Secret 요약 = %+v", response.Data)
+	}
+	if response.Data.Sizes["password"] != len(testSecretValue) || !strings.Contains(response.Data.RedactedYaml, "<15 bytes>") {
+		t.Fatalf("크기 정보 = %v\n%s", response.Data.Sizes, response.Data.RedactedYaml)
+	}
+
+	applied := appliedSecretOf(t, applies)
+	if decodedValue(t, applied, "password") != testSecretValue || applied.Metadata.Namespace != "demo" {
+		t.Fatalf("적용된 Secret = %+v", applied)
+	}
+}
+
+func TestCreateDockerRegistrySecret(t *testing.T) {
+	env := newTestEnv(t)
+	onPermissionCheck(t, env.executor, "")
+	applies := onManifest(t, env.executor, "kubectl apply", nil)
+
+	recorder := env.do(t, http.MethodPost, "/api/secrets", model.SecretRequest{
+		Name:           "registry",
+		Type:           model.SecretTypeDockerRegistry,
+		DockerServer:   "registry.example.com",
+		DockerUsername: "deployer",
+		DockerPassword: testSecretValue,
+	})
+	expectStatus(t, recorder, http.StatusOK)
+	expectNoSecretValue(t, recorder, testSecretValue)
+
+	applied := appliedSecretOf(t, applies)
+	if applied.Type != "kubernetes.io/dockerconfigjson" {
+		t.Fatalf("Secret type = %s", applied.Type)
+	}
+	config := decodedValue(t, applied, ".dockerconfigjson")
+	auth := base64.StdEncoding.EncodeToString([]byte("deployer:" + testSecretValue))
+	if !strings.Contains(config, `"registry.example.com"`) || !strings.Contains(config, `"auth":"`+auth+`"`) {
+		t.Fatalf(".dockerconfigjson = %s", config)
+	}
+}
+
+func TestCreateTLSSecret(t *testing.T) {
+	env := newTestEnv(t)
+	onPermissionCheck(t, env.executor, "")
+	applies := onManifest(t, env.executor, "kubectl apply", nil)
+	cert, key := testCertificate(t, time.Now().Add(24*time.Hour))
+
+	recorder := env.do(t, http.MethodPost, "/api/secrets", model.SecretRequest{
+		Name:    "web-tls",
+		Type:    model.SecretTypeTLS,
+		TLSCert: cert,
+		TLSKey:  key,
+	})
+	expectStatus(t, recorder, http.StatusOK)
+	expectNoSecretValue(t, recorder, key)
+
+	var response model.SecretResponse
+	decodeResponse(t, recorder, &response)
+	certificate := response.Data.Certificate
+	if certificate == nil || certificate.Expired || !reflect.DeepEqual(certificate.DNSNames, []string{"web.example.com"}) {
+		t.Fatalf("인증서 정보 = %+v", certificate)
+	}
+	applied := appliedSecretOf(t, applies)
+	if decodedValue(t, applied, "tls.key") != key {
+		t.Fatalf("tls.key가 그대로 인코딩되어야 합니다")
+	}
+}
+
+func TestCreateTLSSecretRejectsMismatchedPair(t *testing.T) {
+	env := newTestEnv(t)
+	cert, _ := testCertificate(t, time.Now().Add(time.Hour))
+	_, otherKey := testCertificate(t, time.Now().Add(time.Hour))
+
+	recorder := env.do(t, http.MethodPost, "/api/secrets", model.SecretRequest{Name: "web-tls", Type: model.SecretTypeTLS, TLSCert: cert, TLSKey: otherKey})
+	expectStatus(t, recorder, http.StatusBadRequest)
+	if strings.Contains(recorder.Body.String(), "PRIVATE KEY") {
+		t.Fatalf("오류 메시지에 키가 포함되어 있습니다: %s", recorder.Body.String())
+	}
+	expectNoCalls(t, env.executor, "kubectl apply")
+}
+
+func TestCreateSecretValidation(t *testing.T) {
+	env := newTestEnv(t)
+
+	for _, request := range []model.SecretRequest{
+		{Name: "Bad_Name", Literals: map[string]string{"a": "b"}},
+		{Name: "empty"},
+		{Name: "bad-key", Literals: map[string]string{"a/b": "c"}},
+		{Name: "dup", Literals: map[string]string{"a": "1"}, Files: map[string][]byte{"a": []byte("2")}},
+		{Name: "registry", Type: model.SecretTypeDockerRegistry, DockerUsername: "u"},
+		{Name: "unknown", Type: "ssh-auth"},
+	} {
+		recorder := env.do(t, http.MethodPost, "/api/secrets", request)
+		expectStatus(t, recorder, http.StatusBadRequest)
+	}
+	expectNoCalls(t, env.executor, "kubectl apply")
+}
+
+func TestUploadSecret(t *testing.T) {
+	env := newTestEnv(t)
+	onPermissionCheck(t, env.executor, "")
+	applies := onManifest(t, env.executor, "kubectl apply", nil)
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	writer.WriteField("name", "app-config")
+	writer.WriteField("namespace", "demo")
+	writer.WriteField("literal", "password="+testSecretValue)
+	part, _ := writer.CreateFormFile("file", "application.yml")
+	part.Write([]byte("spring:\n  datasource:\n    password: hunter2\n"))
+	writer.Close()
+
+	request := httptest.NewRequest(http.MethodPost, "/api/secrets/upload", &body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	recorder := httptest.NewRecorder()
+	env.router.ServeHTTP(recorder, request)
+
+	expectStatus(t, recorder, http.StatusOK)
+	expectNoSecretValue(t, recorder, testSecretValue, "hunter2")
+	applied := appliedSecretOf(t, applies)
+	if decodedValue(t, applied, "password") != testSecretValue || !strings.Contains(decodedValue(t, applied, "application.yml"), "hunter2") {
+		t.Fatalf("업로드 내용이 인코딩되어야 합니다: %+v", applied.Data)
+	}
+}
+
+func TestSecretTemplateDoesNotSendValuesToModel(t *testing.T) {
+	env := newTestEnv(t)
+	env.llm.reply("apiVersion: v1\nkind: Secret\nmetadata:\n  name: db\ndata:\n  password: \"\"\n")
+
+	recorder := env.do(t, http.MethodPost, "/api/ai/template", model.AITemplateRequest{
+		TemplateType: "secret",
+		Parameters:   map[string]interface{}{"name": "db", "data": map[string]interface{}{"password": testSecretValue}},
+	})
+	expectStatus(t, recorder, http.StatusOK)
+	expectNoSecretValue(t, recorder, testSecretValue)
+
+	sent := env.llm.sent()
+	if strings.Contains(sent, testSecretValue) || !strings.Contains(sent, "password") {
+		t.Fatalf("모델에는 키 이름만 전달되어야 합니다:\n%s", sent)
+	}
+}
+
+func TestSecretTemplateRejectsApply(t *testing.T) {
+	env := newTestEnv(t)
+
+	recorder := env.do(t, http.MethodPost, "/api/ai/template", model.AITemplateRequest{
+		TemplateType: "secret",
+		Parameters:   map[string]interface{}{"name": "db", "apply": true, "data": map[string]interface{}{"password": testSecretValue}},
+	})
+	expectStatus(t, recorder, http.StatusBadRequest)
+	if !strings.Contains(recorder.Body.String(), "/api/secrets") {
+		t.Fatalf("Secret API 안내가 없습니다: %s", recorder.Body.String())
+	}
+	if sent := env.llm.sent(); sent != "" {
+		t.Fatalf("모델을 호출하지 않아야 합니다:\n%s", sent)
+	}
+	expectNoCalls(t, env.executor, "kubectl apply")
+}
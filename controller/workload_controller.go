@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"mykubeapp/model"
+	"mykubeapp/service"
+)
+
+// WorkloadController - 워크로드 운영 작업 컨트롤러
+type WorkloadController struct {
+	workloadService *service.WorkloadService
+}
+
+// NewWorkloadController - 워크로드 컨트롤러 생성자
+func NewWorkloadController() *WorkloadController {
+	return &WorkloadController{
+		workloadService: service.NewWorkloadService(),
+	}
+}
+
+// NewWorkloadControllerWithService - 지정한 서비스를 사용하는 컨트롤러 생성자 (테스트에서 가짜 실행기 주입)
+func NewWorkloadControllerWithService(workloadService *service.WorkloadService) *WorkloadController {
+	return &WorkloadController{
+		workloadService: workloadService,
+	}
+}
+
+// workloadOperationFunc - 워크로드 작업 서비스 메서드 시그니처
+type workloadOperationFunc func(ctx context.Context, kind, name string, req model.WorkloadOperationRequest) (*model.WorkloadOperation, error)
+
+// Scale - 레플리카 수 변경 (POST /api/workloads/{kind}/{name}/scale)
+func (wc *WorkloadController) Scale(w http.ResponseWriter, r *http.Request) {
+	log.Println("⚙️ POST /api/workloads/{kind}/{name}/scale - 스케일 요청")
+	wc.runOperation(w, r, wc.workloadService.Scale, "스케일")
+}
+
+// Restart - 롤링 재시작 (POST /api/workloads/{kind}/{name}/restart)
+func (wc *WorkloadController) Restart(w http.ResponseWriter, r *http.Request) {
+	log.Println("⚙️ POST /api/workloads/{kind}/{name}/restart - 재시작 요청")
+	wc.runOperation(w, r, wc.workloadService.Restart, "재시작")
+}
+
+// Pause - 롤아웃 일시 중지 (POST /api/workloads/{kind}/{name}/pause)
+func (wc *WorkloadController) Pause(w http.ResponseWriter, r *http.Request) {
+	log.Println("⚙️ POST /api/workloads/{kind}/{name}/pause - 롤아웃 일시 중지 요청")
+	wc.runOperation(w, r, wc.workloadService.Pause, "롤아웃 일시 중지")
+}
+
+// Resume - 롤아웃 재개 (POST /api/workloads/{kind}/{name}/resume)
+func (wc *WorkloadController) Resume(w http.ResponseWriter, r *http.Request) {
+	log.Println("⚙️ POST /api/workloads/{kind}/{name}/resume - 롤아웃 재개 요청")
+	wc.runOperation(w, r, wc.workloadService.Resume, "롤아웃 재개")
+}
+
+// Undo - 리비전 롤백 (POST /api/workloads/{kind}/{name}/undo)
+func (wc *WorkloadController) Undo(w http.ResponseWriter, r *http.Request) {
+	log.Println("⚙️ POST /api/workloads/{kind}/{name}/undo - 롤백 요청")
+	wc.runOperation(w, r, wc.workloadService.Undo, "롤백")
+}
+
+// History - 롤아웃 이력 (GET /api/workloads/{kind}/{name}/history?namespace=)
+func (wc *WorkloadController) History(w http.ResponseWriter, r *http.Request) {
+	log.Println("⚙️ GET /api/workloads/{kind}/{name}/history - 롤아웃 이력 조회 요청")
+
+	vars := mux.Vars(r)
+	history, err := wc.workloadService.History(r.Context(), vars["kind"], vars["name"], r.URL.Query().Get("namespace"))
+	if err != nil {
+		if writeCommandTimeout(w, err) {
+			return
+		}
+		http.Error(w, "롤아웃 이력 조회 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.RolloutHistoryResponse{}
+	response.Success = true
+	response.Message = "롤아웃 이력 조회 성공"
+	response.Data = *history
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListOperations - 작업 로그 조회 (GET /api/workloads/operations?namespace=&kind=&name=&limit=)
+func (wc *WorkloadController) ListOperations(w http.ResponseWriter, r *http.Request) {
+	log.Println("⚙️ GET /api/workloads/operations - 작업 로그 조회 요청")
+
+	query := r.URL.Query()
+	limit := 0
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			http.Error(w, "limit 값이 올바르지 않습니다", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	response := model.WorkloadOperationListResponse{}
+	response.Success = true
+	response.Message = "작업 로그 조회 성공"
+	response.Data = wc.workloadService.ListOperations(query.Get("namespace"), query.Get("kind"), query.Get("name"), limit)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// runOperation - 요청 파싱 후 작업 실행
+// 입력 오류는 400, 명령 시간 초과는 504, kubectl 실패는 기록된 작업 결과와 함께 500으로 응답
+func (wc *WorkloadController) runOperation(w http.ResponseWriter, r *http.Request, operate workloadOperationFunc, label string) {
+	var request model.WorkloadOperationRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, "잘못된 요청 형식입니다", http.StatusBadRequest)
+			return
+		}
+	}
+
+	vars := mux.Vars(r)
+	operation, err := operate(r.Context(), vars["kind"], vars["name"], request)
+	if err != nil && writeCommandTimeout(w, err) {
+		return
+	}
+	if err != nil && operation == nil {
+		http.Error(w, label+" 요청이 올바르지 않습니다: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.WorkloadOperationResponse{}
+	response.Success = err == nil
+	response.Message = label + " 완료"
+	response.Data = *operation
+
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		response.Message = label + " 실패: " + err.Error()
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+func TestWorkloadOperationFailure(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.OnError("kubectl rollout restart", errors.New("deployments.apps \"web\" not found"))
+
+	recorder := env.do(t, http.MethodPost, "/api/workloads/deploy/web/restart", model.WorkloadOperationRequest{Namespace: "web"})
+	expectStatus(t, recorder, http.StatusInternalServerError)
+
+	// 실패한 작업도 결과와 함께 응답
+	var response model.WorkloadOperationResponse
+	decodeResponse(t, recorder, &response)
+	if response.Success || response.Data.Kind != "Deployment" || response.Data.Error == "" {
+		t.Fatalf("작업 결과 = %+v", response)
+	}
+}
+
+func TestWorkloadOperationTimeout(t *testing.T) {
+	env := newTestEnv(t)
+	env.executor.OnError("kubectl rollout restart", &utils.CommandTimeoutError{Command: "kubectl rollout restart deployment/web", Timeout: time.Minute})
+
+	// 명령 시간 초과는 500이 아닌 504로 응답
+	recorder := env.do(t, http.MethodPost, "/api/workloads/deploy/web/restart", model.WorkloadOperationRequest{Namespace: "web"})
+	expectStatus(t, recorder, http.StatusGatewayTimeout)
+
+	// 시간 초과된 작업도 작업 로그에는 기록
+	recorder = env.do(t, http.MethodGet, "/api/workloads/operations?name=web", nil)
+	expectStatus(t, recorder, http.StatusOK)
+	var response model.WorkloadOperationListResponse
+	decodeResponse(t, recorder, &response)
+	if len(response.Data) != 1 || response.Data[0].Success {
+		t.Fatalf("작업 로그 = %+v", response.Data)
+	}
+}
@@ -0,0 +1,162 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"mykubeapp/model"
+)
+
+// 권한 검사 API 경로 (authorization.k8s.io/v1)
+const (
+	selfSubjectAccessReviewPath = "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews"
+	selfSubjectRulesReviewPath  = "/apis/authorization.k8s.io/v1/selfsubjectrulesreviews"
+)
+
+// AccessRequest - 단일 동작 권한 검사 대상 (SelfSubjectAccessReview resourceAttributes)
+type AccessRequest struct {
+	Group     string // API 그룹 (core는 빈 값)
+	Resource  string // 리소스 이름 (복수형, 예: deployments)
+	Namespace string // 네임스페이스 (클러스터 범위 리소스는 빈 값)
+	Verb      string // get, list, create, patch ...
+	Name      string // 특정 오브젝트 이름 (선택)
+}
+
+// AccessDecision - 단일 동작 권한 검사 결과
+type AccessDecision struct {
+	Allowed bool
+	Reason  string // 인가 모듈이 제공한 사유 (있는 경우)
+}
+
+// PolicyRule - SelfSubjectRulesReview가 반환한 리소스 규칙
+type PolicyRule struct {
+	APIGroups     []string `json:"apiGroups"`
+	Resources     []string `json:"resources"`
+	Verbs         []string `json:"verbs"`
+	ResourceNames []string `json:"resourceNames"`
+}
+
+// RulesReview - 네임스페이스에서 현재 자격 증명에 허용된 규칙 목록
+type RulesReview struct {
+	Rules           []PolicyRule
+	Incomplete      bool   // 인가 모듈이 규칙 열거를 지원하지 않아 목록이 불완전한 경우
+	EvaluationError string // 규칙 평가 중 오류 (있는 경우)
+}
+
+// CheckAccess - SelfSubjectAccessReview로 현재 자격 증명의 동작 허용 여부를 한 번에 확인 (결과는 요청 순서)
+func CheckAccess(ctx context.Context, backend ClusterBackend, kubeContext string, requests []AccessRequest) ([]AccessDecision, error) {
+	bodies := make([][]byte, len(requests))
+	for i, request := range requests {
+		attributes := map[string]string{
+			"group":    request.Group,
+			"resource": request.Resource,
+			"verb":     request.Verb,
+		}
+		if request.Namespace != "" {
+			attributes["namespace"] = request.Namespace
+		}
+		if request.Name != "" {
+			attributes["name"] = request.Name
+		}
+		bodies[i], _ = json.Marshal(map[string]interface{}{
+			"apiVersion": "authorization.k8s.io/v1",
+			"kind":       "SelfSubjectAccessReview",
+			"spec":       map[string]interface{}{"resourceAttributes": attributes},
+		})
+	}
+
+	outputs, err := backend.CreateRawBatch(ctx, kubeContext, selfSubjectAccessReviewPath, bodies)
+	if err != nil {
+		return nil, fmt.Errorf("권한 검사 요청 실패: %w", err)
+	}
+
+	decisions := make([]AccessDecision, len(outputs))
+	for i, output := range outputs {
+		var review struct {
+			Status struct {
+				Allowed         bool   `json:"allowed"`
+				Reason          string `json:"reason"`
+				EvaluationError string `json:"evaluationError"`
+			} `json:"status"`
+		}
+		if err := json.Unmarshal(output, &review); err != nil {
+			return nil, fmt.Errorf("권한 검사 응답 파싱 실패: %w", err)
+		}
+
+		decisions[i] = AccessDecision{Allowed: review.Status.Allowed, Reason: review.Status.Reason}
+		if decisions[i].Reason == "" {
+			decisions[i].Reason = review.Status.EvaluationError
+		}
+	}
+	return decisions, nil
+}
+
+// ReviewRules - SelfSubjectRulesReview로 네임스페이스에서 허용된 규칙 조회
+func ReviewRules(ctx context.Context, backend ClusterBackend, kubeContext, namespace string) (*RulesReview, error) {
+	body, _ := json.Marshal(map[string]interface{}{
+		"apiVersion": "authorization.k8s.io/v1",
+		"kind":       "SelfSubjectRulesReview",
+		"spec":       map[string]string{"namespace": namespace},
+	})
+
+	output, err := backend.CreateRaw(ctx, kubeContext, selfSubjectRulesReviewPath, body)
+	if err != nil {
+		return nil, fmt.Errorf("권한 규칙 조회 실패: %w", err)
+	}
+
+	var review struct {
+		Status struct {
+			ResourceRules   []PolicyRule `json:"resourceRules"`
+			Incomplete      bool         `json:"incomplete"`
+			EvaluationError string       `json:"evaluationError"`
+		} `json:"status"`
+	}
+	if err := json.Unmarshal(output, &review); err != nil {
+		return nil, fmt.Errorf("권한 규칙 응답 파싱 실패: %w", err)
+	}
+
+	return &RulesReview{
+		Rules:           review.Status.ResourceRules,
+		Incomplete:      review.Status.Incomplete,
+		EvaluationError: review.Status.EvaluationError,
+	}, nil
+}
+
+// Evaluate - 규칙 목록으로 동작 허용 여부 판단 (allowed, restricted, denied)
+// resourceNames가 지정된 규칙만 일치하면 일부 오브젝트에만 허용된 것이므로 restricted
+func (review *RulesReview) Evaluate(group, resource, verb string) string {
+	result := model.PermissionDenied
+	for _, rule := range review.Rules {
+		if !matchesRule(rule.APIGroups, group) || !matchesRule(rule.Resources, resource) || !matchesRule(rule.Verbs, verb) {
+			continue
+		}
+		if len(rule.ResourceNames) == 0 {
+			return model.PermissionAllowed
+		}
+		// create 요청에는 이름이 없으므로 resourceNames 규칙으로 허용되지 않음
+		if verb != "create" {
+			result = model.PermissionRestricted
+		}
+	}
+	return result
+}
+
+// matchesRule - 규칙 값 목록에 대상 또는 "*"가 있는지 확인
+func matchesRule(values []string, target string) bool {
+	return containsString(values, target) || containsString(values, "*")
+}
+
+// IsNotFoundError - 리소스가 없다는 오류인지 확인 (API 서버 404 또는 kubectl NotFound 출력)
+func IsNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var statusErr *APIStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code == 404
+	}
+	return strings.Contains(err.Error(), "NotFound") || strings.Contains(err.Error(), "not found")
+}
@@ -0,0 +1,340 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// cachedAPIClient - context별 API 클라이언트 캐시 항목 (kubeconfig가 바뀌면 다시 생성)
+type cachedAPIClient struct {
+	client  *APIClient
+	modTime time.Time
+}
+
+// APIBackend - kubeconfig 자격 증명으로 API 서버에 직접 HTTP 요청을 보내는 백엔드
+type APIBackend struct {
+	mutex   sync.Mutex
+	clients map[string]cachedAPIClient
+}
+
+// NewAPIBackend - API 백엔드 생성자
+func NewAPIBackend() *APIBackend {
+	return &APIBackend{clients: make(map[string]cachedAPIClient)}
+}
+
+// Name - 백엔드 종류
+func (ab *APIBackend) Name() string {
+	return BackendAPI
+}
+
+// Client - context의 API 클라이언트 (kubeContext가 비어 있으면 current-context)
+func (ab *APIBackend) Client(kubeContext string) (*APIClient, error) {
+	configPaths, err := utils.GetKubeConfigPaths()
+	if err != nil {
+		return nil, err
+	}
+	modTime, err := kubeconfigModTime(configPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	ab.mutex.Lock()
+	defer ab.mutex.Unlock()
+
+	key := strings.Join(configPaths, string(filepath.ListSeparator)) + "|" + kubeContext
+	if cached, ok := ab.clients[key]; ok && cached.modTime.Equal(modTime) {
+		return cached.client, nil
+	}
+
+	client, err := NewAPIClient(configPaths, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	ab.clients[key] = cachedAPIClient{client: client, modTime: modTime}
+	log.Printf("🔌 API 서버 클라이언트 생성 (context: %s, server: %s)", client.Context(), client.config.Server)
+	return client, nil
+}
+
+// kubeconfigModTime - kubeconfig 파일들 중 가장 최근 수정 시각 (하나도 없으면 오류, 목록 중 없는 파일은 무시)
+func kubeconfigModTime(paths []string) (time.Time, error) {
+	var latest time.Time
+	found := false
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		found = true
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	if !found {
+		return time.Time{}, fmt.Errorf("kubeconfig 파일을 찾을 수 없습니다: %s", strings.Join(paths, string(filepath.ListSeparator)))
+	}
+	return latest, nil
+}
+
+// CurrentContext - kubeconfig의 current-context
+func (ab *APIBackend) CurrentContext(ctx context.Context) (string, error) {
+	client, err := ab.Client("")
+	if err != nil {
+		return "", err
+	}
+	return client.Context(), nil
+}
+
+// DiscoverKinds - API 디스커버리로 리소스 종류 조회
+func (ab *APIBackend) DiscoverKinds(ctx context.Context, kubeContext string) ([]model.ResourceKind, error) {
+	client, err := ab.Client(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	return client.DiscoverKinds(ctx)
+}
+
+// GetRaw - REST 경로 JSON 조회
+func (ab *APIBackend) GetRaw(ctx context.Context, kubeContext, path string) ([]byte, error) {
+	client, err := ab.Client(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetRaw(ctx, path)
+}
+
+// ExistingObjects - 오브젝트마다 GET (프로세스 실행 비용이 없으므로 순서대로 요청)
+func (ab *APIBackend) ExistingObjects(ctx context.Context, kubeContext string, objects []ObjectReference) ([]bool, error) {
+	client, err := ab.Client(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]bool, len(objects))
+	for i, object := range objects {
+		if results[i], err = client.Exists(ctx, object.Kind, object.Namespace, object.Name); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// CreateRawBatch - 본문마다 POST (프로세스 실행 비용이 없으므로 순서대로 요청)
+func (ab *APIBackend) CreateRawBatch(ctx context.Context, kubeContext, path string, bodies [][]byte) ([][]byte, error) {
+	client, err := ab.Client(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	results := make([][]byte, 0, len(bodies))
+	for _, body := range bodies {
+		output, err := client.CreateRaw(ctx, path, body)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, output)
+	}
+	return results, nil
+}
+
+// CreateRaw - REST 경로에 JSON 본문 POST
+func (ab *APIBackend) CreateRaw(ctx context.Context, kubeContext, path string, body []byte) ([]byte, error) {
+	client, err := ab.Client(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateRaw(ctx, path, body)
+}
+
+// DefaultNamespace - kubeconfig context의 기본 네임스페이스
+func (ab *APIBackend) DefaultNamespace(ctx context.Context, kubeContext string) (string, error) {
+	client, err := ab.Client(kubeContext)
+	if err != nil {
+		return "", err
+	}
+	return client.DefaultNamespace(), nil
+}
+
+// Watch - REST 경로 watch 스트림 구독
+func (ab *APIBackend) Watch(ctx context.Context, kubeContext, path string, handle func(event WatchEvent) error) error {
+	client, err := ab.Client(kubeContext)
+	if err != nil {
+		return err
+	}
+	return client.Watch(ctx, path, handle)
+}
+
+// manifestObject - 적용/삭제 대상 도큐먼트와 해석된 리소스 정보
+type manifestObject struct {
+	object    map[string]interface{}
+	kind      *model.ResourceKind
+	namespace string
+	name      string
+	ref       string // kubectl 출력 형식의 kind[.group]/name
+}
+
+// resolveManifest - 도큐먼트별 리소스 종류/네임스페이스 해석
+// 네임스페이스는 도큐먼트 → 요청 → context 기본값 순서이며, 도큐먼트와 요청이 다르면 오류 (kubectl과 동일)
+func (ab *APIBackend) resolveManifest(ctx context.Context, client *APIClient, request ManifestRequest, index int, document map[string]interface{}) (*manifestObject, error) {
+	apiVersion, _ := document["apiVersion"].(string)
+	kind, _ := document["kind"].(string)
+	metadata, _ := document["metadata"].(map[string]interface{})
+	if apiVersion == "" || kind == "" || metadata == nil {
+		return nil, fmt.Errorf("도큐먼트 %d: apiVersion, kind, metadata가 필요합니다", index)
+	}
+	name, _ := metadata["name"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("도큐먼트 %d: metadata.name이 필요합니다", index)
+	}
+
+	resourceKind, err := client.ResolveObjectKind(ctx, apiVersion, kind)
+	if err != nil {
+		return nil, fmt.Errorf("%s/%s: %w", kind, name, err)
+	}
+
+	ref := strings.ToLower(kind)
+	if group := apiGroup(apiVersion); group != "" {
+		ref += "." + group
+	}
+	ref += "/" + name
+
+	namespace := ""
+	if resourceKind.Namespaced {
+		documentNamespace, _ := metadata["namespace"].(string)
+		switch {
+		case documentNamespace != "" && request.Namespace != "" && documentNamespace != request.Namespace:
+			return nil, fmt.Errorf("%s: 도큐먼트의 네임스페이스(%s)가 요청 네임스페이스(%s)와 다릅니다", ref, documentNamespace, request.Namespace)
+		case documentNamespace != "":
+			namespace = documentNamespace
+		case request.Namespace != "":
+			namespace = request.Namespace
+		default:
+			namespace = client.DefaultNamespace()
+		}
+		metadata["namespace"] = namespace
+	}
+
+	return &manifestObject{object: document, kind: resourceKind, namespace: namespace, name: name, ref: ref}, nil
+}
+
+// forEachManifest - 도큐먼트별로 action 실행 (실패해도 나머지 계속 진행 후 오류를 모아 반환)
+func (ab *APIBackend) forEachManifest(ctx context.Context, request ManifestRequest, action func(client *APIClient, manifest *manifestObject) (string, error)) (string, error) {
+	client, err := ab.Client(request.Context)
+	if err != nil {
+		return "", err
+	}
+	documents, err := utils.ParseYamlDocuments(request.YamlContent)
+	if err != nil {
+		return "", err
+	}
+
+	var lines []string
+	var failures []string
+	var firstErr error
+	for i, document := range documents {
+		manifest, err := ab.resolveManifest(ctx, client, request, i, document)
+		if err == nil {
+			var line string
+			line, err = action(client, manifest)
+			if err != nil {
+				err = fmt.Errorf("%s: %w", manifest.ref, err)
+			} else if line != "" {
+				lines = append(lines, line)
+			}
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			failures = append(failures, err.Error())
+			// 제한 시간 초과면 나머지 요청도 실패하므로 중단
+			if ctx.Err() != nil {
+				break
+			}
+		}
+	}
+
+	output := ""
+	if len(lines) > 0 {
+		output = strings.Join(lines, "\n") + "\n"
+	}
+	if len(failures) == 1 {
+		return output, firstErr
+	}
+	if len(failures) > 1 {
+		return output, fmt.Errorf("%d개 리소스 실패: %s", len(failures), strings.Join(failures, "; "))
+	}
+	return output, nil
+}
+
+// Patch - 오브젝트 경로에 JSON merge patch 요청
+func (ab *APIBackend) Patch(ctx context.Context, kubeContext string, object ObjectReference, patch []byte) error {
+	client, err := ab.Client(kubeContext)
+	if err != nil {
+		return err
+	}
+	return client.MergePatch(ctx, object.Kind, object.Namespace, object.Name, patch)
+}
+
+// Apply - 도큐먼트별 server-side apply
+// dry-run은 같은 PATCH를 dryRun=All로 보내 서버 검증(스키마, 어드미션)까지 확인하므로 대상 네임스페이스가 있어야 하고 patch/create 권한이 필요
+// 다른 field manager와 필드 소유권이 충돌하면 ForceConflicts를 지정하지 않는 한 덮어쓰지 않고 오류 반환
+func (ab *APIBackend) Apply(ctx context.Context, request ManifestRequest) (string, error) {
+	options := ApplyOptions{DryRun: request.DryRun, Force: request.ForceConflicts}
+	output, err := ab.forEachManifest(ctx, request, func(client *APIClient, manifest *manifestObject) (string, error) {
+		created, err := client.Apply(ctx, manifest.kind, manifest.namespace, manifest.name, manifest.object, options)
+		if IsConflict(err) {
+			return "", fmt.Errorf("다른 field manager와 필드 소유권 충돌 (덮어쓰려면 forceConflicts 지정): %w", err)
+		}
+		if err != nil {
+			return "", err
+		}
+		action := "configured"
+		if created {
+			action = "created"
+		}
+		if request.DryRun {
+			action += " (server dry run)"
+		}
+		return manifest.ref + " " + action, nil
+	})
+	if err != nil {
+		return output, fmt.Errorf("API apply 실패: %w", err)
+	}
+	return output, nil
+}
+
+// Delete - 도큐먼트별 삭제 (없는 리소스는 건너뜀, dry-run은 존재 여부만 조회)
+func (ab *APIBackend) Delete(ctx context.Context, request ManifestRequest) (string, error) {
+	output, err := ab.forEachManifest(ctx, request, func(client *APIClient, manifest *manifestObject) (string, error) {
+		var deleted bool
+		var err error
+		if request.DryRun {
+			deleted, err = client.Exists(ctx, manifest.kind, manifest.namespace, manifest.name)
+		} else {
+			deleted, err = client.Delete(ctx, manifest.kind, manifest.namespace, manifest.name)
+		}
+		if err != nil || !deleted {
+			return "", err
+		}
+		return manifest.ref + " deleted" + dryRunSuffix(request.DryRun), nil
+	})
+	if err != nil {
+		return output, fmt.Errorf("API delete 실패: %w", err)
+	}
+	return output, nil
+}
+
+// dryRunSuffix - kubectl --dry-run=client 출력과 같은 접미어
+func dryRunSuffix(dryRun bool) string {
+	if dryRun {
+		return " (dry run)"
+	}
+	return ""
+}
@@ -0,0 +1,415 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// server-side apply 필드 매니저 이름
+const applyFieldManager = "mykubeapp"
+
+// APIStatusError - API 서버가 반환한 오류 응답 (metav1.Status)
+type APIStatusError struct {
+	Code    int
+	Reason  string
+	Message string
+}
+
+func (e *APIStatusError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("API 서버 오류 (%d %s): %s", e.Code, e.Reason, e.Message)
+	}
+	return fmt.Sprintf("API 서버 오류 (%d): %s", e.Code, e.Message)
+}
+
+// IsNotFound - API 서버 404 오류 여부
+func IsNotFound(err error) bool {
+	var statusErr *APIStatusError
+	return errors.As(err, &statusErr) && statusErr.Code == http.StatusNotFound
+}
+
+// IsConflict - API 서버 409 오류 여부 (server-side apply 필드 소유권 충돌 등)
+func IsConflict(err error) bool {
+	var statusErr *APIStatusError
+	return errors.As(err, &statusErr) && statusErr.Code == http.StatusConflict
+}
+
+// WatchEvent - watch 스트림의 이벤트 한 건
+type WatchEvent struct {
+	Type   string          `json:"type"`   // ADDED, MODIFIED, DELETED, BOOKMARK, ERROR
+	Object json.RawMessage `json:"object"` // 변경된 오브젝트 (ERROR이면 Status)
+}
+
+// groupVersionResources - /api/v1, /apis/{group}/{version} 디스커버리 응답
+type groupVersionResources struct {
+	GroupVersion string `json:"groupVersion"`
+	Resources    []struct {
+		Name       string   `json:"name"`
+		Namespaced bool     `json:"namespaced"`
+		Kind       string   `json:"kind"`
+		Verbs      []string `json:"verbs"`
+		ShortNames []string `json:"shortNames"`
+	} `json:"resources"`
+}
+
+// APIClient - kubeconfig 자격 증명으로 API 서버에 직접 HTTP 요청을 보내는 클라이언트
+type APIClient struct {
+	config     *restConfig
+	httpClient *http.Client
+
+	mutex          sync.Mutex
+	groupVersions  map[string]*groupVersionResources // apiVersion별 리소스 목록 캐시 (apply 시 kind 해석)
+	groupsCachedAt time.Time
+}
+
+// NewAPIClient - kubeconfig 파일(여러 개면 kubectl처럼 병합)의 context로 API 클라이언트 생성 (kubeContext가 비어 있으면 current-context)
+func NewAPIClient(kubeconfigPaths []string, kubeContext string) (*APIClient, error) {
+	config, err := loadRestConfig(kubeconfigPaths, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = config.TLS
+
+	return &APIClient{
+		config: config,
+		// watch 요청이 끊기지 않도록 클라이언트 전체 제한 시간은 두지 않고 요청별 ctx로 제한
+		httpClient:    &http.Client{Transport: transport},
+		groupVersions: make(map[string]*groupVersionResources),
+	}, nil
+}
+
+// Context - 클라이언트가 사용하는 kubeconfig context
+func (c *APIClient) Context() string {
+	return c.config.Context
+}
+
+// DefaultNamespace - context의 기본 네임스페이스 (없으면 default)
+func (c *APIClient) DefaultNamespace() string {
+	if c.config.Namespace != "" {
+		return c.config.Namespace
+	}
+	return "default"
+}
+
+// request - API 서버 요청 (2xx가 아니면 APIStatusError, ctx 제한 시간 초과면 CommandTimeoutError)
+func (c *APIClient) request(ctx context.Context, method, path, contentType string, body []byte) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.config.Server+path, reader)
+	if err != nil {
+		return nil, fmt.Errorf("API 요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.Token)
+	}
+
+	started := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			timeout := time.Duration(0)
+			if deadline, ok := ctx.Deadline(); ok {
+				timeout = deadline.Sub(started).Round(time.Second)
+			}
+			return nil, &utils.CommandTimeoutError{Command: method + " " + path, Timeout: timeout}
+		}
+		return nil, fmt.Errorf("API 서버 요청 실패: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+
+		statusErr := &APIStatusError{Code: resp.StatusCode, Message: strings.TrimSpace(string(data))}
+		var status struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &status) == nil && status.Message != "" {
+			statusErr.Reason = status.Reason
+			statusErr.Message = status.Message
+		}
+		return nil, statusErr
+	}
+	return resp, nil
+}
+
+// requestJSON - 요청 후 JSON 응답을 target으로 파싱 (201 Created 여부 반환)
+func (c *APIClient) requestJSON(ctx context.Context, method, path, contentType string, body []byte, target interface{}) (bool, error) {
+	resp, err := c.request(ctx, method, path, contentType, body)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if target != nil {
+		if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+			return false, fmt.Errorf("API 응답 파싱 실패: %w", err)
+		}
+	}
+	return resp.StatusCode == http.StatusCreated, nil
+}
+
+// GetRaw - REST 경로의 JSON 응답 그대로 조회 (kubectl get --raw 와 동일)
+func (c *APIClient) GetRaw(ctx context.Context, path string) ([]byte, error) {
+	var raw json.RawMessage
+	if _, err := c.requestJSON(ctx, http.MethodGet, path, "", nil, &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// CreateRaw - REST 경로에 JSON 본문을 POST하고 응답 그대로 반환 (kubectl create --raw 와 동일)
+func (c *APIClient) CreateRaw(ctx context.Context, path string, body []byte) ([]byte, error) {
+	var raw json.RawMessage
+	if _, err := c.requestJSON(ctx, http.MethodPost, path, "application/json", body, &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// DiscoverKinds - 목록 조회가 가능한 리소스 종류 (그룹별 선호 버전 기준, 하위 리소스 제외)
+func (c *APIClient) DiscoverKinds(ctx context.Context) ([]model.ResourceKind, error) {
+	var groups struct {
+		Groups []struct {
+			PreferredVersion struct {
+				GroupVersion string `json:"groupVersion"`
+			} `json:"preferredVersion"`
+		} `json:"groups"`
+	}
+	if _, err := c.requestJSON(ctx, http.MethodGet, "/apis", "", nil, &groups); err != nil {
+		return nil, fmt.Errorf("API 그룹 조회 실패: %w", err)
+	}
+
+	groupVersions := []string{"v1"}
+	for _, group := range groups.Groups {
+		if group.PreferredVersion.GroupVersion != "" {
+			groupVersions = append(groupVersions, group.PreferredVersion.GroupVersion)
+		}
+	}
+
+	// 그룹 버전별 리소스 목록은 병렬 조회 (집계 API 장애 등 일부 실패는 건너뜀)
+	results := make([]*groupVersionResources, len(groupVersions))
+	var wg sync.WaitGroup
+	for i, groupVersion := range groupVersions {
+		wg.Add(1)
+		go func(i int, groupVersion string) {
+			defer wg.Done()
+			resources, err := c.groupVersionResources(ctx, groupVersion)
+			if err == nil {
+				results[i] = resources
+			}
+		}(i, groupVersion)
+	}
+	wg.Wait()
+	if results[0] == nil {
+		return nil, fmt.Errorf("core API 리소스 조회 실패")
+	}
+
+	var kinds []model.ResourceKind
+	for _, resources := range results {
+		if resources == nil {
+			continue
+		}
+		for _, resource := range resources.Resources {
+			if strings.Contains(resource.Name, "/") || !containsString(resource.Verbs, "list") {
+				continue
+			}
+			shortNames := resource.ShortNames
+			if shortNames == nil {
+				shortNames = []string{}
+			}
+			kinds = append(kinds, model.ResourceKind{
+				Name:       resource.Name,
+				ShortNames: shortNames,
+				APIVersion: resources.GroupVersion,
+				Namespaced: resource.Namespaced,
+				Kind:       resource.Kind,
+			})
+		}
+	}
+
+	// core 그룹 다음 선호 버전 순서로 조회했으므로 이름이 같으면 그 순서 유지
+	SortResourceKinds(kinds)
+	return kinds, nil
+}
+
+// groupVersionResources - 그룹 버전의 리소스 목록 (디스커버리 캐시 사용)
+func (c *APIClient) groupVersionResources(ctx context.Context, apiVersion string) (*groupVersionResources, error) {
+	c.mutex.Lock()
+	if time.Since(c.groupsCachedAt) > discoveryCacheTTL {
+		c.groupVersions = make(map[string]*groupVersionResources)
+		c.groupsCachedAt = time.Now()
+	}
+	cached := c.groupVersions[apiVersion]
+	c.mutex.Unlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	path := "/apis/" + apiVersion
+	if apiGroup(apiVersion) == "" {
+		path = "/api/" + apiVersion
+	}
+	var resources groupVersionResources
+	if _, err := c.requestJSON(ctx, http.MethodGet, path, "", nil, &resources); err != nil {
+		return nil, err
+	}
+	resources.GroupVersion = apiVersion
+
+	c.mutex.Lock()
+	c.groupVersions[apiVersion] = &resources
+	c.mutex.Unlock()
+	return &resources, nil
+}
+
+// ResolveObjectKind - 매니페스트의 apiVersion/kind에 해당하는 리소스 종류
+func (c *APIClient) ResolveObjectKind(ctx context.Context, apiVersion, kind string) (*model.ResourceKind, error) {
+	resources, err := c.groupVersionResources(ctx, apiVersion)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, fmt.Errorf("클러스터에 없는 API 버전입니다: %s", apiVersion)
+		}
+		return nil, err
+	}
+
+	for _, resource := range resources.Resources {
+		if resource.Kind == kind && !strings.Contains(resource.Name, "/") {
+			return &model.ResourceKind{
+				Name:       resource.Name,
+				ShortNames: resource.ShortNames,
+				APIVersion: apiVersion,
+				Namespaced: resource.Namespaced,
+				Kind:       resource.Kind,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("%s에 %s 리소스가 없습니다", apiVersion, kind)
+}
+
+// Watch - REST 경로를 watch=true로 구독하여 이벤트마다 handle 호출 (ctx 취소, 서버 종료, handle 오류 시 반환)
+func (c *APIClient) Watch(ctx context.Context, path string, handle func(event WatchEvent) error) error {
+	resp, err := c.request(ctx, http.MethodGet, withQuery(path, "watch", "true"), "", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var event WatchEvent
+		if err := decoder.Decode(&event); err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("watch 스트림 파싱 실패: %w", err)
+		}
+		if err := handle(event); err != nil {
+			return err
+		}
+	}
+}
+
+// ApplyOptions - server-side apply 옵션
+type ApplyOptions struct {
+	DryRun bool // dryRun=All (검증과 어드미션까지만 수행하고 저장하지 않음)
+	Force  bool // 다른 field manager와 충돌하는 필드도 소유권을 가져옴 (force=true)
+}
+
+// Apply - server-side apply (PATCH application/apply-patch+yaml), 새로 생성되었으면(dry-run은 생성될 예정이면) true
+// Force 없이 다른 field manager와 충돌하면 409 오류 (IsConflict)
+func (c *APIClient) Apply(ctx context.Context, kind *model.ResourceKind, namespace, name string, object map[string]interface{}, options ApplyOptions) (bool, error) {
+	body, err := json.Marshal(object)
+	if err != nil {
+		return false, fmt.Errorf("오브젝트 직렬화 실패: %w", err)
+	}
+
+	params := url.Values{}
+	params.Set("fieldManager", applyFieldManager)
+	if options.DryRun {
+		params.Set("dryRun", "All")
+	}
+	if options.Force {
+		params.Set("force", "true")
+	}
+
+	path := ResourcePath(kind, namespace, name) + "?" + params.Encode()
+	return c.requestJSON(ctx, http.MethodPatch, path, "application/apply-patch+yaml", body, nil)
+}
+
+// MergePatch - JSON merge patch (PATCH application/merge-patch+json, kubectl patch --type merge 와 동일)
+func (c *APIClient) MergePatch(ctx context.Context, kind *model.ResourceKind, namespace, name string, patch []byte) error {
+	_, err := c.requestJSON(ctx, http.MethodPatch, ResourcePath(kind, namespace, name), "application/merge-patch+json", patch, nil)
+	return err
+}
+
+// Exists - 오브젝트 존재 여부 (권한 사전 검사, dry-run delete에서 조회만 수행)
+func (c *APIClient) Exists(ctx context.Context, kind *model.ResourceKind, namespace, name string) (bool, error) {
+	_, err := c.requestJSON(ctx, http.MethodGet, ResourcePath(kind, namespace, name), "", nil, nil)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Delete - 리소스 삭제 (없으면 false, 하위 리소스는 백그라운드 삭제)
+func (c *APIClient) Delete(ctx context.Context, kind *model.ResourceKind, namespace, name string) (bool, error) {
+	options := map[string]interface{}{
+		"apiVersion":        "v1",
+		"kind":              "DeleteOptions",
+		"propagationPolicy": "Background",
+	}
+	body, _ := json.Marshal(options)
+
+	_, err := c.requestJSON(ctx, http.MethodDelete, ResourcePath(kind, namespace, name), "application/json", body, nil)
+	if IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// withQuery - 경로에 쿼리 파라미터 추가 (기존 쿼리 유지)
+func withQuery(path, key, value string) string {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	return path + separator + url.QueryEscape(key) + "=" + url.QueryEscape(value)
+}
+
+// containsString - 문자열 목록 포함 여부
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,436 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"mykubeapp/model"
+)
+
+const testToken = "test-token"
+
+// fakeAPIServer - 디스커버리, 오브젝트 조회, server-side apply, merge patch, 삭제, watch만 흉내내는 TLS API 서버
+type fakeAPIServer struct {
+	server *httptest.Server
+
+	mutex   sync.Mutex
+	objects map[string]bool // 존재하는 오브젝트 경로
+	owned   map[string]bool // 다른 field manager가 필드를 소유한 오브젝트 경로 (force 없이 apply하면 409)
+	applied []string        // apply 요청 기록 (경로?쿼리)
+	patched []string        // merge patch 요청 기록 (경로 본문)
+}
+
+func newFakeAPIServer(t *testing.T) *fakeAPIServer {
+	t.Helper()
+	fake := &fakeAPIServer{objects: make(map[string]bool), owned: make(map[string]bool)}
+	fake.server = httptest.NewTLSServer(http.HandlerFunc(fake.handle))
+	t.Cleanup(fake.server.Close)
+	return fake
+}
+
+func (f *fakeAPIServer) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+testToken {
+		writeStatus(w, http.StatusUnauthorized, "Unauthorized", "Unauthorized")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/apis":
+		fmt.Fprint(w, `{"groups": [
+			{"name": "events.k8s.io", "preferredVersion": {"groupVersion": "events.k8s.io/v1"}},
+			{"name": "apps", "preferredVersion": {"groupVersion": "apps/v1"}}
+		]}`)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1":
+		fmt.Fprint(w, `{"groupVersion": "v1", "resources": [
+			{"name": "configmaps", "namespaced": true, "kind": "ConfigMap", "verbs": ["create", "delete", "get", "list", "patch", "watch"], "shortNames": ["cm"]},
+			{"name": "events", "namespaced": true, "kind": "Event", "verbs": ["get", "list", "watch"], "shortNames": ["ev"]},
+			{"name": "namespaces", "namespaced": false, "kind": "Namespace", "verbs": ["create", "get", "list", "patch"], "shortNames": ["ns"]},
+			{"name": "pods", "namespaced": true, "kind": "Pod", "verbs": ["get", "list"], "shortNames": ["po"]},
+			{"name": "pods/log", "namespaced": true, "kind": "Pod", "verbs": ["get"]},
+			{"name": "bindings", "namespaced": true, "kind": "Binding", "verbs": ["create"]}
+		]}`)
+	case r.Method == http.MethodGet && r.URL.Path == "/apis/events.k8s.io/v1":
+		fmt.Fprint(w, `{"groupVersion": "events.k8s.io/v1", "resources": [
+			{"name": "events", "namespaced": true, "kind": "Event", "verbs": ["get", "list", "watch"], "shortNames": ["ev"]}
+		]}`)
+	case r.Method == http.MethodGet && r.URL.Path == "/apis/apps/v1":
+		fmt.Fprint(w, `{"groupVersion": "apps/v1", "resources": [
+			{"name": "deployments", "namespaced": true, "kind": "Deployment", "verbs": ["create", "delete", "get", "list", "patch"], "shortNames": ["deploy"]},
+			{"name": "deployments/scale", "namespaced": true, "kind": "Scale", "verbs": ["get", "patch"]}
+		]}`)
+	case r.Method == http.MethodGet && r.URL.Query().Get("watch") == "true":
+		fmt.Fprintln(w, `{"type": "ADDED", "object": {"metadata": {"name": "web.1"}, "reason": "Scheduled"}}`)
+		fmt.Fprintln(w, `{"type": "MODIFIED", "object": {"metadata": {"name": "web.1"}, "reason": "Pulled"}}`)
+	case r.Method == http.MethodGet && f.exists(r.URL.Path):
+		fmt.Fprint(w, `{"kind": "Object"}`)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/namespaces/demo/pods":
+		fmt.Fprint(w, `{"kind": "PodList", "items": [{"metadata": {"name": "web-1"}}]}`)
+	case r.Method == http.MethodPatch && r.Header.Get("Content-Type") == "application/merge-patch+json":
+		if !f.exists(r.URL.Path) {
+			writeStatus(w, http.StatusNotFound, "NotFound", "not found")
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		f.mutex.Lock()
+		f.patched = append(f.patched, r.URL.Path+" "+string(body))
+		f.mutex.Unlock()
+		w.Write(body)
+	case r.Method == http.MethodPatch:
+		if r.Header.Get("Content-Type") != "application/apply-patch+yaml" {
+			writeStatus(w, http.StatusUnsupportedMediaType, "UnsupportedMediaType", "apply-patch가 아닙니다")
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		var object map[string]interface{}
+		if err := json.Unmarshal(body, &object); err != nil {
+			writeStatus(w, http.StatusBadRequest, "BadRequest", err.Error())
+			return
+		}
+
+		f.mutex.Lock()
+		f.applied = append(f.applied, r.URL.Path+"?"+r.URL.RawQuery)
+		existed := f.objects[r.URL.Path]
+		conflict := f.owned[r.URL.Path] && r.URL.Query().Get("force") != "true"
+		if !conflict && r.URL.Query().Get("dryRun") != "All" {
+			f.objects[r.URL.Path] = true
+		}
+		f.mutex.Unlock()
+
+		if conflict {
+			writeStatus(w, http.StatusConflict, "Conflict", `Apply failed with 1 conflict: conflict with "kubectl-edit": .spec.replicas`)
+			return
+		}
+		if !existed {
+			w.WriteHeader(http.StatusCreated)
+		}
+		w.Write(body)
+	case r.Method == http.MethodDelete:
+		f.mutex.Lock()
+		existed := f.objects[r.URL.Path]
+		delete(f.objects, r.URL.Path)
+		f.mutex.Unlock()
+
+		if !existed {
+			writeStatus(w, http.StatusNotFound, "NotFound", "not found")
+			return
+		}
+		fmt.Fprint(w, `{"kind": "Status", "status": "Success"}`)
+	default:
+		writeStatus(w, http.StatusNotFound, "NotFound", "the server could not find the requested resource")
+	}
+}
+
+// exists - 오브젝트 존재 여부
+func (f *fakeAPIServer) exists(path string) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.objects[path]
+}
+
+func writeStatus(w http.ResponseWriter, code int, reason, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]interface{}{"kind": "Status", "status": "Failure", "code": code, "reason": reason, "message": message})
+}
+
+// writeKubeconfig - 가짜 서버 CA와 토큰을 사용하는 kubeconfig 작성 후 경로 반환
+func (f *fakeAPIServer) writeKubeconfig(t *testing.T, token, namespace string) string {
+	t.Helper()
+	caData := base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: f.server.Certificate().Raw}))
+	config := fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: fake
+clusters:
+- name: fake
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+contexts:
+- name: fake
+  context:
+    cluster: fake
+    user: tester
+    namespace: %s
+users:
+- name: tester
+  user:
+    token: %s
+`, f.server.URL, caData, namespace, token)
+
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatalf("kubeconfig 작성 실패: %v", err)
+	}
+	return path
+}
+
+func TestAPIClientDiscoverKinds(t *testing.T) {
+	fake := newFakeAPIServer(t)
+	client, err := NewAPIClient([]string{fake.writeKubeconfig(t, testToken, "")}, "")
+	if err != nil {
+		t.Fatalf("클라이언트 생성 실패: %v", err)
+	}
+
+	kinds, err := client.DiscoverKinds(context.Background())
+	if err != nil {
+		t.Fatalf("디스커버리 실패: %v", err)
+	}
+
+	// 같은 이름이 여러 그룹에 있으면 core 그룹이 먼저 (첫 번째 항목만 기록)
+	names := map[string]string{}
+	for _, kind := range kinds {
+		if _, ok := names[kind.Name]; !ok {
+			names[kind.Name] = kind.APIVersion
+		}
+	}
+	if names["deployments"] != "apps/v1" || names["pods"] != "v1" || names["namespaces"] != "v1" || names["events"] != "v1" {
+		t.Fatalf("리소스 종류 = %v", names)
+	}
+	if _, ok := names["pods/log"]; ok {
+		t.Fatalf("하위 리소스는 제외되어야 합니다: %v", names)
+	}
+	if _, ok := names["bindings"]; ok {
+		t.Fatalf("list 동사가 없는 리소스는 제외되어야 합니다: %v", names)
+	}
+}
+
+func TestAPIClientRejectedToken(t *testing.T) {
+	fake := newFakeAPIServer(t)
+	client, err := NewAPIClient([]string{fake.writeKubeconfig(t, "wrong-token", "")}, "")
+	if err != nil {
+		t.Fatalf("클라이언트 생성 실패: %v", err)
+	}
+
+	_, err = client.GetRaw(context.Background(), "/api/v1/namespaces/demo/pods")
+	statusErr, ok := err.(*APIStatusError)
+	if !ok || statusErr.Code != http.StatusUnauthorized || statusErr.Reason != "Unauthorized" {
+		t.Fatalf("401 APIStatusError가 반환되어야 합니다: %v", err)
+	}
+}
+
+func TestAPIClientUnknownContext(t *testing.T) {
+	fake := newFakeAPIServer(t)
+	if _, err := NewAPIClient([]string{fake.writeKubeconfig(t, testToken, "")}, "missing"); err == nil {
+		t.Fatalf("없는 context는 오류여야 합니다")
+	}
+}
+
+func TestAPIBackendMergesKubeconfigList(t *testing.T) {
+	fake := newFakeAPIServer(t)
+
+	// 앞 파일의 current-context/context/user가 우선하고, cluster는 뒤 파일에서 가져옴
+	first := filepath.Join(t.TempDir(), "first")
+	err := os.WriteFile(first, []byte(`apiVersion: v1
+kind: Config
+current-context: fake
+contexts:
+- name: fake
+  context:
+    cluster: fake
+    user: tester
+    namespace: first
+users:
+- name: tester
+  user:
+    token: `+testToken+`
+`), 0600)
+	if err != nil {
+		t.Fatalf("kubeconfig 작성 실패: %v", err)
+	}
+	second := fake.writeKubeconfig(t, "wrong-token", "second")
+	missing := filepath.Join(t.TempDir(), "missing")
+	t.Setenv("KUBECONFIG", strings.Join([]string{missing, first, second}, string(filepath.ListSeparator)))
+
+	backend := NewAPIBackend()
+	namespace, err := backend.DefaultNamespace(context.Background(), "")
+	if err != nil || namespace != "first" {
+		t.Fatalf("기본 네임스페이스 = %q, %v", namespace, err)
+	}
+	if _, err := backend.DiscoverKinds(context.Background(), ""); err != nil {
+		t.Fatalf("병합한 kubeconfig로 요청 실패: %v", err)
+	}
+}
+
+func TestAPIClientWatch(t *testing.T) {
+	fake := newFakeAPIServer(t)
+	client, err := NewAPIClient([]string{fake.writeKubeconfig(t, testToken, "")}, "")
+	if err != nil {
+		t.Fatalf("클라이언트 생성 실패: %v", err)
+	}
+
+	var types []string
+	err = client.Watch(context.Background(), "/api/v1/namespaces/demo/events?fieldSelector=type%3DNormal", func(event WatchEvent) error {
+		types = append(types, event.Type)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("watch 실패: %v", err)
+	}
+	if strings.Join(types, ",") != "ADDED,MODIFIED" {
+		t.Fatalf("watch 이벤트 = %v", types)
+	}
+}
+
+const testManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 1
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  mode: blue
+`
+
+func TestAPIBackendApplyAndDelete(t *testing.T) {
+	fake := newFakeAPIServer(t)
+	t.Setenv("KUBECONFIG", fake.writeKubeconfig(t, testToken, "team"))
+	backend := NewAPIBackend()
+	ctx := context.Background()
+
+	output, err := backend.Apply(ctx, ManifestRequest{YamlContent: testManifest, Namespace: "demo"})
+	if err != nil {
+		t.Fatalf("apply 실패: %v", err)
+	}
+	if output != "deployment.apps/web created\nconfigmap/settings created\n" {
+		t.Fatalf("apply 출력 = %q", output)
+	}
+	if len(fake.applied) != 2 || fake.applied[0] != "/apis/apps/v1/namespaces/demo/deployments/web?fieldManager=mykubeapp" {
+		t.Fatalf("apply 요청 = %v", fake.applied)
+	}
+
+	// dry-run은 같은 PATCH를 dryRun=All로 보내고 저장하지 않음
+	output, err = backend.Apply(ctx, ManifestRequest{YamlContent: testManifest, Namespace: "demo", DryRun: true})
+	if err != nil || output != "deployment.apps/web configured (server dry run)\nconfigmap/settings configured (server dry run)\n" {
+		t.Fatalf("재적용 dry-run 출력 = %q, %v", output, err)
+	}
+	output, err = backend.Apply(ctx, ManifestRequest{YamlContent: testManifest, Namespace: "fresh", DryRun: true})
+	if err != nil || output != "deployment.apps/web created (server dry run)\nconfigmap/settings created (server dry run)\n" {
+		t.Fatalf("새 오브젝트 dry-run 출력 = %q, %v", output, err)
+	}
+	if len(fake.applied) != 6 || fake.applied[2] != "/apis/apps/v1/namespaces/demo/deployments/web?dryRun=All&fieldManager=mykubeapp" {
+		t.Fatalf("dry-run apply 요청 = %v", fake.applied)
+	}
+	if fake.exists("/apis/apps/v1/namespaces/fresh/deployments/web") {
+		t.Fatalf("dry-run은 오브젝트를 저장하지 않아야 합니다")
+	}
+
+	output, err = backend.Delete(ctx, ManifestRequest{YamlContent: testManifest, Namespace: "demo"})
+	if err != nil || output != "deployment.apps/web deleted\nconfigmap/settings deleted\n" {
+		t.Fatalf("delete 출력 = %q, %v", output, err)
+	}
+
+	// 이미 없는 리소스는 kubectl --ignore-not-found처럼 건너뜀
+	output, err = backend.Delete(ctx, ManifestRequest{YamlContent: testManifest, Namespace: "demo"})
+	if err != nil || output != "" {
+		t.Fatalf("없는 리소스 delete 출력 = %q, %v", output, err)
+	}
+}
+
+func TestAPIBackendApplyNamespaces(t *testing.T) {
+	fake := newFakeAPIServer(t)
+	t.Setenv("KUBECONFIG", fake.writeKubeconfig(t, testToken, "team"))
+	backend := NewAPIBackend()
+
+	// 요청 네임스페이스가 없으면 context의 기본 네임스페이스
+	if _, err := backend.Apply(context.Background(), ManifestRequest{YamlContent: testManifest}); err != nil {
+		t.Fatalf("apply 실패: %v", err)
+	}
+	if !strings.HasPrefix(fake.applied[0], "/apis/apps/v1/namespaces/team/deployments/web?") {
+		t.Fatalf("context 기본 네임스페이스를 사용해야 합니다: %v", fake.applied)
+	}
+
+	// 도큐먼트와 요청 네임스페이스가 다르면 해당 도큐먼트만 실패하고 나머지는 적용
+	manifest := strings.Replace(testManifest, "name: web", "name: web\n  namespace: other", 1)
+	output, err := backend.Apply(context.Background(), ManifestRequest{YamlContent: manifest, Namespace: "demo"})
+	if err == nil || !strings.Contains(err.Error(), "other") {
+		t.Fatalf("네임스페이스 불일치는 오류여야 합니다: %v", err)
+	}
+	if output != "configmap/settings created\n" {
+		t.Fatalf("나머지 도큐먼트는 적용되어야 합니다: %q", output)
+	}
+}
+
+func TestAPIBackendApplyConflict(t *testing.T) {
+	fake := newFakeAPIServer(t)
+	t.Setenv("KUBECONFIG", fake.writeKubeconfig(t, testToken, "team"))
+	backend := NewAPIBackend()
+	fake.owned["/apis/apps/v1/namespaces/demo/deployments/web"] = true
+
+	// 다른 field manager 소유 필드는 기본적으로 덮어쓰지 않고 409 오류
+	output, err := backend.Apply(context.Background(), ManifestRequest{YamlContent: testManifest, Namespace: "demo"})
+	if !IsConflict(err) || !strings.Contains(err.Error(), "forceConflicts") {
+		t.Fatalf("필드 소유권 충돌은 409 오류여야 합니다: %v", err)
+	}
+	if output != "configmap/settings created\n" {
+		t.Fatalf("충돌하지 않은 도큐먼트는 적용되어야 합니다: %q", output)
+	}
+
+	// forceConflicts를 지정하면 force=true로 소유권을 가져옴
+	output, err = backend.Apply(context.Background(), ManifestRequest{YamlContent: testManifest, Namespace: "demo", ForceConflicts: true})
+	if err != nil || !strings.HasPrefix(output, "deployment.apps/web created") {
+		t.Fatalf("강제 적용 출력 = %q, %v", output, err)
+	}
+	if last := fake.applied[len(fake.applied)-2]; last != "/apis/apps/v1/namespaces/demo/deployments/web?fieldManager=mykubeapp&force=true" {
+		t.Fatalf("강제 적용 요청 = %s", last)
+	}
+}
+
+func TestAPIBackendPatch(t *testing.T) {
+	fake := newFakeAPIServer(t)
+	t.Setenv("KUBECONFIG", fake.writeKubeconfig(t, testToken, "team"))
+	backend := NewAPIBackend()
+	deployments := &model.ResourceKind{Name: "deployments", APIVersion: "apps/v1", Namespaced: true, Kind: "Deployment"}
+	fake.objects["/apis/apps/v1/namespaces/demo/deployments/web"] = true
+
+	patch := `{"metadata":{"annotations":{"team":"web"}}}`
+	if err := backend.Patch(context.Background(), "", ObjectReference{Kind: deployments, Namespace: "demo", Name: "web"}, []byte(patch)); err != nil {
+		t.Fatalf("patch 실패: %v", err)
+	}
+	if len(fake.patched) != 1 || fake.patched[0] != "/apis/apps/v1/namespaces/demo/deployments/web "+patch {
+		t.Fatalf("patch 요청 = %v", fake.patched)
+	}
+
+	// 없는 오브젝트는 404 오류
+	err := backend.Patch(context.Background(), "", ObjectReference{Kind: deployments, Namespace: "demo", Name: "api"}, []byte(patch))
+	if !IsNotFound(err) {
+		t.Fatalf("없는 오브젝트 patch는 404 오류여야 합니다: %v", err)
+	}
+}
+
+func TestAPIBackendApplyUnknownKind(t *testing.T) {
+	fake := newFakeAPIServer(t)
+	t.Setenv("KUBECONFIG", fake.writeKubeconfig(t, testToken, ""))
+
+	manifest := "apiVersion: example.com/v1\nkind: Widget\nmetadata:\n  name: w\n"
+	if _, err := NewAPIBackend().Apply(context.Background(), ManifestRequest{YamlContent: manifest}); err == nil {
+		t.Fatalf("클러스터에 없는 API 버전은 오류여야 합니다")
+	}
+	if len(fake.applied) != 0 {
+		t.Fatalf("apply 요청이 없어야 합니다: %v", fake.applied)
+	}
+}
+
+func TestBackendFromEnv(t *testing.T) {
+	for value, expected := range map[string]string{"": BackendKubectl, "API": BackendAPI, "kubectl": BackendKubectl, "grpc": BackendKubectl} {
+		t.Setenv("KUBE_BACKEND", value)
+		if backend := BackendFromEnv(); backend != expected {
+			t.Fatalf("KUBE_BACKEND=%q → %s, 기대값 %s", value, backend, expected)
+		}
+	}
+}
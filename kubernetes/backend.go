@@ -0,0 +1,85 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+	"os"
+	"strings"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 클러스터 백엔드 종류 (KUBE_BACKEND 환경변수)
+const (
+	BackendKubectl = "kubectl" // kubectl 프로세스 실행 (기본값)
+	BackendAPI     = "api"     // kubeconfig 자격 증명으로 API 서버에 직접 HTTP 요청
+)
+
+// BackendFromEnv - KUBE_BACKEND 환경변수의 백엔드 종류 (없거나 잘못된 값이면 kubectl)
+func BackendFromEnv() string {
+	switch value := strings.ToLower(strings.TrimSpace(os.Getenv("KUBE_BACKEND"))); value {
+	case "", BackendKubectl:
+		return BackendKubectl
+	case BackendAPI:
+		return BackendAPI
+	default:
+		log.Printf("⚠️  알 수 없는 KUBE_BACKEND 값입니다 (%s), kubectl 백엔드를 사용합니다", value)
+		return BackendKubectl
+	}
+}
+
+// ManifestRequest - 백엔드에 전달하는 매니페스트 적용/삭제 요청
+type ManifestRequest struct {
+	Context        string // 대상 context (비어 있으면 현재 context)
+	Namespace      string // 네임스페이스 (비어 있으면 매니페스트/context 기본값)
+	YamlContent    string // 멀티 도큐먼트 YAML
+	DryRun         bool   // dry-run 여부 (kubectl 백엔드는 --dry-run=client, API 백엔드는 dryRun=All)
+	ForceConflicts bool   // server-side apply 필드 소유권 충돌 시 강제 적용 (API 백엔드 전용, kubectl 백엔드의 client-side apply는 충돌 개념 없음)
+}
+
+// ObjectReference - 존재 여부를 조회할 오브젝트
+type ObjectReference struct {
+	Kind      *model.ResourceKind // 리소스 종류
+	Namespace string              // 네임스페이스 (클러스터 범위 리소스는 빈 값)
+	Name      string              // 오브젝트 이름
+}
+
+// ClusterBackend - 클러스터 조회/변경 백엔드 (kubectl 또는 API 서버 직접 호출)
+//
+// Apply/Delete 출력은 두 백엔드 모두 kubectl과 같은 "kind[.group]/name created" 형식이므로
+// 기존 출력 파싱(리소스 목록 추출)을 그대로 사용할 수 있습니다.
+type ClusterBackend interface {
+	// Name - 백엔드 종류 (kubectl, api)
+	Name() string
+	// CurrentContext - kubeconfig의 현재 context 이름
+	CurrentContext(ctx context.Context) (string, error)
+	// DiscoverKinds - 목록 조회가 가능한 리소스 종류
+	DiscoverKinds(ctx context.Context, kubeContext string) ([]model.ResourceKind, error)
+	// GetRaw - REST 경로 JSON 조회
+	GetRaw(ctx context.Context, kubeContext, path string) ([]byte, error)
+	// ExistingObjects - 오브젝트별 존재 여부를 한 번에 조회, 결과는 요청 순서 (본문은 반환하지 않으며 로그에도 남기지 않음)
+	ExistingObjects(ctx context.Context, kubeContext string, objects []ObjectReference) ([]bool, error)
+	// CreateRaw - REST 경로에 JSON 본문 POST (SelfSubjectAccessReview 등 생성형 API)
+	CreateRaw(ctx context.Context, kubeContext, path string, body []byte) ([]byte, error)
+	// CreateRawBatch - 같은 REST 경로에 여러 본문 POST, 응답은 요청 순서대로 반환
+	CreateRawBatch(ctx context.Context, kubeContext, path string, bodies [][]byte) ([][]byte, error)
+	// DefaultNamespace - context의 기본 네임스페이스 (없으면 default)
+	DefaultNamespace(ctx context.Context, kubeContext string) (string, error)
+	// Watch - REST 경로 watch 스트림 구독 (ctx 취소 또는 스트림 종료 시 반환)
+	Watch(ctx context.Context, kubeContext, path string, handle func(event WatchEvent) error) error
+	// Patch - 오브젝트에 JSON merge patch 적용 (어노테이션 갱신처럼 일부 필드만 변경)
+	Patch(ctx context.Context, kubeContext string, object ObjectReference, patch []byte) error
+	// Apply - 매니페스트 적용
+	Apply(ctx context.Context, request ManifestRequest) (string, error)
+	// Delete - 매니페스트 삭제 (없는 리소스는 무시)
+	Delete(ctx context.Context, request ManifestRequest) (string, error)
+}
+
+// NewClusterBackend - KUBE_BACKEND 설정에 따른 백엔드 생성 (kubectl 백엔드는 executor로 명령 실행)
+func NewClusterBackend(executor utils.CommandExecutor) ClusterBackend {
+	if BackendFromEnv() == BackendAPI {
+		return NewAPIBackend()
+	}
+	return NewKubectlBackend(executor)
+}
@@ -0,0 +1,53 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"mykubeapp/utils"
+)
+
+// Diff - kubectl diff로 대상 클러스터의 현재 상태와 매니페스트 비교 (차이가 있으면 true)
+// kubectl diff는 차이가 있으면 종료 코드 1을 반환하므로 ExecuteCommand 대신 직접 실행
+func Diff(ctx context.Context, kubeContext, namespace, yamlContent string) (string, bool, error) {
+	tempFile, err := createTempYamlFile(yamlContent)
+	if err != nil {
+		return "", false, err
+	}
+	defer os.Remove(tempFile)
+
+	args := ContextArgs(kubeContext, "diff", "-f", tempFile)
+	if namespace != "" {
+		args = append(args, "-n", namespace)
+	}
+	log.Printf("🔧 명령어 실행: kubectl %s", strings.Join(args, " "))
+
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+
+	started := time.Now()
+	var stdout, stderr bytes.Buffer
+	cmd := utils.CommandContext(ctx, "kubectl", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	switch {
+	case ctx.Err() == context.DeadlineExceeded:
+		return "", false, &utils.CommandTimeoutError{Command: "kubectl diff", Timeout: time.Since(started).Round(time.Second)}
+	case err == nil:
+		return "", false, nil
+	case errors.As(err, &exitErr) && exitErr.ExitCode() == 1:
+		return stdout.String(), true, nil
+	default:
+		return "", false, fmt.Errorf("kubectl diff 실패: %v, 출력: %s", err, strings.TrimSpace(stderr.String()))
+	}
+}
@@ -0,0 +1,204 @@
+package kubernetes
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+)
+
+// restConfig - kubeconfig의 context에서 추출한 API 서버 접속 정보
+type restConfig struct {
+	Context   string      // 사용한 context 이름
+	Server    string      // API 서버 주소 (https://host:6443)
+	Namespace string      // context의 기본 네임스페이스 (없으면 빈 값)
+	Token     string      // Bearer 토큰
+	TLS       *tls.Config // CA/클라이언트 인증서 설정
+}
+
+// kubeconfigFile - 파싱한 kubeconfig 파일 한 개 (상대 경로는 파일이 있는 디렉토리 기준)
+type kubeconfigFile struct {
+	dir    string
+	config model.KubeConfig
+}
+
+// readKubeconfigFiles - kubeconfig 파일들을 순서대로 파싱 (여러 파일 중 없는 파일은 kubectl처럼 무시)
+func readKubeconfigFiles(paths []string) ([]kubeconfigFile, error) {
+	var files []kubeconfigFile
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) && len(paths) > 1 {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("kubeconfig 읽기 실패: %w", err)
+		}
+
+		var config model.KubeConfig
+		if err := yaml.Unmarshal(content, &config); err != nil {
+			return nil, fmt.Errorf("kubeconfig 파싱 실패 (%s): %w", path, err)
+		}
+		files = append(files, kubeconfigFile{dir: filepath.Dir(path), config: config})
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("kubeconfig 파일을 찾을 수 없습니다: %s", strings.Join(paths, string(filepath.ListSeparator)))
+	}
+	return files, nil
+}
+
+// context - 이름이 같은 context (없으면 nil)
+func (f kubeconfigFile) context(name string) *model.ContextConfigData {
+	for i := range f.config.Contexts {
+		if f.config.Contexts[i].Name == name {
+			return &f.config.Contexts[i].Context
+		}
+	}
+	return nil
+}
+
+// cluster - 이름이 같은 cluster (없으면 nil)
+func (f kubeconfigFile) cluster(name string) *model.ClusterConfigData {
+	for i := range f.config.Clusters {
+		if f.config.Clusters[i].Name == name {
+			return &f.config.Clusters[i].Cluster
+		}
+	}
+	return nil
+}
+
+// user - 이름이 같은 user (없으면 nil)
+func (f kubeconfigFile) user(name string) *model.UserConfigData {
+	for i := range f.config.Users {
+		if f.config.Users[i].Name == name {
+			return &f.config.Users[i].User
+		}
+	}
+	return nil
+}
+
+// loadRestConfig - kubeconfig 파일들에서 context의 접속 정보 추출 (kubeContext가 비어 있으면 current-context)
+// 여러 파일은 kubectl과 같이 병합: current-context와 같은 이름의 context/cluster/user는 먼저 나온 파일의 값을 사용
+func loadRestConfig(paths []string, kubeContext string) (*restConfig, error) {
+	files, err := readKubeconfigFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		if kubeContext == "" {
+			kubeContext = file.config.CurrentContext
+		}
+	}
+	if kubeContext == "" {
+		return nil, fmt.Errorf("kubeconfig에 current-context가 없습니다")
+	}
+
+	var contextData *model.ContextConfigData
+	for _, file := range files {
+		if contextData = file.context(kubeContext); contextData != nil {
+			break
+		}
+	}
+	if contextData == nil {
+		return nil, fmt.Errorf("kubeconfig에 context가 없습니다: %s", kubeContext)
+	}
+
+	// 인증서/토큰 파일의 상대 경로는 해당 항목을 정의한 파일 기준
+	var cluster *model.ClusterConfigData
+	var clusterDir string
+	for _, file := range files {
+		if cluster = file.cluster(contextData.Cluster); cluster != nil {
+			clusterDir = file.dir
+			break
+		}
+	}
+	if cluster == nil || cluster.Server == "" {
+		return nil, fmt.Errorf("context %s의 클러스터 정보가 없습니다: %s", kubeContext, contextData.Cluster)
+	}
+
+	var user model.UserConfigData
+	var userDir string
+	for _, file := range files {
+		if found := file.user(contextData.User); found != nil {
+			user, userDir = *found, file.dir
+			break
+		}
+	}
+	if len(user.Exec) > 0 {
+		return nil, fmt.Errorf("exec 인증 플러그인은 api 백엔드에서 지원하지 않습니다 (context: %s)", kubeContext)
+	}
+
+	result := &restConfig{
+		Context:   kubeContext,
+		Server:    strings.TrimRight(cluster.Server, "/"),
+		Namespace: contextData.Namespace,
+		TLS:       &tls.Config{InsecureSkipVerify: cluster.InsecureSkipTLSVerify},
+	}
+
+	caData, err := kubeconfigData(cluster.CertificateAuthorityData, cluster.CertificateAuthority, clusterDir)
+	if err != nil {
+		return nil, fmt.Errorf("CA 인증서 읽기 실패: %w", err)
+	}
+	if len(caData) > 0 && !cluster.InsecureSkipTLSVerify {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("CA 인증서 형식이 올바르지 않습니다 (context: %s)", kubeContext)
+		}
+		result.TLS.RootCAs = pool
+	}
+
+	certData, err := kubeconfigData(user.ClientCertificateData, user.ClientCertificate, userDir)
+	if err != nil {
+		return nil, fmt.Errorf("클라이언트 인증서 읽기 실패: %w", err)
+	}
+	keyData, err := kubeconfigData(user.ClientKeyData, user.ClientKey, userDir)
+	if err != nil {
+		return nil, fmt.Errorf("클라이언트 키 읽기 실패: %w", err)
+	}
+	if len(certData) > 0 && len(keyData) > 0 {
+		certificate, err := tls.X509KeyPair(certData, keyData)
+		if err != nil {
+			return nil, fmt.Errorf("클라이언트 인증서 형식이 올바르지 않습니다: %w", err)
+		}
+		result.TLS.Certificates = []tls.Certificate{certificate}
+	}
+
+	result.Token = user.Token
+	if result.Token == "" && user.TokenFile != "" {
+		token, err := os.ReadFile(resolveKubeconfigPath(user.TokenFile, userDir))
+		if err != nil {
+			return nil, fmt.Errorf("토큰 파일 읽기 실패: %w", err)
+		}
+		result.Token = strings.TrimSpace(string(token))
+	}
+
+	return result, nil
+}
+
+// kubeconfigData - base64 인라인 데이터 우선, 없으면 파일 내용
+func kubeconfigData(inline, file, baseDir string) ([]byte, error) {
+	if inline != "" {
+		return base64.StdEncoding.DecodeString(inline)
+	}
+	if file != "" {
+		return os.ReadFile(resolveKubeconfigPath(file, baseDir))
+	}
+	return nil, nil
+}
+
+// resolveKubeconfigPath - 상대 경로를 kubeconfig 디렉토리 기준으로 변환
+func resolveKubeconfigPath(path, baseDir string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
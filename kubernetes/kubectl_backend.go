@@ -0,0 +1,340 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// KubectlBackend - kubectl 프로세스로 클러스터에 접근하는 백엔드
+type KubectlBackend struct {
+	executor utils.CommandExecutor
+}
+
+// NewKubectlBackend - kubectl 백엔드 생성자
+func NewKubectlBackend(executor utils.CommandExecutor) *KubectlBackend {
+	return &KubectlBackend{executor: executor}
+}
+
+// Name - 백엔드 종류
+func (kb *KubectlBackend) Name() string {
+	return BackendKubectl
+}
+
+// ContextArgs - kubectl 인자 앞에 --context 추가 (비어 있으면 현재 context)
+func ContextArgs(kubeContext string, args ...string) []string {
+	if kubeContext == "" {
+		return args
+	}
+	return append([]string{"--context", kubeContext}, args...)
+}
+
+// CurrentContext - kubectl config current-context 로 현재 context 조회
+func (kb *KubectlBackend) CurrentContext(ctx context.Context) (string, error) {
+	output, err := kb.executor.Execute(ctx, "kubectl", "config", "current-context")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// DiscoverKinds - kubectl api-resources 로 리소스 종류 조회
+func (kb *KubectlBackend) DiscoverKinds(ctx context.Context, kubeContext string) ([]model.ResourceKind, error) {
+	output, err := kb.executor.Execute(ctx, "kubectl", ContextArgs(kubeContext, "api-resources", "--verbs=list", "--no-headers")...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAPIResources(output), nil
+}
+
+// GetRaw - kubectl get --raw 로 JSON 조회
+func (kb *KubectlBackend) GetRaw(ctx context.Context, kubeContext, path string) ([]byte, error) {
+	output, err := kb.executor.Execute(ctx, "kubectl", ContextArgs(kubeContext, "get", "--raw", path)...)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(output), nil
+}
+
+// existingObjectColumns - kubectl get -f 출력 열 (오브젝트 본문 대신 식별 정보만 출력)
+const existingObjectColumns = "custom-columns=APIVERSION:.apiVersion,KIND:.kind,NAMESPACE:.metadata.namespace,NAME:.metadata.name"
+
+// ExistingObjects - 식별 정보만 담은 매니페스트로 kubectl get -f 를 한 번 실행해 존재 여부 확인
+// (--ignore-not-found로 없는 오브젝트는 출력되지 않으며, Secret 등 오브젝트 본문이 출력/로그에 남지 않음)
+func (kb *KubectlBackend) ExistingObjects(ctx context.Context, kubeContext string, objects []ObjectReference) ([]bool, error) {
+	if len(objects) == 0 {
+		return nil, nil
+	}
+	items := make([]map[string]interface{}, len(objects))
+	for i, object := range objects {
+		metadata := map[string]interface{}{"name": object.Name}
+		if object.Kind.Namespaced {
+			metadata["namespace"] = object.Namespace
+		}
+		items[i] = map[string]interface{}{"apiVersion": object.Kind.APIVersion, "kind": object.Kind.Kind, "metadata": metadata}
+	}
+	list, _ := json.Marshal(map[string]interface{}{"apiVersion": "v1", "kind": "List", "items": items})
+
+	tempFile, err := createTempYamlFile(string(list))
+	if err != nil {
+		return nil, fmt.Errorf("임시 파일 생성 실패: %w", err)
+	}
+	defer os.Remove(tempFile) // 함수 종료 시 임시 파일 삭제
+
+	output, err := kb.executor.Execute(ctx, "kubectl", ContextArgs(kubeContext, "get", "-f", tempFile, "--ignore-not-found", "--no-headers", "-o", existingObjectColumns)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// 그룹/Kind/네임스페이스/이름으로 비교 (출력 apiVersion은 서버 선호 버전일 수 있으므로 그룹만 비교)
+	found := make(map[string]bool)
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			continue
+		}
+		namespace := fields[2]
+		if namespace == "<none>" {
+			namespace = ""
+		}
+		found[apiGroup(fields[0])+"/"+fields[1]+"/"+namespace+"/"+fields[3]] = true
+	}
+
+	results := make([]bool, len(objects))
+	for i, object := range objects {
+		namespace := ""
+		if object.Kind.Namespaced {
+			namespace = object.Namespace
+		}
+		results[i] = found[apiGroup(object.Kind.APIVersion)+"/"+object.Kind.Kind+"/"+namespace+"/"+object.Name]
+	}
+	return results, nil
+}
+
+// CreateRaw - 임시 파일에 본문을 쓰고 kubectl create --raw path -f 실행
+func (kb *KubectlBackend) CreateRaw(ctx context.Context, kubeContext, path string, body []byte) ([]byte, error) {
+	tempFile, err := createTempYamlFile(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("임시 파일 생성 실패: %w", err)
+	}
+	defer os.Remove(tempFile) // 함수 종료 시 임시 파일 삭제
+
+	output, err := kb.executor.Execute(ctx, "kubectl", ContextArgs(kubeContext, "create", "--raw", path, "-f", tempFile)...)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(output), nil
+}
+
+// CreateRawBatch - 본문들을 List 하나로 묶어 kubectl create -f 한 번으로 생성
+// (kubectl은 본문의 kind로 경로를 결정하므로 path는 사용하지 않음, 출력은 오브젝트별 JSON이 이어진 형태)
+func (kb *KubectlBackend) CreateRawBatch(ctx context.Context, kubeContext, path string, bodies [][]byte) ([][]byte, error) {
+	if len(bodies) == 0 {
+		return nil, nil
+	}
+	items := make([]json.RawMessage, len(bodies))
+	for i, body := range bodies {
+		items[i] = body
+	}
+	list, _ := json.Marshal(map[string]interface{}{"apiVersion": "v1", "kind": "List", "items": items})
+
+	tempFile, err := createTempYamlFile(string(list))
+	if err != nil {
+		return nil, fmt.Errorf("임시 파일 생성 실패: %w", err)
+	}
+	defer os.Remove(tempFile) // 함수 종료 시 임시 파일 삭제
+
+	output, err := kb.executor.Execute(ctx, "kubectl", ContextArgs(kubeContext, "create", "-f", tempFile, "-o", "json")...)
+	if err != nil {
+		return nil, err
+	}
+
+	var results [][]byte
+	decoder := json.NewDecoder(strings.NewReader(output))
+	for {
+		var object struct {
+			Kind  string            `json:"kind"`
+			Items []json.RawMessage `json:"items"`
+		}
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("kubectl create 출력 파싱 실패: %w", err)
+		}
+		json.Unmarshal(raw, &object)
+		if object.Kind == "List" {
+			for _, item := range object.Items {
+				results = append(results, item)
+			}
+			continue
+		}
+		results = append(results, raw)
+	}
+	if len(results) != len(bodies) {
+		return nil, fmt.Errorf("kubectl create 응답 수가 요청 수와 다릅니다 (%d/%d)", len(results), len(bodies))
+	}
+	return results, nil
+}
+
+// DefaultNamespace - kubectl config view --minify 로 context의 기본 네임스페이스 조회
+func (kb *KubectlBackend) DefaultNamespace(ctx context.Context, kubeContext string) (string, error) {
+	output, err := kb.executor.Execute(ctx, "kubectl", ContextArgs(kubeContext, "config", "view", "--minify", "-o", "jsonpath={..namespace}")...)
+	if err != nil {
+		return "", err
+	}
+	if namespace := strings.TrimSpace(output); namespace != "" {
+		return namespace, nil
+	}
+	return "default", nil
+}
+
+// Watch - kubectl get --raw "path?watch=true" 출력(watch 이벤트 JSON 스트림)을 구독
+func (kb *KubectlBackend) Watch(ctx context.Context, kubeContext, path string, handle func(event WatchEvent) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cmd := utils.CommandContext(ctx, "kubectl", ContextArgs(kubeContext, "get", "--raw", withQuery(path, "watch", "true"))...)
+	cmd.Env = os.Environ()
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("kubectl 실행 실패: %w", err)
+	}
+
+	decoder := json.NewDecoder(stdout)
+	var streamErr error
+	for {
+		var event WatchEvent
+		if err := decoder.Decode(&event); err != nil {
+			if err != io.EOF && ctx.Err() == nil {
+				streamErr = fmt.Errorf("watch 스트림 파싱 실패: %w", err)
+			}
+			break
+		}
+		if err := handle(event); err != nil {
+			streamErr = err
+			break
+		}
+	}
+
+	cancel()
+	io.Copy(io.Discard, stdout)
+	waitErr := cmd.Wait()
+
+	if streamErr != nil {
+		return streamErr
+	}
+	if waitErr != nil && ctx.Err() == nil {
+		return fmt.Errorf("kubectl watch 종료: %s", strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// Patch - kubectl patch --type merge 로 오브젝트 일부 변경 (리소스는 resource.group 형식으로 지정)
+func (kb *KubectlBackend) Patch(ctx context.Context, kubeContext string, object ObjectReference, patch []byte) error {
+	resource := object.Kind.Name
+	if group := apiGroup(object.Kind.APIVersion); group != "" {
+		resource += "." + group
+	}
+	args := []string{"patch", resource, object.Name, "--type", "merge", "-p", string(patch)}
+	if object.Kind.Namespaced && object.Namespace != "" {
+		args = append(args, "-n", object.Namespace)
+	}
+	_, err := kb.executor.Execute(ctx, "kubectl", ContextArgs(kubeContext, args...)...)
+	return err
+}
+
+// Apply - 임시 파일에 YAML을 쓰고 kubectl apply -f 실행
+func (kb *KubectlBackend) Apply(ctx context.Context, request ManifestRequest) (string, error) {
+	tempFile, err := createTempYamlFile(request.YamlContent)
+	if err != nil {
+		return "", fmt.Errorf("임시 파일 생성 실패: %w", err)
+	}
+	defer os.Remove(tempFile) // 함수 종료 시 임시 파일 삭제
+
+	// kubectl apply 명령어 구성
+	args := ContextArgs(request.Context, "apply", "-f", tempFile)
+
+	// 네임스페이스 지정
+	if request.Namespace != "" {
+		args = append(args, "-n", request.Namespace)
+	}
+
+	// dry-run 모드
+	if request.DryRun {
+		args = append(args, "--dry-run=client")
+	}
+
+	// 상세 출력
+	args = append(args, "-v=0")
+
+	output, err := kb.executor.Execute(ctx, "kubectl", args...)
+	if err != nil {
+		return "", fmt.Errorf("kubectl apply 실패: %w", err)
+	}
+	return output, nil
+}
+
+// Delete - 임시 파일에 YAML을 쓰고 kubectl delete -f 실행
+func (kb *KubectlBackend) Delete(ctx context.Context, request ManifestRequest) (string, error) {
+	tempFile, err := createTempYamlFile(request.YamlContent)
+	if err != nil {
+		return "", fmt.Errorf("임시 파일 생성 실패: %w", err)
+	}
+	defer os.Remove(tempFile) // 함수 종료 시 임시 파일 삭제
+
+	// kubectl delete 명령어 구성
+	args := ContextArgs(request.Context, "delete", "-f", tempFile)
+
+	// 네임스페이스 지정
+	if request.Namespace != "" {
+		args = append(args, "-n", request.Namespace)
+	}
+
+	// 리소스가 없어도 에러 무시
+	args = append(args, "--ignore-not-found=true")
+	if request.DryRun {
+		args = append(args, "--dry-run=client")
+	}
+
+	output, err := kb.executor.Execute(ctx, "kubectl", args...)
+	if err != nil {
+		return "", fmt.Errorf("kubectl delete 실패: %w", err)
+	}
+	return output, nil
+}
+
+// createTempYamlFile - 임시 YAML 파일 생성 (동시 요청끼리 이름이 겹치지 않도록 os.CreateTemp 사용)
+func createTempYamlFile(yamlContent string) (string, error) {
+	// os.CreateTemp는 소유자만 읽을 수 있는 권한(0600)으로 생성하므로 Secret이 포함되어도 안전
+	file, err := os.CreateTemp("", "mykubeapp-*.yaml")
+	if err != nil {
+		return "", fmt.Errorf("임시 파일 생성 실패: %w", err)
+	}
+	tempFile := file.Name()
+
+	_, err = file.WriteString(yamlContent)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempFile)
+		return "", fmt.Errorf("임시 파일 쓰기 실패: %w", err)
+	}
+
+	log.Printf("📝 임시 YAML 파일 생성: %s", tempFile)
+	return tempFile, nil
+}
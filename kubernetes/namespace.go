@@ -1 +1,358 @@
 package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 네임스페이스 이름 규칙 (RFC 1123 label)
+var namespaceNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// 삭제할 수 없는 시스템 네임스페이스
+var systemNamespaces = map[string]bool{
+	"default":         true,
+	"kube-system":     true,
+	"kube-public":     true,
+	"kube-node-lease": true,
+}
+
+// 네임스페이스 목록에서 개수를 집계하는 리소스 종류
+var countedResources = []string{
+	"pods", "deployments.apps", "statefulsets.apps", "daemonsets.apps",
+	"services", "configmaps", "secrets", "persistentvolumeclaims",
+	"jobs.batch", "cronjobs.batch", "ingresses.networking.k8s.io",
+}
+
+// 삭제 미리보기에서 제외하는 리소스 (자동 생성/소멸되어 의미가 없는 것)
+var previewExcludedResources = map[string]bool{
+	"events":                          true,
+	"events.events.k8s.io":            true,
+	"endpoints":                       true,
+	"endpointslices.discovery.k8s.io": true,
+	"pods.metrics.k8s.io":             true,
+	"localsubjectaccessreviews.authorization.k8s.io": true,
+}
+
+// NamespaceManager - kubectl 기반 네임스페이스 관리 (KUBE_BACKEND와 무관하게 항상 kubectl 사용)
+type NamespaceManager struct {
+	context  string                // kubeconfig context (비어 있으면 현재 context)
+	executor utils.CommandExecutor // kubectl 실행기
+}
+
+// NewNamespaceManager - 네임스페이스 관리자 생성자
+func NewNamespaceManager() *NamespaceManager {
+	return NewNamespaceManagerWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewNamespaceManagerWithExecutor - 지정한 명령 실행기를 사용하는 네임스페이스 관리자 생성자
+func NewNamespaceManagerWithExecutor(executor utils.CommandExecutor) *NamespaceManager {
+	return &NamespaceManager{executor: executor}
+}
+
+// ForContext - 지정한 context를 대상으로 하는 네임스페이스 관리자
+func (nm *NamespaceManager) ForContext(kubeContext string) *NamespaceManager {
+	return &NamespaceManager{context: kubeContext, executor: nm.executor}
+}
+
+// kubectl - context가 지정되어 있으면 --context를 붙여 kubectl 실행 (조회 제한 시간 적용)
+func (nm *NamespaceManager) kubectl(ctx context.Context, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+	return nm.executor.Execute(ctx, "kubectl", ContextArgs(nm.context, args...)...)
+}
+
+// kubeObjectList - kubectl get -o json 목록 형식
+type kubeObjectList struct {
+	Items []kubeObject `json:"items"`
+}
+
+// kubeObject - 목록 항목에서 필요한 필드만 파싱
+type kubeObject struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name              string            `json:"name"`
+		Namespace         string            `json:"namespace"`
+		Labels            map[string]string `json:"labels"`
+		Annotations       map[string]string `json:"annotations"`
+		CreationTimestamp string            `json:"creationTimestamp"`
+	} `json:"metadata"`
+	Status struct {
+		Phase string `json:"phase"`
+	} `json:"status"`
+}
+
+// ValidateNamespaceName - 네임스페이스 이름 검증
+func ValidateNamespaceName(name string) error {
+	if len(name) == 0 || len(name) > 63 || !namespaceNamePattern.MatchString(name) {
+		return fmt.Errorf("잘못된 네임스페이스 이름입니다: %q (소문자, 숫자, '-'만 사용, 63자 이하)", name)
+	}
+	return nil
+}
+
+// IsSystemNamespace - 시스템 네임스페이스 여부
+func IsSystemNamespace(name string) bool {
+	return systemNamespaces[name]
+}
+
+// ListNamespaces - 네임스페이스 목록과 리소스 수 조회
+func (nm *NamespaceManager) ListNamespaces(ctx context.Context) ([]model.NamespaceInfo, error) {
+	output, err := nm.kubectl(ctx, "get", "namespaces", "-o", "json")
+	if err != nil {
+		return nil, fmt.Errorf("네임스페이스 목록 조회 실패: %w", err)
+	}
+
+	var list kubeObjectList
+	if err := json.Unmarshal([]byte(output), &list); err != nil {
+		return nil, fmt.Errorf("네임스페이스 목록 파싱 실패: %w", err)
+	}
+
+	counts, err := nm.countResources(ctx, "")
+	if err != nil {
+		// 리소스 수는 부가 정보이므로 실패해도 목록은 반환
+		log.Printf("⚠️  네임스페이스 리소스 집계 실패 (계속 진행): %v", err)
+		counts = map[string]map[string]int{}
+	}
+
+	namespaces := make([]model.NamespaceInfo, 0, len(list.Items))
+	for _, item := range list.Items {
+		namespaces = append(namespaces, toNamespaceInfo(item, counts[item.Metadata.Name]))
+	}
+	return namespaces, nil
+}
+
+// GetNamespace - 네임스페이스 단건 조회
+func (nm *NamespaceManager) GetNamespace(ctx context.Context, name string) (*model.NamespaceInfo, error) {
+	if err := ValidateNamespaceName(name); err != nil {
+		return nil, err
+	}
+
+	output, err := nm.kubectl(ctx, "get", "namespace", name, "-o", "json")
+	if err != nil {
+		return nil, fmt.Errorf("네임스페이스 조회 실패: %w", err)
+	}
+
+	var item kubeObject
+	if err := json.Unmarshal([]byte(output), &item); err != nil {
+		return nil, fmt.Errorf("네임스페이스 파싱 실패: %w", err)
+	}
+
+	counts, err := nm.countResources(ctx, name)
+	if err != nil {
+		log.Printf("⚠️  네임스페이스 리소스 집계 실패 (계속 진행): %v", err)
+		counts = map[string]map[string]int{}
+	}
+
+	info := toNamespaceInfo(item, counts[name])
+	return &info, nil
+}
+
+// NamespaceExists - 네임스페이스 존재 여부 확인
+func (nm *NamespaceManager) NamespaceExists(ctx context.Context, name string) (bool, error) {
+	if err := ValidateNamespaceName(name); err != nil {
+		return false, err
+	}
+
+	output, err := nm.kubectl(ctx, "get", "namespace", name, "--ignore-not-found", "-o", "name")
+	if err != nil {
+		return false, fmt.Errorf("네임스페이스 확인 실패: %w", err)
+	}
+	return strings.TrimSpace(output) != "", nil
+}
+
+// CreateNamespace - 라벨/어노테이션을 포함한 네임스페이스 생성
+func (nm *NamespaceManager) CreateNamespace(ctx context.Context, request model.CreateNamespaceRequest) error {
+	if err := ValidateNamespaceName(request.Name); err != nil {
+		return err
+	}
+
+	metadata := map[string]interface{}{"name": request.Name}
+	if len(request.Labels) > 0 {
+		metadata["labels"] = request.Labels
+	}
+	if len(request.Annotations) > 0 {
+		metadata["annotations"] = request.Annotations
+	}
+	manifest, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   metadata,
+	})
+	if err != nil {
+		return fmt.Errorf("네임스페이스 매니페스트 생성 실패: %w", err)
+	}
+
+	tempFile, err := createTempYamlFile(string(manifest))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile)
+
+	// 이미 존재하면 create가 실패하므로 덮어쓰지 않음
+	if _, err := nm.kubectl(ctx, "create", "-f", tempFile); err != nil {
+		return fmt.Errorf("네임스페이스 생성 실패: %w", err)
+	}
+
+	log.Printf("✅ 네임스페이스 생성 완료: %s", request.Name)
+	return nil
+}
+
+// EnsureNamespace - 네임스페이스가 없으면 생성 (생성했으면 true)
+func (nm *NamespaceManager) EnsureNamespace(ctx context.Context, name string) (bool, error) {
+	exists, err := nm.NamespaceExists(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+
+	log.Printf("📁 네임스페이스 자동 생성: %s", name)
+	if err := nm.CreateNamespace(ctx, model.CreateNamespaceRequest{Name: name}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ListNamespaceResources - 네임스페이스에 속한 모든 리소스 목록 (삭제 미리보기용)
+func (nm *NamespaceManager) ListNamespaceResources(ctx context.Context, name string) ([]model.NamespaceResource, error) {
+	if err := ValidateNamespaceName(name); err != nil {
+		return nil, err
+	}
+
+	output, err := nm.kubectl(ctx, "api-resources", "--verbs=list", "--namespaced", "-o", "name")
+	if err != nil {
+		return nil, fmt.Errorf("리소스 종류 조회 실패: %w", err)
+	}
+
+	var resourceTypes []string
+	for _, line := range strings.Split(output, "\n") {
+		resourceType := strings.TrimSpace(line)
+		if resourceType != "" && !previewExcludedResources[resourceType] {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	if len(resourceTypes) == 0 {
+		return []model.NamespaceResource{}, nil
+	}
+
+	output, err = nm.kubectl(ctx, "get", strings.Join(resourceTypes, ","), "-n", name, "--ignore-not-found", "-o", "json")
+	if err != nil {
+		return nil, fmt.Errorf("네임스페이스 리소스 조회 실패: %w", err)
+	}
+
+	var list kubeObjectList
+	if strings.TrimSpace(output) != "" {
+		if err := json.Unmarshal([]byte(output), &list); err != nil {
+			return nil, fmt.Errorf("네임스페이스 리소스 파싱 실패: %w", err)
+		}
+	}
+
+	resources := make([]model.NamespaceResource, 0, len(list.Items))
+	for _, item := range list.Items {
+		resources = append(resources, model.NamespaceResource{Kind: item.Kind, Name: item.Metadata.Name})
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].Kind != resources[j].Kind {
+			return resources[i].Kind < resources[j].Kind
+		}
+		return resources[i].Name < resources[j].Name
+	})
+	return resources, nil
+}
+
+// DeleteNamespace - 네임스페이스 삭제 (포함된 모든 리소스가 함께 삭제됨)
+func (nm *NamespaceManager) DeleteNamespace(ctx context.Context, name string) (string, error) {
+	if err := ValidateNamespaceName(name); err != nil {
+		return "", err
+	}
+	if IsSystemNamespace(name) {
+		return "", fmt.Errorf("시스템 네임스페이스는 삭제할 수 없습니다: %s", name)
+	}
+
+	output, err := nm.kubectl(ctx, "delete", "namespace", name, "--wait=false")
+	if err != nil {
+		return "", fmt.Errorf("네임스페이스 삭제 실패: %w", err)
+	}
+
+	log.Printf("🗑️ 네임스페이스 삭제 요청 완료: %s", name)
+	return output, nil
+}
+
+// countResources - 네임스페이스별/종류별 리소스 수 집계 (namespace가 비어 있으면 전체)
+func (nm *NamespaceManager) countResources(ctx context.Context, namespace string) (map[string]map[string]int, error) {
+	args := []string{"get", strings.Join(countedResources, ","), "-o", "json"}
+	if namespace == "" {
+		args = append(args, "--all-namespaces")
+	} else {
+		args = append(args, "-n", namespace)
+	}
+
+	output, err := nm.kubectl(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var list kubeObjectList
+	if err := json.Unmarshal([]byte(output), &list); err != nil {
+		return nil, fmt.Errorf("리소스 목록 파싱 실패: %w", err)
+	}
+
+	return countByKind(list.Items), nil
+}
+
+// countByKind - 항목을 네임스페이스별/종류별로 집계
+func countByKind(items []kubeObject) map[string]map[string]int {
+	counts := make(map[string]map[string]int)
+	for _, item := range items {
+		if counts[item.Metadata.Namespace] == nil {
+			counts[item.Metadata.Namespace] = make(map[string]int)
+		}
+		counts[item.Metadata.Namespace][item.Kind]++
+	}
+	return counts
+}
+
+// CountResourcesByKind - 리소스 목록을 종류별로 집계
+func CountResourcesByKind(resources []model.NamespaceResource) map[string]int {
+	counts := make(map[string]int)
+	for _, resource := range resources {
+		counts[resource.Kind]++
+	}
+	return counts
+}
+
+// toNamespaceInfo - kubectl 항목을 응답 모델로 변환
+func toNamespaceInfo(item kubeObject, counts map[string]int) model.NamespaceInfo {
+	if counts == nil {
+		counts = map[string]int{}
+	}
+	labels := item.Metadata.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	annotations := item.Metadata.Annotations
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	return model.NamespaceInfo{
+		Name:           item.Metadata.Name,
+		Status:         item.Status.Phase,
+		Labels:         labels,
+		Annotations:    annotations,
+		CreatedTime:    item.Metadata.CreationTimestamp,
+		ResourceCounts: counts,
+		IsSystem:       IsSystemNamespace(item.Metadata.Name),
+	}
+}
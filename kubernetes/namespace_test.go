@@ -0,0 +1,26 @@
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"mykubeapp/utils/utilstest"
+)
+
+func TestNamespaceManagerRejectsInvalidNames(t *testing.T) {
+	executor := utilstest.NewFakeExecutor()
+	manager := NewNamespaceManagerWithExecutor(executor)
+	ctx := context.Background()
+
+	// 플래그처럼 보이는 이름은 kubectl 인자로 전달되기 전에 거부
+	if _, err := manager.NamespaceExists(ctx, "--kubeconfig=/tmp/x"); err == nil || !strings.Contains(err.Error(), "잘못된 네임스페이스 이름입니다") {
+		t.Fatalf("존재 여부 확인 오류 = %v", err)
+	}
+	if _, err := manager.EnsureNamespace(ctx, "-n"); err == nil {
+		t.Fatalf("자동 생성은 잘못된 이름을 거부해야 합니다")
+	}
+	if calls := executor.Calls(); len(calls) != 0 {
+		t.Fatalf("kubectl이 호출되지 않아야 합니다: %v", calls)
+	}
+}
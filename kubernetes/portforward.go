@@ -0,0 +1,102 @@
+package kubernetes
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"mykubeapp/utils"
+)
+
+// kubectl port-forward 출력: Forwarding from 127.0.0.1:43567 -> 8080
+var forwardingPattern = regexp.MustCompile(`Forwarding from 127\.0\.0\.1:(\d+) ->`)
+
+// 로컬 포트 할당 대기 시간
+const portForwardStartTimeout = 20 * time.Second
+
+// PortForward - 실행 중인 kubectl port-forward 프로세스
+type PortForward struct {
+	LocalPort int
+	cancel    context.CancelFunc
+	done      chan struct{}
+	err       error
+}
+
+// StartPortForward - kubectl port-forward를 임의의 로컬 포트(127.0.0.1)로 시작
+// target은 pod/이름 또는 svc/이름 형식
+func StartPortForward(namespace, target string, remotePort int) (*PortForward, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cmd := utils.CommandContext(ctx, "kubectl", "port-forward", "-n", namespace,
+		"--address", "127.0.0.1", target, fmt.Sprintf(":%d", remotePort))
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("kubectl port-forward 시작 실패: %w", err)
+	}
+
+	forward := &PortForward{cancel: cancel, done: make(chan struct{})}
+	portFound := make(chan int, 1)
+
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			if match := forwardingPattern.FindStringSubmatch(scanner.Text()); match != nil {
+				port, _ := strconv.Atoi(match[1])
+				select {
+				case portFound <- port:
+				default:
+				}
+			}
+		}
+		io.Copy(io.Discard, stdout)
+	}()
+
+	go func() {
+		forward.err = cmd.Wait()
+		if forward.err != nil && strings.TrimSpace(stderr.String()) != "" {
+			forward.err = fmt.Errorf("%v: %s", forward.err, strings.TrimSpace(stderr.String()))
+		}
+		close(forward.done)
+	}()
+
+	select {
+	case port := <-portFound:
+		forward.LocalPort = port
+		return forward, nil
+	case <-forward.done:
+		cancel()
+		return nil, fmt.Errorf("kubectl port-forward 종료됨: %v", forward.err)
+	case <-time.After(portForwardStartTimeout):
+		forward.Stop()
+		return nil, fmt.Errorf("kubectl port-forward 시작 시간 초과 (%s)", portForwardStartTimeout)
+	}
+}
+
+// Stop - 포트포워드 프로세스 종료 후 대기
+func (pf *PortForward) Stop() {
+	pf.cancel()
+	<-pf.done
+}
+
+// Done - 프로세스가 종료되면 닫히는 채널
+func (pf *PortForward) Done() <-chan struct{} {
+	return pf.done
+}
+
+// Err - 프로세스 종료 오류 (Done 이후에만 유효)
+func (pf *PortForward) Err() error {
+	return pf.err
+}
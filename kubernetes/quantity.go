@@ -0,0 +1,63 @@
+package kubernetes
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// 쿠버네티스 수량 접미사 배수 (이진: Ki~Ei, 십진: n~E)
+var quantitySuffixes = []struct {
+	suffix     string
+	multiplier float64
+}{
+	{"Ki", 1 << 10}, {"Mi", 1 << 20}, {"Gi", 1 << 30}, {"Ti", 1 << 40}, {"Pi", 1 << 50}, {"Ei", 1 << 60},
+	{"n", 1e-9}, {"u", 1e-6}, {"m", 1e-3}, {"k", 1e3}, {"M", 1e6}, {"G", 1e9}, {"T", 1e12}, {"P", 1e15}, {"E", 1e18},
+}
+
+// ParseQuantity - 쿠버네티스 수량 문자열을 기본 단위 값으로 변환 (예: 100m → 0.1, 1Gi → 1073741824)
+func ParseQuantity(value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("빈 수량입니다")
+	}
+
+	multiplier := 1.0
+	number := value
+	for _, candidate := range quantitySuffixes {
+		if strings.HasSuffix(value, candidate.suffix) {
+			number = strings.TrimSuffix(value, candidate.suffix)
+			multiplier = candidate.multiplier
+			break
+		}
+	}
+
+	// 접미사가 없으면 1e3 같은 지수 표기도 허용 (E 접미사와 구분하기 위해 숫자 파싱을 먼저 시도)
+	if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+		return parsed, nil
+	}
+	parsed, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("잘못된 수량입니다: %s", value)
+	}
+	return parsed * multiplier, nil
+}
+
+// ParseCPUMillis - CPU 수량을 밀리코어로 변환 (예: 1.5 → 1500, 250m → 250)
+func ParseCPUMillis(value string) (int64, error) {
+	cores, err := ParseQuantity(value)
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(cores * 1000)), nil
+}
+
+// ParseMemoryBytes - 메모리 수량을 바이트로 변환 (예: 128Mi → 134217728)
+func ParseMemoryBytes(value string) (int64, error) {
+	bytes, err := ParseQuantity(value)
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(bytes)), nil
+}
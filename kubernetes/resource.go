@@ -0,0 +1,283 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 디스커버리 결과 캐시 유지 시간
+const discoveryCacheTTL = 5 * time.Minute
+
+// DiscoveryCache - context별 리소스 종류 디스커버리 캐시 (클러스터마다 CRD가 다르므로 context 이름으로 구분)
+type DiscoveryCache struct {
+	backend ClusterBackend
+	mutex   sync.Mutex
+	entries map[string]discoveryEntry
+	pending map[string]*discoveryCall // 진행 중인 디스커버리 (같은 context의 동시 요청은 결과를 공유)
+}
+
+// discoveryEntry - context 하나의 디스커버리 결과
+type discoveryEntry struct {
+	kinds      []model.ResourceKind
+	discovered time.Time
+}
+
+// discoveryCall - 진행 중인 디스커버리 한 건 (done이 닫히면 kinds, err 확정)
+type discoveryCall struct {
+	done  chan struct{}
+	kinds []model.ResourceKind
+	err   error
+}
+
+// NewDiscoveryCache - 디스커버리 캐시 생성자
+func NewDiscoveryCache(backend ClusterBackend) *DiscoveryCache {
+	return &DiscoveryCache{
+		backend: backend,
+		entries: make(map[string]discoveryEntry),
+		pending: make(map[string]*discoveryCall),
+	}
+}
+
+// Kinds - context의 리소스 종류 (kubeContext가 비어 있으면 current-context 이름으로 캐시)
+// current-context를 확인할 수 없으면 캐시하지 않고 조회
+// 잠금은 캐시 조회/갱신에만 사용하므로 다른 context의 디스커버리는 서로 기다리지 않고,
+// 같은 context에 대한 동시 요청은 진행 중인 디스커버리 한 건의 결과를 함께 사용 (한 호출자가 취소해도 디스커버리는 계속됨)
+func (dc *DiscoveryCache) Kinds(ctx context.Context, kubeContext string) ([]model.ResourceKind, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+
+	key := kubeContext
+	if key == "" {
+		if current, err := dc.backend.CurrentContext(ctx); err == nil {
+			key = current
+		}
+	}
+	if key == "" {
+		return dc.discover(ctx, kubeContext, key)
+	}
+
+	dc.mutex.Lock()
+	if entry, ok := dc.entries[key]; ok && time.Since(entry.discovered) < discoveryCacheTTL {
+		dc.mutex.Unlock()
+		return entry.kinds, nil
+	}
+	call, ok := dc.pending[key]
+	if !ok {
+		call = &discoveryCall{done: make(chan struct{})}
+		dc.pending[key] = call
+		// 공유 디스커버리는 먼저 요청한 호출자가 취소해도 함께 기다리는 호출자를 위해 분리한 context로 끝까지 실행
+		discoverCtx, discoverCancel := context.WithTimeout(context.WithoutCancel(ctx), utils.QueryCommandTimeout)
+		go func() {
+			defer discoverCancel()
+			dc.finish(discoverCtx, call, kubeContext, key)
+		}()
+	}
+	dc.mutex.Unlock()
+
+	select {
+	case <-call.done:
+		return call.kinds, call.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("리소스 종류 조회 실패: %w", ctx.Err())
+	}
+}
+
+// finish - 공유 디스커버리 실행 후 결과 캐시 및 대기 중인 호출자에게 완료 알림
+func (dc *DiscoveryCache) finish(ctx context.Context, call *discoveryCall, kubeContext, key string) {
+	call.kinds, call.err = dc.discover(ctx, kubeContext, key)
+
+	dc.mutex.Lock()
+	if call.err == nil {
+		dc.entries[key] = discoveryEntry{kinds: call.kinds, discovered: time.Now()}
+	}
+	delete(dc.pending, key)
+	dc.mutex.Unlock()
+	close(call.done)
+}
+
+// discover - 백엔드로 디스커버리 실행 (잠금 없이 호출)
+func (dc *DiscoveryCache) discover(ctx context.Context, kubeContext, key string) ([]model.ResourceKind, error) {
+	kinds, err := dc.backend.DiscoverKinds(ctx, kubeContext)
+	if err != nil {
+		return nil, fmt.Errorf("리소스 종류 조회 실패: %w", err)
+	}
+	log.Printf("✅ 리소스 종류 디스커버리 완료 (context: %s, %d개)", key, len(kinds))
+	return kinds, nil
+}
+
+// ResourceBrowser - 클러스터 백엔드 기반 읽기 전용 리소스 조회
+type ResourceBrowser struct {
+	backend   ClusterBackend
+	discovery *DiscoveryCache
+}
+
+// NewResourceBrowser - 리소스 조회기 생성자
+func NewResourceBrowser() *ResourceBrowser {
+	return NewResourceBrowserWithBackend(NewClusterBackend(utils.NewSystemExecutor()))
+}
+
+// NewResourceBrowserWithBackend - 지정한 클러스터 백엔드를 사용하는 리소스 조회기 생성자
+func NewResourceBrowserWithBackend(backend ClusterBackend) *ResourceBrowser {
+	return &ResourceBrowser{backend: backend, discovery: NewDiscoveryCache(backend)}
+}
+
+// DiscoverKinds - 목록 조회가 가능한 리소스 종류 (context별 캐시 사용, 비어 있으면 현재 context)
+func (rb *ResourceBrowser) DiscoverKinds(ctx context.Context, kubeContext string) ([]model.ResourceKind, error) {
+	return rb.discovery.Kinds(ctx, kubeContext)
+}
+
+// ParseAPIResources - kubectl api-resources --no-headers 출력 파싱
+// 컬럼: NAME [SHORTNAMES] APIVERSION NAMESPACED KIND (SHORTNAMES는 비어 있을 수 있음)
+func ParseAPIResources(output string) []model.ResourceKind {
+	var kinds []model.ResourceKind
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 {
+			continue
+		}
+
+		kind := model.ResourceKind{
+			Name:       fields[0],
+			ShortNames: []string{},
+			APIVersion: fields[len(fields)-3],
+			Namespaced: fields[len(fields)-2] == "true",
+			Kind:       fields[len(fields)-1],
+		}
+		if len(fields) == 5 {
+			kind.ShortNames = strings.Split(fields[1], ",")
+		}
+		kinds = append(kinds, kind)
+	}
+
+	SortResourceKinds(kinds)
+	return kinds
+}
+
+// SortResourceKinds - 이름순 정렬. 이름이 같으면 core 그룹을 먼저 두고 나머지는 디스커버리 순서(선호 버전) 유지
+// (events는 v1과 events.k8s.io/v1 양쪽에 있음)
+func SortResourceKinds(kinds []model.ResourceKind) {
+	sort.SliceStable(kinds, func(i, j int) bool {
+		if kinds[i].Name != kinds[j].Name {
+			return kinds[i].Name < kinds[j].Name
+		}
+		return apiGroup(kinds[i].APIVersion) == "" && apiGroup(kinds[j].APIVersion) != ""
+	})
+}
+
+// ResolveKind - 이름/축약 이름/Kind/"이름.그룹" 으로 리소스 종류 찾기
+// 여러 그룹에 같은 이름이 있으면 core 그룹, 없으면 먼저 발견된 그룹 사용 ("이름.그룹"으로 지정 가능)
+func (rb *ResourceBrowser) ResolveKind(ctx context.Context, kubeContext, name string) (*model.ResourceKind, error) {
+	kinds, err := rb.DiscoverKinds(ctx, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	lower := strings.ToLower(name)
+	var found *model.ResourceKind
+	for i := range kinds {
+		kind := &kinds[i]
+		group := apiGroup(kind.APIVersion)
+		if group != "" && kind.Name+"."+group == lower {
+			return kind, nil
+		}
+		if kind.Name != lower && strings.ToLower(kind.Kind) != lower && !slices.Contains(kind.ShortNames, lower) {
+			continue
+		}
+		if group == "" {
+			return kind, nil
+		}
+		if found == nil {
+			found = kind
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("알 수 없는 리소스 종류입니다: %s", name)
+	}
+	return found, nil
+}
+
+// List - 리소스 목록 조회 (API 서버 페이지네이션 사용)
+func (rb *ResourceBrowser) List(ctx context.Context, kind *model.ResourceKind, query model.ResourceListQuery) (map[string]interface{}, error) {
+	params := url.Values{}
+	if query.LabelSelector != "" {
+		params.Set("labelSelector", query.LabelSelector)
+	}
+	if query.FieldSelector != "" {
+		params.Set("fieldSelector", query.FieldSelector)
+	}
+	if query.Limit > 0 {
+		params.Set("limit", strconv.Itoa(query.Limit))
+	}
+	if query.Continue != "" {
+		params.Set("continue", query.Continue)
+	}
+
+	path := ResourcePath(kind, query.Namespace, "")
+	if encoded := params.Encode(); encoded != "" {
+		path += "?" + encoded
+	}
+	return rb.getRaw(ctx, query.Context, path)
+}
+
+// Get - 리소스 단건 조회
+func (rb *ResourceBrowser) Get(ctx context.Context, kubeContext string, kind *model.ResourceKind, namespace, name string) (map[string]interface{}, error) {
+	if kind.Namespaced && namespace == "" {
+		namespace = "default"
+	}
+	return rb.getRaw(ctx, kubeContext, ResourcePath(kind, namespace, name))
+}
+
+// ResourcePath - API 서버 REST 경로 생성 (/api/v1/namespaces/{ns}/pods/{name})
+func ResourcePath(kind *model.ResourceKind, namespace, name string) string {
+	var path string
+	if apiGroup(kind.APIVersion) == "" {
+		path = "/api/" + kind.APIVersion
+	} else {
+		path = "/apis/" + kind.APIVersion
+	}
+	if kind.Namespaced && namespace != "" {
+		path += "/namespaces/" + url.PathEscape(namespace)
+	}
+	path += "/" + kind.Name
+	if name != "" {
+		path += "/" + url.PathEscape(name)
+	}
+	return path
+}
+
+// getRaw - REST 경로 JSON 조회 (kubectl get --raw 또는 API 서버 직접 요청)
+func (rb *ResourceBrowser) getRaw(ctx context.Context, kubeContext, path string) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+
+	output, err := rb.backend.GetRaw(ctx, kubeContext, path)
+	if err != nil {
+		return nil, fmt.Errorf("리소스 조회 실패: %w", err)
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(output, &object); err != nil {
+		return nil, fmt.Errorf("리소스 응답 파싱 실패: %w", err)
+	}
+	return object, nil
+}
+
+// apiGroup - apiVersion에서 그룹 추출 (core는 빈 값)
+func apiGroup(apiVersion string) string {
+	if index := strings.Index(apiVersion, "/"); index >= 0 {
+		return apiVersion[:index]
+	}
+	return ""
+}
@@ -0,0 +1,213 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"mykubeapp/model"
+	"mykubeapp/utils/utilstest"
+)
+
+// kubectl api-resources 출력 (events는 events.k8s.io/v1이 먼저 나와도 core 그룹이 우선)
+const testEventResources = `events        ev       events.k8s.io/v1   true    Event
+deployments   deploy   apps/v1            true    Deployment
+events        ev       v1                 true    Event
+pods          po       v1                 true    Pod
+`
+
+func TestParseAPIResourcesPrefersCoreGroup(t *testing.T) {
+	kinds := ParseAPIResources(testEventResources)
+	if len(kinds) != 4 || kinds[1].Name != "events" || kinds[1].APIVersion != "v1" || kinds[2].APIVersion != "events.k8s.io/v1" {
+		t.Fatalf("리소스 종류 순서 = %+v", kinds)
+	}
+}
+
+func TestResolveKindPrefersCoreGroup(t *testing.T) {
+	executor := utilstest.NewFakeExecutor().On("kubectl api-resources", testEventResources)
+	browser := NewResourceBrowserWithBackend(NewKubectlBackend(executor))
+
+	for name, apiVersion := range map[string]string{
+		"events":               "v1",
+		"ev":                   "v1",
+		"Event":                "v1",
+		"events.events.k8s.io": "events.k8s.io/v1",
+		"deploy":               "apps/v1",
+	} {
+		kind, err := browser.ResolveKind(context.Background(), "", name)
+		if err != nil || kind.APIVersion != apiVersion {
+			t.Fatalf("%s 해석 결과 = %+v, %v (기대값 %s)", name, kind, err, apiVersion)
+		}
+	}
+	if _, err := browser.ResolveKind(context.Background(), "", "widgets"); err == nil {
+		t.Fatalf("알 수 없는 종류는 오류여야 합니다")
+	}
+}
+
+func TestResolveKindCachesPerContext(t *testing.T) {
+	// a 클러스터에만 widgets CRD가 있음
+	resources := map[string]string{
+		"a": testEventResources + "widgets                 example.com/v1     true    Widget\n",
+		"b": testEventResources,
+	}
+	current := "a"
+	executor := utilstest.NewFakeExecutor()
+	executor.OnFunc("kubectl config current-context", func(call utilstest.FakeCommandCall) (string, error) {
+		return current + "\n", nil
+	})
+	executor.OnFunc("kubectl api-resources", func(call utilstest.FakeCommandCall) (string, error) {
+		return resources[current], nil
+	})
+	executor.OnFunc("kubectl --context", func(call utilstest.FakeCommandCall) (string, error) {
+		if output, ok := resources[call.Args[1]]; ok && call.Args[2] == "api-resources" {
+			return output, nil
+		}
+		return "", fmt.Errorf("알 수 없는 명령: %s", call.Command())
+	})
+	browser := NewResourceBrowserWithBackend(NewKubectlBackend(executor))
+	ctx := context.Background()
+
+	if kind, err := browser.ResolveKind(ctx, "a", "widgets"); err != nil || kind.APIVersion != "example.com/v1" {
+		t.Fatalf("a의 widgets = %+v, %v", kind, err)
+	}
+	if _, err := browser.ResolveKind(ctx, "b", "widgets"); err == nil {
+		t.Fatalf("b에는 widgets CRD가 없어야 합니다")
+	}
+
+	// 현재 context가 바뀌면 바뀐 context의 결과 사용
+	if _, err := browser.ResolveKind(ctx, "", "widgets"); err != nil {
+		t.Fatalf("현재 context a의 widgets 조회 실패: %v", err)
+	}
+	current = "b"
+	if _, err := browser.ResolveKind(ctx, "", "widgets"); err == nil {
+		t.Fatalf("현재 context b에서 a의 디스커버리 결과가 사용되었습니다")
+	}
+
+	// 현재 context도 이름으로 캐시를 공유하므로 context마다 한 번씩만 디스커버리
+	if calls := len(executor.CallsTo("kubectl --context")) + len(executor.CallsTo("kubectl api-resources")); calls != 2 {
+		t.Fatalf("디스커버리 호출 수 = %d, 기대값 2", calls)
+	}
+}
+
+func TestDiscoveryCacheSharesConcurrentMisses(t *testing.T) {
+	release := make(chan struct{})
+	executor := utilstest.NewFakeExecutor()
+	executor.OnFunc("kubectl --context", func(call utilstest.FakeCommandCall) (string, error) {
+		// slow context의 디스커버리는 release가 닫힐 때까지 대기
+		if call.Args[1] == "slow" {
+			<-release
+		}
+		return testEventResources, nil
+	})
+	cache := NewDiscoveryCache(NewKubectlBackend(executor))
+	ctx := context.Background()
+
+	var wait sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			if kinds, err := cache.Kinds(ctx, "slow"); err != nil || len(kinds) != 4 {
+				t.Errorf("slow 디스커버리 결과 = %d개, %v", len(kinds), err)
+			}
+		}()
+	}
+
+	// 다른 context는 진행 중인 slow 디스커버리를 기다리지 않음
+	done := make(chan error, 1)
+	go func() {
+		_, err := cache.Kinds(ctx, "fast")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("fast 디스커버리 실패: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("다른 context의 디스커버리가 잠금에 막혔습니다")
+	}
+
+	// 요청이 모두 대기 상태가 된 뒤 slow 디스커버리 완료
+	for deadline := time.Now().Add(time.Second); len(executor.CallsTo("kubectl --context slow")) == 0 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wait.Wait()
+
+	// 같은 context의 동시 요청은 디스커버리 한 번의 결과를 공유
+	if calls := len(executor.CallsTo("kubectl --context slow")); calls != 1 {
+		t.Fatalf("slow 디스커버리 호출 수 = %d, 기대값 1", calls)
+	}
+}
+
+// blockingDiscoveryBackend - release가 닫힐 때까지 디스커버리를 멈추고, 그 사이 context가 취소되면 실패하는 백엔드
+type blockingDiscoveryBackend struct {
+	ClusterBackend
+	started chan struct{}
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (b *blockingDiscoveryBackend) DiscoverKinds(ctx context.Context, kubeContext string) ([]model.ResourceKind, error) {
+	if b.calls.Add(1) == 1 {
+		close(b.started)
+	}
+	select {
+	case <-b.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return b.ClusterBackend.DiscoverKinds(ctx, kubeContext)
+}
+
+func TestDiscoveryCacheSurvivesLeaderCancel(t *testing.T) {
+	backend := &blockingDiscoveryBackend{
+		ClusterBackend: NewKubectlBackend(utilstest.NewFakeExecutor().On("kubectl --context slow api-resources", testEventResources)),
+		started:        make(chan struct{}),
+		release:        make(chan struct{}),
+	}
+	cache := NewDiscoveryCache(backend)
+
+	// 먼저 요청한 호출자가 디스커버리를 시작한 뒤 다른 호출자가 합류
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderDone := make(chan error, 1)
+	go func() {
+		_, err := cache.Kinds(leaderCtx, "slow")
+		leaderDone <- err
+	}()
+	<-backend.started
+	type kindsResult struct {
+		kinds []model.ResourceKind
+		err   error
+	}
+	waiterDone := make(chan kindsResult, 1)
+	go func() {
+		kinds, err := cache.Kinds(context.Background(), "slow")
+		waiterDone <- kindsResult{kinds, err}
+	}()
+
+	// 먼저 요청한 호출자는 취소하면 바로 반환
+	cancelLeader()
+	select {
+	case err := <-leaderDone:
+		if err == nil {
+			t.Fatalf("취소한 호출자가 오류 없이 반환되었습니다")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("취소한 호출자가 디스커버리 완료를 기다렸습니다")
+	}
+
+	// 함께 기다리던 호출자는 취소의 영향 없이 같은 디스커버리 결과를 받음
+	close(backend.release)
+	result := <-waiterDone
+	if result.err != nil || len(result.kinds) != 4 {
+		t.Fatalf("대기 중인 호출자 결과 = %d개, %v", len(result.kinds), result.err)
+	}
+	if calls := backend.calls.Load(); calls != 1 {
+		t.Fatalf("디스커버리 호출 수 = %d, 기대값 1", calls)
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"github.com/gorilla/mux"
 	"log"
 	"mykubeapp/controller"
+	"mykubeapp/kubernetes"
 	"net/http"
 )
 
 func main() {
 	// Spring Boot의 SpringApplication.run() 역할
 	log.Println("🚀 쿠버네티스 관리 애플리케이션 시작...")
+	log.Printf("🔧 클러스터 백엔드: %s (KUBE_BACKEND)", kubernetes.BackendFromEnv())
 
 	// 라우터 생성 (Spring의 @RequestMapping 역할)
 	router := mux.NewRouter()
@@ -52,48 +54,8 @@ func corsMiddleware(next http.Handler) http.Handler {
 }
 
 func setupRoutes(router *mux.Router) {
-	// 컨트롤러 인스턴스 생성
-	kubeController := controller.NewKubeController()
-	terminalController := controller.NewTerminalController()
-	aiController := controller.NewAIController()
-	gitController := controller.NewGitController() // Git 컨트롤러 추가
-
-	// API 라우트 설정 (Spring의 @RequestMapping과 유사)
-	api := router.PathPrefix("/api").Subrouter()
-
-	// Health check endpoint
-	router.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`{"status":"UP","message":"쿠버네티스 관리 애플리케이션이 정상 동작 중입니다"}`))
-	}).Methods("GET")
-
-	// 쿠버네티스 관련 API
-	api.HandleFunc("/config", kubeController.GetConfig).Methods("GET", "OPTIONS")
-	api.HandleFunc("/config", kubeController.AddConfig).Methods("POST", "OPTIONS")
-	api.HandleFunc("/contexts", kubeController.GetContexts).Methods("GET", "OPTIONS")
-	api.HandleFunc("/context/use", kubeController.UseContext).Methods("POST", "OPTIONS")
-	api.HandleFunc("/context", kubeController.DeleteContext).Methods("DELETE", "OPTIONS")
-	api.HandleFunc("/context/{contextName}", kubeController.GetContextDetail).Methods("GET", "OPTIONS")
-	api.HandleFunc("/apply", kubeController.ApplyYaml).Methods("POST", "OPTIONS")
-	api.HandleFunc("/delete", kubeController.DeleteYaml).Methods("POST", "OPTIONS")
-	api.HandleFunc("/kubectl", terminalController.KubectlTerminal)
-
-	// AI 관련 API
-	api.HandleFunc("/ai/health", aiController.CheckAIHealth).Methods("GET", "OPTIONS")
-	api.HandleFunc("/ai/generate-yaml", aiController.GenerateYaml).Methods("POST", "OPTIONS")
-	api.HandleFunc("/ai/generate-apply", aiController.GenerateAndApplyEnhanced).Methods("POST", "OPTIONS") // 🆕 Enhanced 버전 사용
-	api.HandleFunc("/ai/query", aiController.QueryAI).Methods("POST", "OPTIONS")
-	api.HandleFunc("/ai/template", aiController.GenerateTemplate).Methods("POST", "OPTIONS")
-	api.HandleFunc("/ai/validate", aiController.ValidateYaml).Methods("POST", "OPTIONS")
-	api.HandleFunc("/ai/examples", aiController.GetAIExamples).Methods("GET", "OPTIONS")
-	api.HandleFunc("/ai/git", aiController.ProcessGitCommand).Methods("POST", "OPTIONS") // 🆕 Git 전용 엔드포인트 추가
-
-	// 🆕 Git 관련 API 추가
-	api.HandleFunc("/git/yaml", gitController.GetYamlFromGit).Methods("POST", "OPTIONS")    // Git에서 YAML 조회
-	api.HandleFunc("/git/apply", gitController.ApplyYamlFromGit).Methods("POST", "OPTIONS") // Git에서 YAML 적용
-	api.HandleFunc("/git/ai", gitController.ProcessGitWithAI).Methods("POST", "OPTIONS")    // AI를 통한 Git 연동
-	api.HandleFunc("/git/cleanup", gitController.CleanupGitTemp).Methods("GET", "OPTIONS")  // Git 임시 파일 정리
+	// 컨트롤러 생성 후 API 라우트 등록 (테스트와 같은 라우트 정의 사용)
+	controller.RegisterRoutes(router, controller.NewControllers())
 
 	log.Println("📋 등록된 라우트:")
 	log.Println("  GET    /health                    - 헬스 체크")
@@ -101,6 +63,7 @@ func setupRoutes(router *mux.Router) {
 	log.Println("  POST   /api/config                - 새로운 config 추가")
 	log.Println("  GET    /api/contexts              - context 목록 조회")
 	log.Println("  GET    /api/context/{contextName} - context 상세 정보 조회")
+	log.Println("  GET    /api/context/{contextName}/overview - 클러스터 상태 요약 (버전, 노드, 리소스, 파드, 워크로드, Warning 이벤트)")
 	log.Println("  POST   /api/context/use           - context 변경")
 	log.Println("  DELETE /api/context               - context 삭제")
 	log.Println("  POST   /api/apply                 - YAML 적용")
@@ -113,7 +76,7 @@ func setupRoutes(router *mux.Router) {
 	log.Println("  POST   /api/ai/generate-apply     - AI로 YAML 생성 후 적용 (Git 자동감지)")
 	log.Println("  POST   /api/ai/query              - AI에게 질문하기")
 	log.Println("  POST   /api/ai/template           - 템플릿 기반 YAML 생성")
-	log.Println("  POST   /api/ai/validate           - AI YAML 검증")
+	log.Println("  POST   /api/ai/validate           - AI YAML 구문/스키마 검증 (kubernetesVersion, crdContent)")
 	log.Println("  POST   /api/ai/git                - AI Git 전용 처리")
 	log.Println("  GET    /api/ai/examples           - AI 사용 예제")
 	log.Println("")
@@ -122,5 +85,100 @@ func setupRoutes(router *mux.Router) {
 	log.Println("  POST   /api/git/apply            - Git 레포지토리 YAML 적용")
 	log.Println("  POST   /api/git/ai               - AI를 통한 Git 연동")
 	log.Println("  GET    /api/git/cleanup          - Git 임시 파일 정리")
+	log.Println("  POST   /api/git/drift            - Git과 클러스터 드리프트 리포트 (path, context, labelSelector)")
+	log.Println("")
+	log.Println("🛡️ 정책 관련 라우트:")
+	log.Println("  GET    /api/policy               - 적용 전 정책 설정 조회")
+	log.Println("  PUT    /api/policy               - 적용 전 정책 설정 변경")
+	log.Println("  POST   /api/policy/evaluate      - YAML 정책 평가 (적용 없음)")
+	log.Println("")
+	log.Println("🏗️ kustomize 관련 라우트:")
+	log.Println("  POST   /api/kustomize/build      - 업로드 아카이브 kustomize 빌드 미리보기")
+	log.Println("  POST   /api/kustomize/apply      - 업로드 아카이브 kustomize 빌드 후 적용")
+	log.Println("")
+	log.Println("⛵ Helm 관련 라우트:")
+	log.Println("  POST   /api/helm/git/template    - Git 차트 렌더링 미리보기")
+	log.Println("  POST   /api/helm/git/install     - Git 차트 설치/업그레이드")
+	log.Println("  POST   /api/helm/upload/template - 업로드 차트 렌더링 미리보기")
+	log.Println("  POST   /api/helm/upload/install  - 업로드 차트 설치/업그레이드")
+	log.Println("  GET    /api/helm/releases        - Helm 릴리스 목록 조회")
+	log.Println("  GET    /api/helm/releases/{namespace}/{name}/history - Helm 릴리스 이력 조회")
+	log.Println("")
+	log.Println("🌍 환경 변수 관련 라우트:")
+	log.Println("  GET    /api/environments         - 환경별 변수 세트 조회")
+	log.Println("  PUT    /api/environments         - 환경별 변수 세트 변경")
+	log.Println("  POST   /api/environments/substitute - ${VAR} 치환 미리보기")
+	log.Println("")
+	log.Println("📁 네임스페이스 관련 라우트:")
+	log.Println("  GET    /api/namespaces           - 네임스페이스 목록 (상태, 라벨, 리소스 수)")
+	log.Println("  POST   /api/namespaces           - 네임스페이스 생성 (라벨, 어노테이션)")
+	log.Println("  GET    /api/namespaces/{name}    - 네임스페이스 상세 조회")
+	log.Println("  GET    /api/namespaces/{name}/delete-preview - 삭제될 리소스 미리보기")
+	log.Println("  DELETE /api/namespaces/{name}?confirm={name} - 네임스페이스 삭제")
+	log.Println("")
+	log.Println("👥 팀 온보딩 관련 라우트:")
+	log.Println("  POST   /api/onboarding/team      - 팀 네임스페이스 번들 생성/갱신 (quota, RBAC, NetworkPolicy, context)")
+	log.Println("")
+	log.Println("🔎 리소스 조회 관련 라우트:")
+	log.Println("  GET    /api/resources            - 조회 가능한 리소스 종류 목록 (context)")
+	log.Println("  GET    /api/resources/{kind}     - 리소스 목록 (context, namespace, labelSelector, fieldSelector, limit, continue)")
+	log.Println("  GET    /api/resources/{kind}/{name} - 리소스 상세 조회 (context, namespace)")
+	log.Println("")
+	log.Println("📜 로그 스트리밍 관련 라우트:")
+	log.Println("  WS/SSE /api/logs                 - 파드 로그 스트리밍 (pod|selector, container, follow, tail, since, sinceTime, previous)")
+	log.Println("")
+	log.Println("🖥️ 파드 exec 관련 라우트:")
+	log.Println("  WS     /api/exec                 - 대화형 셸 (pod, container, command, tty, cols, rows, idleTimeout)")
+	log.Println("")
+	log.Println("🔀 포트포워드 관련 라우트:")
+	log.Println("  GET    /api/portforwards         - 활성 포트포워드 목록")
+	log.Println("  POST   /api/portforwards         - 파드/서비스 포트포워드 시작 (namespace, kind, name, port, ttl)")
+	log.Println("  GET    /api/portforwards/{id}    - 포트포워드 조회")
+	log.Println("  DELETE /api/portforwards/{id}    - 포트포워드 중지")
+	log.Println("  *      /api/portforwards/{id}/proxy/... - 대상 HTTP/WebSocket 프록시")
+	log.Println("")
+	log.Println("📣 이벤트 스트리밍 관련 라우트:")
+	log.Println("  WS/SSE /api/events               - 이벤트 스트리밍 (namespace, allNamespaces, object, related, type, reason, watchOnly)")
+	log.Println("")
+	log.Println("⚙️ 워크로드 운영 관련 라우트:")
+	log.Println("  GET    /api/workloads/operations - 작업 로그 (namespace, kind, name, limit)")
+	log.Println("  POST   /api/workloads/{kind}/{name}/scale   - 레플리카 수 변경 (replicas)")
+	log.Println("  POST   /api/workloads/{kind}/{name}/restart - 롤링 재시작")
+	log.Println("  POST   /api/workloads/{kind}/{name}/pause   - 롤아웃 일시 중지 (Deployment)")
+	log.Println("  POST   /api/workloads/{kind}/{name}/resume  - 롤아웃 재개 (Deployment)")
+	log.Println("  GET    /api/workloads/{kind}/{name}/history - 롤아웃 이력")
+	log.Println("  POST   /api/workloads/{kind}/{name}/undo    - 리비전 롤백 (revision)")
+	log.Println("")
+	log.Println("📤 리소스 내보내기 관련 라우트:")
+	log.Println("  POST   /api/export               - 재적용 가능한 YAML 내보내기 (resources, labelSelector, kinds, includeSecrets)")
+	log.Println("")
+	log.Println("🚚 리소스 승격 관련 라우트:")
+	log.Println("  POST   /api/promote              - 컨텍스트 간 복사/승격 (transform, diff, dry-run, apply)")
+	log.Println("")
+	log.Println("🌐 다중 클러스터 관련 라우트:")
+	log.Println("  POST   /api/apply/multi          - 여러 context에 YAML 적용 (contexts, group, canary, concurrency, stopOnFailure)")
+	log.Println("  GET    /api/context-groups       - context 그룹 조회")
+	log.Println("  PUT    /api/context-groups       - context 그룹 변경")
+	log.Println("")
+	log.Println("🔐 Secret 관련 라우트:")
+	log.Println("  POST   /api/secrets              - Secret 생성 (generic, docker-registry, tls)")
+	log.Println("  POST   /api/secrets/upload       - 업로드 파일/PEM으로 Secret 생성 (multipart)")
+	log.Println("")
+	log.Println("🗂️ ConfigMap 관련 라우트:")
+	log.Println("  POST   /api/configmaps/upload    - 업로드 파일로 ConfigMap 생성 (multipart, apply, dryRun, rollout)")
+	log.Println("  POST   /api/configmaps/git       - Git 레포지토리 파일/디렉토리로 ConfigMap 생성 (repoUrl, branch, path)")
+	log.Println("")
+	log.Println("🛡️ 권한 관련 라우트:")
+	log.Println("  GET    /api/permissions          - 동사 × 리소스 권한 매트릭스 (context, namespace, resources)")
+	log.Println("")
+	log.Println("🧵 비동기 작업 관련 라우트:")
+	log.Println("  GET    /api/jobs                 - 작업 목록")
+	log.Println("  POST   /api/jobs/git-apply       - Git 레포지토리 YAML 적용 작업 제출")
+	log.Println("  POST   /api/jobs/ai-generate-apply - AI YAML 생성 및 적용 작업 제출")
+	log.Println("  GET    /api/jobs/{id}            - 작업 상태 및 진행 상황")
+	log.Println("  GET    /api/jobs/{id}/logs       - 작업 로그 (since)")
+	log.Println("  GET    /api/jobs/{id}/result     - 작업 결과")
+	log.Println("  GET    /api/jobs/{id}/events     - 작업 진행 이벤트 스트림 (SSE 또는 WebSocket)")
+	log.Println("  POST   /api/jobs/{id}/cancel     - 작업 취소")
 	log.Println("✅ CORS 미들웨어 적용 완료 (모든 라우트에 OPTIONS 지원)")
 }
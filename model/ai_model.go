@@ -2,7 +2,8 @@ package model
 
 // AIYamlRequest - AI YAML 생성 요청
 type AIYamlRequest struct {
-	Prompt string `json:"prompt" binding:"required"` // AI에게 보낼 프롬프트
+	Prompt            string `json:"prompt" binding:"required"` // AI에게 보낼 프롬프트
+	KubernetesVersion string `json:"kubernetesVersion"`         // 스키마 검증 대상 서버 버전 (선택사항)
 }
 
 // AIYamlResponse - AI YAML 생성 응답
@@ -17,6 +18,8 @@ type AIYamlResult struct {
 	Prompt        string `json:"prompt"`        // 원본 프롬프트
 	GeneratedTime string `json:"generatedTime"` // 생성 시간
 	Source        string `json:"source"`        // AI 모델 소스
+
+	Validation *SchemaValidationResult `json:"validation,omitempty"` // 스키마 검증 결과
 }
 
 // AIApplyRequest - AI YAML 생성 및 적용 요청
@@ -24,6 +27,8 @@ type AIApplyRequest struct {
 	Prompt    string `json:"prompt" binding:"required"` // AI에게 보낼 프롬프트
 	Namespace string `json:"namespace"`                 // 네임스페이스 (선택사항)
 	DryRun    bool   `json:"dryRun"`                    // dry-run 모드 (선택사항)
+
+	KubernetesVersion string `json:"kubernetesVersion"` // 스키마 검증 대상 서버 버전 (선택사항)
 }
 
 // AIApplyResponse - AI YAML 생성 및 적용 응답
@@ -39,6 +44,8 @@ type AIApplyResult struct {
 	Prompt        string          `json:"prompt"`        // 원본 프롬프트
 	GeneratedTime string          `json:"generatedTime"` // 생성 시간
 	Source        string          `json:"source"`        // AI 모델 소스
+
+	Validation *SchemaValidationResult `json:"validation,omitempty"` // 적용 전 스키마 검증 결과
 }
 
 // AIQueryRequest - AI 질문 요청
@@ -123,6 +130,10 @@ type AITemplateRequest struct {
 	Parameters   map[string]interface{} `json:"parameters"`                      // 템플릿 파라미터
 	Namespace    string                 `json:"namespace"`                       // 네임스페이스 (선택사항)
 	DryRun       bool                   `json:"dryRun"`                          // dry-run 모드 (선택사항)
+	Environment  string                 `json:"environment"`                     // ${VAR} 치환에 사용할 환경 이름 (선택사항)
+	Variables    map[string]string      `json:"variables"`                       // 직접 지정한 치환 변수 (선택사항)
+
+	KubernetesVersion string `json:"kubernetesVersion"` // 스키마 검증 대상 서버 버전 (선택사항)
 }
 
 // AITemplateResponse - AI 템플릿 기반 생성 응답
@@ -139,4 +150,9 @@ type AITemplateResult struct {
 	ApplyResult   *ApplyYamlResult       `json:"applyResult,omitempty"` // 적용 결과 (적용한 경우)
 	GeneratedTime string                 `json:"generatedTime"`         // 생성 시간
 	Source        string                 `json:"source"`                // AI 모델 소스
+
+	Environment       string            `json:"environment,omitempty"`       // 치환에 사용한 환경 이름
+	ResolvedVariables map[string]string `json:"resolvedVariables,omitempty"` // 치환에 실제 사용된 변수와 값
+
+	Validation *SchemaValidationResult `json:"validation,omitempty"` // 스키마 검증 결과
 }
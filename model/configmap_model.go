@@ -0,0 +1,55 @@
+package model
+
+// ConfigMap 의존 Deployment 롤아웃 상태
+const (
+	ConfigMapRolloutAnnotated     = "annotated"      // 해시 어노테이션 변경 (롤아웃 발생)
+	ConfigMapRolloutUnchanged     = "unchanged"      // 해시가 같아 변경 없음
+	ConfigMapRolloutWouldAnnotate = "would-annotate" // dry-run: 적용 시 어노테이션이 변경됨
+	ConfigMapRolloutFailed        = "failed"         // 어노테이션 패치 실패
+)
+
+// ConfigMapRequest - ConfigMap 생성 공통 옵션 (업로드/Git 요청 공통)
+type ConfigMapRequest struct {
+	Name      string            `json:"name" binding:"required"` // ConfigMap 이름
+	Namespace string            `json:"namespace"`               // 네임스페이스 (기본값: default)
+	Context   string            `json:"context"`                 // 적용할 kubeconfig context (선택사항)
+	Labels    map[string]string `json:"labels"`                  // metadata.labels (선택사항)
+	Apply     bool              `json:"apply"`                   // true면 생성 후 적용 (기본값: YAML만 생성)
+	DryRun    bool              `json:"dryRun"`                  // dry-run 모드 (apply=true일 때)
+	Rollout   bool              `json:"rollout"`                 // ConfigMap을 참조하는 Deployment에 내용 해시 어노테이션 추가 (apply=true일 때)
+}
+
+// ConfigMapGitRequest - Git 레포지토리 파일/디렉토리로 ConfigMap 생성 요청 DTO
+type ConfigMapGitRequest struct {
+	ConfigMapRequest        // 익명 임베딩 (공통 옵션)
+	RepoURL          string `json:"repoUrl" binding:"required"` // Git 레포지토리 URL
+	Branch           string `json:"branch"`                     // 브랜치 (기본값: main)
+	Path             string `json:"path" binding:"required"`    // 레포지토리 내 파일 또는 디렉토리 경로 (디렉토리는 하위 디렉토리 제외)
+}
+
+// ConfigMapRollout - 의존 Deployment 어노테이션 결과
+type ConfigMapRollout struct {
+	Deployment string `json:"deployment"`      // Deployment 이름
+	Status     string `json:"status"`          // annotated, unchanged, would-annotate, failed
+	Error      string `json:"error,omitempty"` // 실패 사유
+}
+
+// ConfigMapResult - ConfigMap 생성 결과
+type ConfigMapResult struct {
+	Name           string             `json:"name"`                  // ConfigMap 이름
+	Namespace      string             `json:"namespace"`             // 네임스페이스
+	YamlContent    string             `json:"yamlContent"`           // 생성된 매니페스트
+	Keys           []string           `json:"keys"`                  // data 키 (UTF-8 텍스트)
+	BinaryKeys     []string           `json:"binaryKeys"`            // binaryData 키 (UTF-8이 아닌 파일)
+	Hash           string             `json:"hash"`                  // 내용 해시 (sha256)
+	HashAnnotation string             `json:"hashAnnotation"`        // Deployment pod 템플릿에 추가하는 어노테이션 키
+	Source         string             `json:"source,omitempty"`      // Git 출처 (repo@branch:path)
+	ApplyResult    *ApplyYamlResult   `json:"applyResult,omitempty"` // 적용 결과 (apply=true인 경우)
+	Rollouts       []ConfigMapRollout `json:"rollouts,omitempty"`    // 의존 Deployment 어노테이션 결과 (rollout=true인 경우)
+}
+
+// ConfigMapResponse - ConfigMap 생성 응답
+type ConfigMapResponse struct {
+	BaseResponse                 // 익명 임베딩
+	Data         ConfigMapResult `json:"data"`
+}
@@ -0,0 +1,70 @@
+package model
+
+// 드리프트 상태
+const (
+	DriftStatusInSync  = "in-sync" // Git과 일치
+	DriftStatusDrifted = "drifted" // 실제 값이 Git과 다름
+	DriftStatusMissing = "missing" // Git에는 있지만 클러스터에 없음
+	DriftStatusExtra   = "extra"   // 라벨이 일치하지만 Git에 없는 리소스
+)
+
+// DriftRequest - Git 매니페스트와 클러스터 상태 비교 요청 DTO
+type DriftRequest struct {
+	RepoURL       string            `json:"repoUrl" binding:"required"` // Git 레포지토리 URL
+	Branch        string            `json:"branch"`                     // 브랜치 (기본값: main)
+	Path          string            `json:"path"`                       // 비교할 경로 (파일 또는 디렉토리, kustomization 디렉토리면 빌드 결과 사용)
+	KustomizePath string            `json:"kustomizePath"`              // 빌드할 kustomization 경로 (선택사항)
+	Context       string            `json:"context"`                    // 비교할 kubeconfig context (비어 있으면 현재 context)
+	Namespace     string            `json:"namespace"`                  // 매니페스트에 네임스페이스가 없을 때 사용할 네임스페이스 (기본값: default)
+	Environment   string            `json:"environment"`                // ${VAR} 치환에 사용할 환경 이름 (선택사항)
+	Variables     map[string]string `json:"variables"`                  // 직접 지정한 치환 변수 (선택사항)
+	LabelSelector string            `json:"labelSelector"`              // Git에 없는 리소스 탐지용 라벨 셀렉터 (비어 있으면 탐지 생략)
+	ExtraKinds    []string          `json:"extraKinds"`                 // 추가 리소스 탐지 대상 종류 (비어 있으면 Git에 있는 종류)
+}
+
+// DriftDifference - 필드 단위 차이
+type DriftDifference struct {
+	Path     string      `json:"path"`     // 필드 경로 (예: spec.template.spec.containers[web].image)
+	Expected interface{} `json:"expected"` // Git 값
+	Actual   interface{} `json:"actual"`   // 클러스터 값 (없으면 null)
+}
+
+// DriftResource - 리소스별 드리프트 결과
+type DriftResource struct {
+	APIVersion  string            `json:"apiVersion"`            // API 버전
+	Kind        string            `json:"kind"`                  // Kind
+	Namespace   string            `json:"namespace,omitempty"`   // 네임스페이스
+	Name        string            `json:"name"`                  // 이름
+	Status      string            `json:"status"`                // in-sync, drifted, missing, extra
+	SourceFile  string            `json:"sourceFile,omitempty"`  // 매니페스트 파일 경로
+	Differences []DriftDifference `json:"differences,omitempty"` // 필드 단위 차이 (drifted)
+	Message     string            `json:"message,omitempty"`     // 조회 실패 사유 등
+}
+
+// DriftSummary - 상태별 개수
+type DriftSummary struct {
+	Total   int `json:"total"`   // 전체 리소스 수
+	InSync  int `json:"inSync"`  // 일치
+	Drifted int `json:"drifted"` // 차이 있음
+	Missing int `json:"missing"` // 클러스터에 없음
+	Extra   int `json:"extra"`   // Git에 없음
+}
+
+// DriftReport - 드리프트 리포트
+type DriftReport struct {
+	RepoURL     string          `json:"repoUrl"`     // 레포지토리 URL
+	Branch      string          `json:"branch"`      // 브랜치
+	Commit      string          `json:"commit"`      // 비교한 커밋
+	Path        string          `json:"path"`        // 비교한 경로
+	Context     string          `json:"context"`     // 비교한 context
+	InSync      bool            `json:"inSync"`      // 전체 일치 여부
+	Summary     DriftSummary    `json:"summary"`     // 상태별 개수
+	Resources   []DriftResource `json:"resources"`   // 리소스별 결과
+	CheckedTime string          `json:"checkedTime"` // 비교 시간
+}
+
+// DriftReportResponse - 드리프트 리포트 응답
+type DriftReportResponse struct {
+	BaseResponse             // 익명 임베딩
+	Data         DriftReport `json:"data"`
+}
@@ -0,0 +1,41 @@
+package model
+
+// 이벤트 스트림 메시지 타입
+const (
+	EventMessageTypeEvent = "event" // 쿠버네티스 이벤트
+	EventMessageTypeError = "error" // kubectl 오류 출력
+	EventMessageTypeEnd   = "end"   // 스트림 종료
+)
+
+// EventObjectRef - 이벤트를 볼 대상 오브젝트 (ApplyYamlResult.Resources 형식: deployment.apps/web)
+type EventObjectRef struct {
+	Kind string `json:"kind"` // 소문자 Kind (예: deployment)
+	Name string `json:"name"` // 오브젝트 이름
+}
+
+// EventStreamOptions - 이벤트 스트리밍 옵션 (쿼리 파라미터)
+type EventStreamOptions struct {
+	Namespace     string           // 네임스페이스 (기본값: default)
+	AllNamespaces bool             // 전체 네임스페이스
+	Objects       []EventObjectRef // 대상 오브젝트 (비어 있으면 네임스페이스 전체)
+	Related       bool             // 대상 이름으로 시작하는 하위 오브젝트(ReplicaSet, Pod 등) 이벤트 포함
+	Type          string           // 이벤트 타입 필터 (Normal 또는 Warning)
+	Reasons       []string         // 사유 필터 (예: BackOff, FailedScheduling)
+	WatchOnly     bool             // 기존 이벤트는 생략하고 새 이벤트만 전송
+}
+
+// ClusterEventMessage - 이벤트 스트림 메시지 (WebSocket 텍스트 프레임 또는 SSE data)
+type ClusterEventMessage struct {
+	Type           string `json:"type"`                     // event, error, end
+	EventType      string `json:"eventType,omitempty"`      // Normal, Warning
+	Reason         string `json:"reason,omitempty"`         // 이벤트 사유
+	Note           string `json:"note,omitempty"`           // 이벤트 내용
+	Namespace      string `json:"namespace,omitempty"`      // 이벤트 네임스페이스
+	ObjectKind     string `json:"objectKind,omitempty"`     // 대상 오브젝트 Kind
+	ObjectName     string `json:"objectName,omitempty"`     // 대상 오브젝트 이름
+	Source         string `json:"source,omitempty"`         // 이벤트를 기록한 컴포넌트
+	Count          int    `json:"count,omitempty"`          // 발생 횟수
+	FirstTimestamp string `json:"firstTimestamp,omitempty"` // 최초 발생 시간
+	LastTimestamp  string `json:"lastTimestamp,omitempty"`  // 마지막 발생 시간
+	Message        string `json:"message,omitempty"`        // 오류/종료 메시지
+}
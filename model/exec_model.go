@@ -0,0 +1,33 @@
+package model
+
+// exec WebSocket 메시지 타입
+const (
+	ExecMessageStdin  = "stdin"  // 클라이언트 → 서버: 입력
+	ExecMessageResize = "resize" // 클라이언트 → 서버: 터미널 크기 변경
+	ExecMessageStdout = "stdout" // 서버 → 클라이언트: 표준 출력 (TTY 모드에서는 stderr 포함)
+	ExecMessageStderr = "stderr" // 서버 → 클라이언트: 표준 에러 (TTY가 아닌 경우)
+	ExecMessageExit   = "exit"   // 서버 → 클라이언트: 종료 코드
+	ExecMessageError  = "error"  // 서버 → 클라이언트: 세션 오류
+)
+
+// ExecOptions - 파드 exec 옵션 (쿼리 파라미터)
+type ExecOptions struct {
+	Namespace   string   // 네임스페이스 (기본값: default)
+	Pod         string   // 파드 이름
+	Container   string   // 컨테이너 이름 (선택사항)
+	Command     []string // 실행할 명령 (기본값: bash가 있으면 bash, 없으면 sh)
+	TTY         bool     // TTY 할당 여부 (기본값: true)
+	Cols        uint16   // 초기 터미널 너비
+	Rows        uint16   // 초기 터미널 높이
+	IdleTimeout string   // 입출력이 없을 때 세션을 닫는 시간 (예: 10m)
+}
+
+// ExecMessage - exec WebSocket 메시지 (JSON 텍스트 프레임)
+type ExecMessage struct {
+	Type     string `json:"type"`               // stdin, resize, stdout, stderr, exit, error
+	Data     string `json:"data,omitempty"`     // 입출력 데이터
+	Cols     uint16 `json:"cols,omitempty"`     // resize: 너비
+	Rows     uint16 `json:"rows,omitempty"`     // resize: 높이
+	ExitCode *int   `json:"exitCode,omitempty"` // exit: 종료 코드
+	Reason   string `json:"reason,omitempty"`   // exit/error: 사유
+}
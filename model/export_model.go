@@ -0,0 +1,28 @@
+package model
+
+// ExportRequest - 실행 중인 리소스를 재적용 가능한 YAML로 내보내기 요청 DTO
+// Resources, LabelSelector 중 하나를 지정하거나 둘 다 비우면 네임스페이스 전체를 내보냄
+type ExportRequest struct {
+	Context        string   `json:"context"`        // 조회할 kubeconfig context (비어 있으면 현재 context)
+	Namespace      string   `json:"namespace"`      // 네임스페이스 (기본값: default)
+	Resources      []string `json:"resources"`      // 리소스 참조 (예: deployment/web, service/web - ApplyYamlResult.Resources 형식)
+	LabelSelector  string   `json:"labelSelector"`  // 라벨 셀렉터 (예: app=web)
+	Kinds          []string `json:"kinds"`          // 셀렉터/네임스페이스 전체 내보내기 대상 종류 (비어 있으면 기본 종류)
+	IncludeSecrets bool     `json:"includeSecrets"` // 셀렉터/네임스페이스 전체 내보내기에 Secret 포함 여부
+	KeepNamespace  bool     `json:"keepNamespace"`  // metadata.namespace 유지 여부 (기본값: 제거하여 다른 네임스페이스에도 적용 가능)
+}
+
+// ExportResult - 내보내기 결과
+type ExportResult struct {
+	YamlContent   string   `json:"yamlContent"`   // 정리된 멀티 도큐먼트 YAML (POST /api/apply 에 그대로 사용 가능)
+	Resources     []string `json:"resources"`     // 내보낸 리소스 (kind/name)
+	Skipped       []string `json:"skipped"`       // 컨트롤러가 생성한 리소스 등 제외된 리소스 (kind/name: 사유)
+	DocumentCount int      `json:"documentCount"` // 도큐먼트 수
+	ExportedTime  string   `json:"exportedTime"`  // 내보낸 시간
+}
+
+// ExportResponse - 내보내기 응답
+type ExportResponse struct {
+	BaseResponse              // 익명 임베딩
+	Data         ExportResult `json:"data"`
+}
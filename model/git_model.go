@@ -5,6 +5,8 @@ type GitYamlRequest struct {
 	RepoURL  string `json:"repoUrl" binding:"required"` // Git 레포지토리 URL
 	Branch   string `json:"branch"`                     // 브랜치 (선택사항, 기본값: main/master)
 	Filename string `json:"filename"`                   // 특정 파일명 (선택사항)
+
+	KustomizePath string `json:"kustomizePath"` // 빌드할 kustomization 경로 (선택사항, 예: overlays/prod)
 }
 
 // GitApplyRequest - Git 레포지토리에서 YAML 가져와서 적용 요청
@@ -14,6 +16,10 @@ type GitApplyRequest struct {
 	Filename  string `json:"filename"`                   // 특정 파일명 (선택사항, 없으면 모든 YAML)
 	Namespace string `json:"namespace"`                  // 네임스페이스 (선택사항)
 	DryRun    bool   `json:"dryRun"`                     // dry-run 모드 (선택사항)
+
+	KustomizePath string            `json:"kustomizePath"` // 빌드할 kustomization 경로 (선택사항, 예: overlays/prod)
+	Environment   string            `json:"environment"`   // ${VAR} 치환에 사용할 환경 이름 (선택사항)
+	Variables     map[string]string `json:"variables"`     // 직접 지정한 치환 변수 (선택사항)
 }
 
 // GitYamlResponse - Git YAML 조회 응답
@@ -29,6 +35,8 @@ type GitYamlData struct {
 	YamlFiles   []GitYamlFile `json:"yamlFiles"`   // 발견된 YAML 파일들
 	TotalFiles  int           `json:"totalFiles"`  // 총 파일 수
 	RetrievedAt string        `json:"retrievedAt"` // 조회 시간
+
+	Kustomizations []KustomizeTarget `json:"kustomizations,omitempty"` // 발견된 kustomization 디렉토리
 }
 
 // GitYamlFile - Git에서 가져온 YAML 파일 정보
@@ -38,6 +46,8 @@ type GitYamlFile struct {
 	Content      string `json:"content"`      // 파일 내용
 	Size         int64  `json:"size"`         // 파일 크기 (bytes)
 	IsKubernetes bool   `json:"isKubernetes"` // Kubernetes YAML인지 여부
+
+	KustomizePath string `json:"kustomizePath,omitempty"` // kustomize 빌드 결과인 경우 빌드한 경로
 }
 
 // GitApplyResponse - Git YAML 적용 응답
@@ -59,10 +69,14 @@ type GitApplyResult struct {
 	TotalFiles   int                  `json:"totalFiles"`   // 총 파일 수
 	SuccessFiles int                  `json:"successFiles"` // 성공한 파일 수
 	FailedFiles  int                  `json:"failedFiles"`  // 실패한 파일 수
+	SkippedFiles int                  `json:"skippedFiles"` // 작업 취소로 적용하지 않은 파일 수
 	AppliedTime  string               `json:"appliedTime"`  // 적용 시간
 	Results      []GitFileApplyResult `json:"results"`      // 각 파일별 적용 결과
 	AllResources []string             `json:"allResources"` // 모든 적용된 리소스 목록
 	DryRun       bool                 `json:"dryRun"`       // dry-run 여부
+
+	Environment       string            `json:"environment,omitempty"`       // 치환에 사용한 환경 이름
+	ResolvedVariables map[string]string `json:"resolvedVariables,omitempty"` // 치환에 실제 사용된 변수와 값 (전체 파일 합산)
 }
 
 // GitFileApplyResult - 개별 파일 적용 결과
@@ -72,6 +86,8 @@ type GitFileApplyResult struct {
 	Output    string   `json:"output"`    // kubectl 출력
 	Resources []string `json:"resources"` // 적용된 리소스 목록
 	Error     string   `json:"error"`     // 에러 메시지 (실패시)
+
+	PolicyFindings []PolicyFinding `json:"policyFindings,omitempty"` // 정책 위반 항목
 }
 
 // AIGitRequest - AI를 통한 Git 연동 요청
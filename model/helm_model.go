@@ -0,0 +1,87 @@
+package model
+
+// HelmChartInfo - 발견된 Helm 차트 정보 (Chart.yaml)
+type HelmChartInfo struct {
+	Path        string `json:"path"`        // 루트 기준 차트 디렉토리 경로
+	Name        string `json:"name"`        // 차트 이름
+	Version     string `json:"version"`     // 차트 버전
+	AppVersion  string `json:"appVersion"`  // 애플리케이션 버전
+	Description string `json:"description"` // 차트 설명
+}
+
+// HelmGitRequest - Git 레포지토리의 차트 렌더링/설치 요청 DTO
+type HelmGitRequest struct {
+	RepoURL     string                 `json:"repoUrl" binding:"required"`     // Git 레포지토리 URL
+	Branch      string                 `json:"branch"`                         // 브랜치 (선택사항)
+	ChartPath   string                 `json:"chartPath"`                      // 차트 경로 (차트가 여러 개일 때 필수)
+	ReleaseName string                 `json:"releaseName" binding:"required"` // 릴리스 이름
+	Namespace   string                 `json:"namespace"`                      // 네임스페이스 (선택사항, 기본값: default)
+	Values      map[string]interface{} `json:"values"`                         // values 재정의
+	DryRun      bool                   `json:"dryRun"`                         // dry-run 모드 (설치 시)
+}
+
+// HelmRenderResult - 차트 렌더링 결과
+type HelmRenderResult struct {
+	Chart        HelmChartInfo   `json:"chart"`        // 렌더링한 차트
+	Charts       []HelmChartInfo `json:"charts"`       // 발견된 모든 차트
+	ReleaseName  string          `json:"releaseName"`  // 릴리스 이름
+	Namespace    string          `json:"namespace"`    // 네임스페이스
+	RenderedYaml string          `json:"renderedYaml"` // 렌더링된 매니페스트
+	Resources    []string        `json:"resources"`    // 렌더링된 리소스 목록 (kind/name)
+	RenderedTime string          `json:"renderedTime"` // 렌더링 시간
+}
+
+// HelmRenderResponse - 차트 렌더링 응답
+type HelmRenderResponse struct {
+	BaseResponse                  // 익명 임베딩
+	Data         HelmRenderResult `json:"data"`
+}
+
+// HelmInstallResult - 차트 설치/업그레이드 결과
+type HelmInstallResult struct {
+	Chart          HelmChartInfo   `json:"chart"`                    // 설치한 차트
+	Release        HelmRelease     `json:"release"`                  // 설치 후 릴리스 상태
+	Output         string          `json:"output"`                   // helm 명령 출력
+	DryRun         bool            `json:"dryRun"`                   // dry-run 여부
+	InstalledTime  string          `json:"installedTime"`            // 설치 시간
+	PolicyFindings []PolicyFinding `json:"policyFindings,omitempty"` // 정책 경고
+}
+
+// HelmInstallResponse - 차트 설치/업그레이드 응답
+type HelmInstallResponse struct {
+	BaseResponse                   // 익명 임베딩
+	Data         HelmInstallResult `json:"data"`
+}
+
+// HelmRelease - Helm 릴리스 정보 (helm list -o json 형식)
+type HelmRelease struct {
+	Name       string `json:"name"`        // 릴리스 이름
+	Namespace  string `json:"namespace"`   // 네임스페이스
+	Revision   string `json:"revision"`    // 현재 리비전
+	Updated    string `json:"updated"`     // 마지막 변경 시간
+	Status     string `json:"status"`      // 상태 (deployed, failed 등)
+	Chart      string `json:"chart"`       // 차트 이름-버전
+	AppVersion string `json:"app_version"` // 애플리케이션 버전
+}
+
+// HelmReleaseRevision - 릴리스 리비전 이력 (helm history -o json 형식)
+type HelmReleaseRevision struct {
+	Revision    int    `json:"revision"`    // 리비전 번호
+	Updated     string `json:"updated"`     // 변경 시간
+	Status      string `json:"status"`      // 상태
+	Chart       string `json:"chart"`       // 차트 이름-버전
+	AppVersion  string `json:"app_version"` // 애플리케이션 버전
+	Description string `json:"description"` // 설명
+}
+
+// HelmReleasesResponse - 릴리스 목록 응답
+type HelmReleasesResponse struct {
+	BaseResponse               // 익명 임베딩
+	Data         []HelmRelease `json:"data"`
+}
+
+// HelmHistoryResponse - 릴리스 이력 응답
+type HelmHistoryResponse struct {
+	BaseResponse                       // 익명 임베딩
+	Data         []HelmReleaseRevision `json:"data"`
+}
@@ -0,0 +1,107 @@
+package model
+
+// 비동기 작업 상태
+const (
+	JobStatusPending   = "pending"   // 실행 대기
+	JobStatusRunning   = "running"   // 실행 중
+	JobStatusSucceeded = "succeeded" // 성공
+	JobStatusFailed    = "failed"    // 실패
+	JobStatusCancelled = "cancelled" // 사용자 요청으로 취소
+)
+
+// 비동기 작업 종류
+const (
+	JobTypeGitApply        = "git-apply"         // Git 레포지토리 YAML 적용
+	JobTypeAIGenerateApply = "ai-generate-apply" // AI YAML 생성 및 적용
+)
+
+// 작업 항목 상태
+const (
+	JobItemStatusRunning   = "running"   // 처리 중
+	JobItemStatusSucceeded = "succeeded" // 성공
+	JobItemStatusFailed    = "failed"    // 실패
+	JobItemStatusSkipped   = "skipped"   // 취소 등으로 처리하지 않음
+)
+
+// 작업 이벤트 종류 (SSE event 이름과 동일)
+const (
+	JobEventSnapshot = "snapshot" // 구독 시작 시 현재 상태
+	JobEventStatus   = "status"   // 작업 상태 변경
+	JobEventProgress = "progress" // 항목 진행 상황 변경
+	JobEventLog      = "log"      // 로그 추가
+	JobEventEnd      = "end"      // 작업 종료 (성공/실패/취소)
+)
+
+// JobProgressItem - 작업 항목(파일/리소스/단계)별 진행 상황
+type JobProgressItem struct {
+	Name        string `json:"name"`              // 항목 이름 (예: 파일 경로)
+	Status      string `json:"status"`            // 항목 상태
+	Message     string `json:"message,omitempty"` // 실패 사유 등
+	UpdatedTime string `json:"updatedTime"`       // 마지막 변경 시간
+}
+
+// JobProgress - 작업 진행 상황
+type JobProgress struct {
+	Total     int               `json:"total"`             // 전체 항목 수 (알 수 없으면 0)
+	Completed int               `json:"completed"`         // 성공한 항목 수
+	Failed    int               `json:"failed"`            // 실패한 항목 수
+	Current   string            `json:"current,omitempty"` // 처리 중인 항목
+	Items     []JobProgressItem `json:"items"`             // 항목별 진행 상황
+}
+
+// JobLogEntry - 작업 로그 한 줄
+type JobLogEntry struct {
+	Index   int    `json:"index"`   // 로그 순번 (since 파라미터로 이어서 조회)
+	Time    string `json:"time"`    // 기록 시간
+	Message string `json:"message"` // 로그 내용
+}
+
+// Job - 비동기 작업 상태
+type Job struct {
+	ID           string      `json:"id"`                     // 작업 ID
+	Type         string      `json:"type"`                   // 작업 종류
+	Description  string      `json:"description"`            // 작업 설명
+	Status       string      `json:"status"`                 // 작업 상태
+	Progress     JobProgress `json:"progress"`               // 진행 상황
+	LogCount     int         `json:"logCount"`               // 누적 로그 수
+	Error        string      `json:"error,omitempty"`        // 실패 사유
+	HasResult    bool        `json:"hasResult"`              // 결과 조회 가능 여부
+	CreatedTime  string      `json:"createdTime"`            // 제출 시간
+	StartedTime  string      `json:"startedTime,omitempty"`  // 실행 시작 시간
+	FinishedTime string      `json:"finishedTime,omitempty"` // 종료 시간
+}
+
+// JobEvent - 작업 이벤트 스트림 메시지
+type JobEvent struct {
+	Type     string       `json:"type"`               // 이벤트 종류
+	JobID    string       `json:"jobId"`              // 작업 ID
+	Job      *Job         `json:"job,omitempty"`      // 작업 상태 (snapshot/status/end)
+	Progress *JobProgress `json:"progress,omitempty"` // 진행 상황 (progress)
+	Log      *JobLogEntry `json:"log,omitempty"`      // 로그 (log)
+}
+
+// JobResponse - 작업 상태 응답
+type JobResponse struct {
+	BaseResponse     // 익명 임베딩
+	Data         Job `json:"data"`
+}
+
+// JobListResponse - 작업 목록 응답
+type JobListResponse struct {
+	BaseResponse       // 익명 임베딩
+	Data         []Job `json:"data"`
+}
+
+// JobLogsResponse - 작업 로그 응답
+type JobLogsResponse struct {
+	BaseResponse               // 익명 임베딩
+	Data         []JobLogEntry `json:"data"`
+}
+
+// JobResultResponse - 작업 결과 응답 (작업 종류별 결과 구조)
+type JobResultResponse struct {
+	BaseResponse             // 익명 임베딩
+	Status       string      `json:"status"` // 작업 상태
+	Error        string      `json:"error,omitempty"`
+	Data         interface{} `json:"data"`
+}
@@ -65,6 +65,7 @@ type ClusterConfig struct {
 type ClusterConfigData struct {
 	Server                   string `yaml:"server"`
 	CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
+	CertificateAuthority     string `yaml:"certificate-authority,omitempty"` // CA 인증서 파일 경로
 	InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify,omitempty"`
 }
 
@@ -90,8 +91,13 @@ type UserConfig struct {
 // UserConfigData - 사용자 설정 데이터
 type UserConfigData struct {
 	Token                 string `yaml:"token,omitempty"`
+	TokenFile             string `yaml:"tokenFile,omitempty"` // 토큰 파일 경로
 	ClientCertificateData string `yaml:"client-certificate-data,omitempty"`
+	ClientCertificate     string `yaml:"client-certificate,omitempty"` // 클라이언트 인증서 파일 경로
 	ClientKeyData         string `yaml:"client-key-data,omitempty"`
+	ClientKey             string `yaml:"client-key,omitempty"` // 클라이언트 키 파일 경로
+
+	Exec map[string]interface{} `yaml:"exec,omitempty"` // exec 인증 플러그인 (api 백엔드 미지원)
 }
 
 // ContextDetailResponse - Context 상세 정보 응답
@@ -128,9 +134,15 @@ type UserDetail struct {
 
 // ApplyYamlRequest - YAML 적용 요청 DTO
 type ApplyYamlRequest struct {
-	YamlContent string `json:"yamlContent" binding:"required"` // YAML 내용
-	Namespace   string `json:"namespace"`                      // 네임스페이스 (선택사항)
-	DryRun      bool   `json:"dryRun"`                         // dry-run 모드 (선택사항)
+	YamlContent         string            `json:"yamlContent" binding:"required"` // YAML 내용
+	Context             string            `json:"context"`                        // 적용할 kubeconfig context (선택사항, 비어 있으면 현재 context)
+	Namespace           string            `json:"namespace"`                      // 네임스페이스 (선택사항)
+	CreateNamespace     bool              `json:"createNamespace"`                // Namespace가 없으면 먼저 생성 (선택사항)
+	DryRun              bool              `json:"dryRun"`                         // dry-run 모드 (선택사항)
+	ForceConflicts      bool              `json:"forceConflicts"`                 // server-side apply(API 백엔드)에서 다른 field manager 소유 필드도 덮어씀 (선택사항, 기본값은 409 충돌 오류)
+	SkipPermissionCheck bool              `json:"skipPermissionCheck"`            // 권한 사전 검사 생략 (SelfSubjectAccessReview를 지원하지 않는 클러스터용, 선택사항)
+	Environment         string            `json:"environment"`                    // ${VAR} 치환에 사용할 환경 이름 (선택사항)
+	Variables           map[string]string `json:"variables"`                      // 직접 지정한 치환 변수 (선택사항, 환경 값보다 우선)
 }
 
 // ApplyYamlResponse - YAML 적용 응답
@@ -141,10 +153,16 @@ type ApplyYamlResponse struct {
 
 // ApplyYamlResult - YAML 적용 결과
 type ApplyYamlResult struct {
-	Output      string   `json:"output"`      // kubectl 명령 출력
-	AppliedTime string   `json:"appliedTime"` // 적용 시간
-	Resources   []string `json:"resources"`   // 적용된 리소스 목록
-	DryRun      bool     `json:"dryRun"`      // dry-run 여부
+	Output                 string            `json:"output"`                           // kubectl 명령 출력
+	AppliedTime            string            `json:"appliedTime"`                      // 적용 시간
+	Resources              []string          `json:"resources"`                        // 적용된 리소스 목록
+	DryRun                 bool              `json:"dryRun"`                           // dry-run 여부
+	CreatedNamespace       bool              `json:"createdNamespace,omitempty"`       // 적용 전에 네임스페이스를 새로 생성했는지 여부
+	Environment            string            `json:"environment,omitempty"`            // 치환에 사용한 환경 이름
+	ResolvedVariables      map[string]string `json:"resolvedVariables,omitempty"`      // 치환에 실제 사용된 변수와 값
+	PolicyFindings         []PolicyFinding   `json:"policyFindings,omitempty"`         // 정책 경고 (warn 모드 위반)
+	PermissionCheckSkipped bool              `json:"permissionCheckSkipped,omitempty"` // 권한 사전 검사를 생략했는지 여부 (요청에 따른 생략 또는 검사 실패)
+	PermissionCheckWarning string            `json:"permissionCheckWarning,omitempty"` // 검사를 수행하지 못해 생략한 경우 그 원인
 }
 
 // DeleteYamlRequest - YAML 삭제 요청 DTO
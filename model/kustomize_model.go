@@ -0,0 +1,35 @@
+package model
+
+// KustomizeTarget - 레포지토리/업로드에서 발견된 kustomization 디렉토리
+type KustomizeTarget struct {
+	Path       string   `json:"path"`       // 루트 기준 상대 경로 (예: overlays/prod)
+	IsOverlay  bool     `json:"isOverlay"`  // 다른 kustomization을 참조하는 overlay 여부
+	IsLeaf     bool     `json:"isLeaf"`     // 다른 kustomization이 참조하지 않는 최종 빌드 대상 여부
+	References []string `json:"references"` // 참조하는 base/component 경로
+}
+
+// KustomizeBuildResult - kustomize 빌드 결과
+type KustomizeBuildResult struct {
+	Path          string   `json:"path"`          // 빌드한 kustomization 경로
+	RenderedYaml  string   `json:"renderedYaml"`  // 렌더링된 매니페스트
+	Resources     []string `json:"resources"`     // 렌더링된 리소스 목록 (kind/name)
+	DocumentCount int      `json:"documentCount"` // 도큐먼트 수
+}
+
+// KustomizeBuildResponse - kustomize 빌드(미리보기) 응답
+type KustomizeBuildResponse struct {
+	BaseResponse                      // 익명 임베딩
+	Data         KustomizeBuildResult `json:"data"`
+}
+
+// KustomizeApplyResponse - kustomize 빌드 후 적용 응답
+type KustomizeApplyResponse struct {
+	BaseResponse                      // 익명 임베딩
+	Data         KustomizeApplyResult `json:"data"`
+}
+
+// KustomizeApplyResult - kustomize 빌드 후 적용 결과
+type KustomizeApplyResult struct {
+	Build       KustomizeBuildResult `json:"build"`       // 빌드 결과
+	ApplyResult ApplyYamlResult      `json:"applyResult"` // 적용 결과
+}
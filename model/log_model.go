@@ -0,0 +1,33 @@
+package model
+
+// 로그 스트림 메시지 타입
+const (
+	LogMessageTypeLog   = "log"   // 로그 한 줄
+	LogMessageTypeError = "error" // kubectl 오류 출력
+	LogMessageTypeEnd   = "end"   // 스트림 종료
+)
+
+// PodLogOptions - 파드 로그 스트리밍 옵션 (쿼리 파라미터)
+type PodLogOptions struct {
+	Namespace     string // 네임스페이스 (기본값: default)
+	Pod           string // 파드 이름 (Selector와 둘 중 하나 필수)
+	Selector      string // 라벨 셀렉터 (일치하는 모든 파드의 로그를 합쳐서 전송)
+	Container     string // 컨테이너 이름 (비어 있으면 전체 컨테이너)
+	Follow        bool   // 새 로그를 계속 수신
+	TailLines     int    // 마지막 N줄부터 (-1이면 kubectl 기본값)
+	Since         string // 상대 시간 (예: 10m, 1h)
+	SinceTime     string // 절대 시간 (RFC3339)
+	Previous      bool   // 재시작 이전 컨테이너의 로그
+	Timestamps    bool   // 각 줄의 타임스탬프 포함
+	MaxLogStreams int    // 셀렉터 사용 시 동시 스트림 수 (kubectl --max-log-requests)
+}
+
+// PodLogMessage - 로그 스트림 메시지 (WebSocket 텍스트 프레임 또는 SSE data)
+type PodLogMessage struct {
+	Type      string `json:"type"`                // log, error, end
+	Pod       string `json:"pod,omitempty"`       // 파드 이름
+	Container string `json:"container,omitempty"` // 컨테이너 이름
+	Timestamp string `json:"timestamp,omitempty"` // 로그 타임스탬프 (timestamps 옵션 사용 시)
+	Line      string `json:"line,omitempty"`      // 로그 내용
+	Message   string `json:"message,omitempty"`   // 오류/종료 메시지
+}
@@ -0,0 +1,65 @@
+package model
+
+// 컨텍스트별 적용 상태
+const (
+	MultiClusterStatusSucceeded = "succeeded" // 적용 성공
+	MultiClusterStatusFailed    = "failed"    // 적용 실패
+	MultiClusterStatusSkipped   = "skipped"   // 카나리/이전 실패 또는 요청 취소로 적용하지 않음
+)
+
+// ContextGroupConfig - context 그룹 설정 (파일로 저장)
+type ContextGroupConfig struct {
+	Groups map[string][]string `json:"groups" yaml:"groups"` // 그룹 이름 → context 목록
+}
+
+// ContextGroupConfigResponse - context 그룹 조회 응답
+type ContextGroupConfigResponse struct {
+	BaseResponse                    // 익명 임베딩
+	Data         ContextGroupConfig `json:"data"`
+}
+
+// MultiClusterApplyRequest - 여러 context에 같은 매니페스트를 적용하는 요청 DTO
+type MultiClusterApplyRequest struct {
+	YamlContent         string            `json:"yamlContent" binding:"required"` // YAML 내용
+	Contexts            []string          `json:"contexts"`                       // 대상 context 목록 (group과 함께 지정하면 합집합)
+	Group               string            `json:"group"`                          // 대상 context 그룹 이름 (선택사항)
+	Namespace           string            `json:"namespace"`                      // 네임스페이스 (선택사항)
+	CreateNamespace     bool              `json:"createNamespace"`                // Namespace가 없으면 먼저 생성 (선택사항)
+	DryRun              bool              `json:"dryRun"`                         // dry-run 모드 (선택사항)
+	ForceConflicts      bool              `json:"forceConflicts"`                 // server-side apply 필드 소유권 충돌 시 강제 적용 (선택사항)
+	SkipPermissionCheck bool              `json:"skipPermissionCheck"`            // 권한 사전 검사 생략 (선택사항, 결과에 표시)
+	Environment         string            `json:"environment"`                    // ${VAR} 치환에 사용할 환경 이름 (선택사항)
+	Variables           map[string]string `json:"variables"`                      // 직접 지정한 치환 변수 (선택사항)
+	Concurrency         int               `json:"concurrency"`                    // 동시에 적용할 context 수 (기본값: 4, 최대 10)
+	Canary              string            `json:"canary"`                         // 먼저 단독으로 적용할 context (실패하면 나머지는 적용하지 않음)
+	StopOnFailure       bool              `json:"stopOnFailure"`                  // 실패가 생기면 아직 시작하지 않은 context는 건너뜀
+}
+
+// MultiClusterContextResult - context 하나의 적용 결과
+type MultiClusterContextResult struct {
+	Context    string           `json:"context"`          // 대상 context
+	Canary     bool             `json:"canary"`           // 카나리 context 여부
+	Status     string           `json:"status"`           // succeeded, failed, skipped
+	Result     *ApplyYamlResult `json:"result,omitempty"` // 적용 결과 (성공한 경우)
+	Error      string           `json:"error,omitempty"`  // 실패/건너뜀 사유
+	DurationMs int64            `json:"durationMs"`       // 소요 시간 (밀리초)
+}
+
+// MultiClusterApplyResult - 다중 context 적용 결과 매트릭스
+type MultiClusterApplyResult struct {
+	Group       string                      `json:"group,omitempty"` // 사용한 context 그룹
+	DryRun      bool                        `json:"dryRun"`          // dry-run 여부
+	Concurrency int                         `json:"concurrency"`     // 실제 동시 실행 수
+	Total       int                         `json:"total"`           // 대상 context 수
+	Succeeded   int                         `json:"succeeded"`       // 성공 수
+	Failed      int                         `json:"failed"`          // 실패 수
+	Skipped     int                         `json:"skipped"`         // 건너뜀 수
+	Results     []MultiClusterContextResult `json:"results"`         // context별 결과 (요청 순서, 카나리가 맨 앞)
+	AppliedTime string                      `json:"appliedTime"`     // 완료 시간
+}
+
+// MultiClusterApplyResponse - 다중 context 적용 응답
+type MultiClusterApplyResponse struct {
+	BaseResponse                         // 익명 임베딩
+	Data         MultiClusterApplyResult `json:"data"`
+}
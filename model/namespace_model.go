@@ -0,0 +1,66 @@
+package model
+
+// NamespaceInfo - 네임스페이스 정보
+type NamespaceInfo struct {
+	Name           string            `json:"name"`           // 네임스페이스 이름
+	Status         string            `json:"status"`         // 상태 (Active, Terminating)
+	Labels         map[string]string `json:"labels"`         // 라벨
+	Annotations    map[string]string `json:"annotations"`    // 어노테이션
+	CreatedTime    string            `json:"createdTime"`    // 생성 시간
+	ResourceCounts map[string]int    `json:"resourceCounts"` // 종류별 리소스 수 (Pod, Deployment 등)
+	IsSystem       bool              `json:"isSystem"`       // 시스템 네임스페이스 여부 (삭제 불가)
+}
+
+// NamespaceListResponse - 네임스페이스 목록 응답
+type NamespaceListResponse struct {
+	BaseResponse                 // 익명 임베딩
+	Data         []NamespaceInfo `json:"data"`
+}
+
+// NamespaceResponse - 네임스페이스 단건 응답
+type NamespaceResponse struct {
+	BaseResponse               // 익명 임베딩
+	Data         NamespaceInfo `json:"data"`
+}
+
+// CreateNamespaceRequest - 네임스페이스 생성 요청 DTO
+type CreateNamespaceRequest struct {
+	Name        string            `json:"name" binding:"required"` // 네임스페이스 이름
+	Labels      map[string]string `json:"labels"`                  // 라벨 (선택사항)
+	Annotations map[string]string `json:"annotations"`             // 어노테이션 (선택사항)
+}
+
+// NamespaceResource - 네임스페이스에 속한 리소스
+type NamespaceResource struct {
+	Kind string `json:"kind"` // 리소스 종류
+	Name string `json:"name"` // 리소스 이름
+}
+
+// NamespaceDeletePreview - 삭제 시 함께 제거될 리소스 목록
+type NamespaceDeletePreview struct {
+	Namespace      string              `json:"namespace"`      // 삭제 대상 네임스페이스
+	Resources      []NamespaceResource `json:"resources"`      // 함께 삭제될 리소스
+	ResourceCounts map[string]int      `json:"resourceCounts"` // 종류별 리소스 수
+	ConfirmValue   string              `json:"confirmValue"`   // 삭제 시 confirm 파라미터로 보내야 하는 값
+}
+
+// NamespaceDeletePreviewResponse - 삭제 미리보기 응답
+type NamespaceDeletePreviewResponse struct {
+	BaseResponse                        // 익명 임베딩
+	Data         NamespaceDeletePreview `json:"data"`
+}
+
+// NamespaceDeleteResult - 네임스페이스 삭제 결과
+type NamespaceDeleteResult struct {
+	Namespace   string                 `json:"namespace"`   // 삭제한 네임스페이스
+	Deleted     bool                   `json:"deleted"`     // 삭제 여부
+	Preview     NamespaceDeletePreview `json:"preview"`     // 삭제 직전 리소스 목록
+	Output      string                 `json:"output"`      // kubectl 출력
+	DeletedTime string                 `json:"deletedTime"` // 삭제 시간
+}
+
+// NamespaceDeleteResponse - 네임스페이스 삭제 응답
+type NamespaceDeleteResponse struct {
+	BaseResponse                       // 익명 임베딩
+	Data         NamespaceDeleteResult `json:"data"`
+}
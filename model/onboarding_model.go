@@ -0,0 +1,54 @@
+package model
+
+// TeamOnboardingRequest - 팀 네임스페이스 온보딩 요청 DTO
+type TeamOnboardingRequest struct {
+	Team       string            `json:"team" binding:"required"`   // 팀 이름 (네임스페이스 기본값)
+	Namespace  string            `json:"namespace"`                 // 네임스페이스 (선택사항, 기본값: 팀 이름)
+	Groups     []string          `json:"groups" binding:"required"` // Role을 부여할 팀 그룹 이름
+	Quota      TeamQuota         `json:"quota"`                     // ResourceQuota 크기 (빈 값은 기본값 사용)
+	LimitRange TeamLimitRange    `json:"limitRange"`                // 컨테이너 기본 requests/limits (빈 값은 기본값 사용)
+	Labels     map[string]string `json:"labels"`                    // 네임스페이스에 추가할 라벨 (선택사항)
+	DryRun     bool              `json:"dryRun"`                    // 매니페스트만 생성하고 적용하지 않음
+
+	CreateContext    bool   `json:"createContext"`    // 네임스페이스 범위 kubeconfig context 생성 여부
+	ExportKubeconfig bool   `json:"exportKubeconfig"` // 생성한 context만 담은 kubeconfig 반환 여부
+	TokenDuration    string `json:"tokenDuration"`    // ServiceAccount 토큰 유효 기간 (기본값: 24h)
+}
+
+// TeamQuota - 팀 ResourceQuota 크기
+type TeamQuota struct {
+	RequestsCPU    string `json:"requestsCpu"`    // requests.cpu 합계 (기본값: 4)
+	RequestsMemory string `json:"requestsMemory"` // requests.memory 합계 (기본값: 8Gi)
+	LimitsCPU      string `json:"limitsCpu"`      // limits.cpu 합계 (기본값: 8)
+	LimitsMemory   string `json:"limitsMemory"`   // limits.memory 합계 (기본값: 16Gi)
+	Pods           string `json:"pods"`           // 최대 Pod 수 (기본값: 50)
+	Storage        string `json:"storage"`        // requests.storage 합계 (기본값: 100Gi)
+	PVCs           string `json:"pvcs"`           // 최대 PVC 수 (기본값: 10)
+}
+
+// TeamLimitRange - 컨테이너 기본 리소스 값
+type TeamLimitRange struct {
+	DefaultCPU           string `json:"defaultCpu"`           // 기본 limits.cpu (기본값: 500m)
+	DefaultMemory        string `json:"defaultMemory"`        // 기본 limits.memory (기본값: 512Mi)
+	DefaultRequestCPU    string `json:"defaultRequestCpu"`    // 기본 requests.cpu (기본값: 100m)
+	DefaultRequestMemory string `json:"defaultRequestMemory"` // 기본 requests.memory (기본값: 128Mi)
+}
+
+// TeamOnboardingResult - 팀 온보딩 결과
+type TeamOnboardingResult struct {
+	Team          string           `json:"team"`                  // 팀 이름
+	Namespace     string           `json:"namespace"`             // 생성/갱신한 네임스페이스
+	Manifests     string           `json:"manifests"`             // 생성된 매니페스트 (멀티 도큐먼트 YAML)
+	Resources     []string         `json:"resources"`             // 번들 리소스 목록 (kind/name)
+	ApplyResult   *ApplyYamlResult `json:"applyResult,omitempty"` // 적용 결과 (dry-run이 아닌 경우)
+	DryRun        bool             `json:"dryRun"`                // dry-run 여부
+	ContextName   string           `json:"contextName,omitempty"` // 생성한 kubeconfig context 이름
+	Kubeconfig    string           `json:"kubeconfig,omitempty"`  // 내보낸 kubeconfig (요청한 경우)
+	OnboardedTime string           `json:"onboardedTime"`         // 처리 시간
+}
+
+// TeamOnboardingResponse - 팀 온보딩 응답
+type TeamOnboardingResponse struct {
+	BaseResponse                      // 익명 임베딩
+	Data         TeamOnboardingResult `json:"data"`
+}
@@ -0,0 +1,57 @@
+package model
+
+// ClusterOverview - 컨텍스트별 클러스터 상태 요약 (랜딩 페이지용)
+type ClusterOverview struct {
+	Context              string                `json:"context"`              // 컨텍스트 이름
+	ServerVersion        string                `json:"serverVersion"`        // API 서버 버전 (예: v1.29.2)
+	Platform             string                `json:"platform"`             // 서버 플랫폼 (예: linux/amd64)
+	Nodes                NodeOverview          `json:"nodes"`                // 노드 수와 Ready 상태
+	CPU                  ResourceOverview      `json:"cpu"`                  // CPU (밀리코어)
+	Memory               ResourceOverview      `json:"memory"`               // 메모리 (바이트)
+	PodPhases            map[string]int        `json:"podPhases"`            // 전체 파드 phase별 개수
+	Namespaces           []NamespacePodSummary `json:"namespaces"`           // 네임스페이스별 파드 phase 개수
+	UnavailableWorkloads []UnavailableWorkload `json:"unavailableWorkloads"` // 사용 불가 레플리카가 있는 워크로드
+	WarningEvents        []ClusterEventMessage `json:"warningEvents"`        // 최근 Warning 이벤트 (최신 순)
+	Errors               []string              `json:"errors,omitempty"`     // 권한 부족 등으로 수집하지 못한 항목
+	CollectedTime        string                `json:"collectedTime"`        // 수집 시간
+}
+
+// NodeOverview - 노드 요약
+type NodeOverview struct {
+	Total         int      `json:"total"`         // 전체 노드 수
+	Ready         int      `json:"ready"`         // Ready 노드 수
+	NotReady      []string `json:"notReady"`      // Ready가 아닌 노드 이름
+	Unschedulable []string `json:"unschedulable"` // cordon된 노드 이름
+}
+
+// ResourceOverview - 할당 가능량 대비 요청량
+type ResourceOverview struct {
+	Allocatable      int64   `json:"allocatable"`      // 노드 할당 가능량 합계
+	Requested        int64   `json:"requested"`        // 실행 중인 파드의 요청량 합계
+	Limits           int64   `json:"limits"`           // 실행 중인 파드의 제한량 합계
+	RequestedPercent float64 `json:"requestedPercent"` // 요청량 / 할당 가능량 (%)
+	Unit             string  `json:"unit"`             // 단위 (millicores, bytes)
+}
+
+// NamespacePodSummary - 네임스페이스별 파드 phase 개수
+type NamespacePodSummary struct {
+	Namespace string         `json:"namespace"` // 네임스페이스
+	Total     int            `json:"total"`     // 전체 파드 수
+	Phases    map[string]int `json:"phases"`    // phase별 개수 (Running, Pending, Failed 등)
+}
+
+// UnavailableWorkload - 원하는 레플리카 수를 채우지 못한 워크로드
+type UnavailableWorkload struct {
+	Kind        string `json:"kind"`        // Deployment, StatefulSet, DaemonSet
+	Namespace   string `json:"namespace"`   // 네임스페이스
+	Name        string `json:"name"`        // 이름
+	Desired     int    `json:"desired"`     // 원하는 레플리카 수
+	Available   int    `json:"available"`   // 사용 가능한 레플리카 수
+	Unavailable int    `json:"unavailable"` // 사용 불가 레플리카 수
+}
+
+// ClusterOverviewResponse - 클러스터 요약 응답
+type ClusterOverviewResponse struct {
+	BaseResponse                 // 익명 임베딩
+	Data         ClusterOverview `json:"data"`
+}
@@ -0,0 +1,67 @@
+package model
+
+// 권한 매트릭스 셀 값
+const (
+	PermissionAllowed    = "allowed"    // 허용
+	PermissionRestricted = "restricted" // resourceNames로 일부 오브젝트에만 허용
+	PermissionDenied     = "denied"     // 거부
+)
+
+// PermissionVerbs - 권한 매트릭스 열 (동사) 순서
+var PermissionVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+// PermissionMatrixQuery - 권한 매트릭스 조회 조건
+type PermissionMatrixQuery struct {
+	Context   string   // kubeconfig context (비어 있으면 현재 context)
+	Namespace string   // 네임스페이스 (비어 있으면 context 기본값)
+	Resources []string // 리소스 필터 (이름, 이름.그룹, Kind, 축약 이름; 비어 있으면 전체)
+}
+
+// PermissionRow - 리소스 한 종류의 동사별 권한
+type PermissionRow struct {
+	Resource   string            `json:"resource"`   // 리소스 이름 (복수형, 예: deployments)
+	Group      string            `json:"group"`      // API 그룹 (core는 빈 값)
+	Kind       string            `json:"kind"`       // Kind (예: Deployment)
+	Namespaced bool              `json:"namespaced"` // 네임스페이스 범위 여부
+	Verbs      map[string]string `json:"verbs"`      // 동사 → allowed, restricted, denied
+}
+
+// PermissionMatrix - 현재 자격 증명의 동사 × 리소스 권한 매트릭스
+type PermissionMatrix struct {
+	Context         string          `json:"context"`                   // 대상 context
+	Namespace       string          `json:"namespace"`                 // 대상 네임스페이스
+	Verbs           []string        `json:"verbs"`                     // 열 순서
+	Rows            []PermissionRow `json:"rows"`                      // 리소스별 권한
+	Incomplete      bool            `json:"incomplete"`                // 인가 모듈이 규칙 열거를 지원하지 않아 실제보다 적게 표시될 수 있음
+	EvaluationError string          `json:"evaluationError,omitempty"` // 규칙 평가 오류
+	CheckedTime     string          `json:"checkedTime"`               // 조회 시각
+}
+
+// PermissionMatrixResponse - 권한 매트릭스 응답
+type PermissionMatrixResponse struct {
+	BaseResponse                  // 익명 임베딩
+	Data         PermissionMatrix `json:"data"`
+}
+
+// MissingPermission - 적용에 필요하지만 허용되지 않은 권한
+type MissingPermission struct {
+	Object    string `json:"object"`              // 매니페스트 오브젝트 (kind[.group]/name)
+	Namespace string `json:"namespace,omitempty"` // 네임스페이스 (클러스터 범위 리소스는 빈 값)
+	Resource  string `json:"resource"`            // 리소스 (resource[.group])
+	Verb      string `json:"verb"`                // 필요한 동사
+	Reason    string `json:"reason,omitempty"`    // 인가 모듈 사유
+}
+
+// PermissionCheck - 적용 전 권한 사전 검사 결과
+type PermissionCheck struct {
+	Context   string              `json:"context"`             // 대상 context
+	Objects   int                 `json:"objects"`             // 검사한 오브젝트 수
+	Missing   []MissingPermission `json:"missing"`             // 부족한 권한
+	Unchecked []string            `json:"unchecked,omitempty"` // 리소스 종류를 찾지 못해 검사하지 못한 오브젝트 (같은 매니페스트의 CRD 등)
+}
+
+// PermissionCheckResponse - 권한 부족으로 적용이 차단되었을 때의 응답
+type PermissionCheckResponse struct {
+	BaseResponse                 // 익명 임베딩
+	Data         PermissionCheck `json:"data"`
+}
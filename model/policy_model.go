@@ -0,0 +1,77 @@
+package model
+
+// 정책 모드 상수
+const (
+	PolicyModeOff  = "off"  // 검사하지 않음
+	PolicyModeWarn = "warn" // 경고만 반환하고 적용은 계속
+	PolicyModeDeny = "deny" // 위반 시 적용 차단
+)
+
+// 정책 규칙 이름 상수
+const (
+	PolicyRuleNoPrivileged      = "no-privileged"      // privileged 컨테이너 금지
+	PolicyRuleNoHostPath        = "no-host-path"       // hostPath 볼륨 금지
+	PolicyRuleNoHostNetwork     = "no-host-network"    // hostNetwork 금지
+	PolicyRuleNoLatestTag       = "no-latest-tag"      // :latest(또는 태그 없음) 이미지 금지
+	PolicyRuleRequireResources  = "require-resources"  // requests/limits 필수
+	PolicyRuleRequiredLabels    = "required-labels"    // 필수 라벨
+	PolicyRuleAllowedRegistries = "allowed-registries" // 허용된 이미지 레지스트리
+)
+
+// PolicyConfig - 정책 설정 (파일로 저장)
+type PolicyConfig struct {
+	Default   PolicyProfile    `json:"default" yaml:"default"`     // 기본 정책
+	Overrides []PolicyOverride `json:"overrides" yaml:"overrides"` // context/namespace별 재정의 (순서대로 덮어씀)
+}
+
+// PolicyProfile - 정책 규칙 묶음
+type PolicyProfile struct {
+	Rules             map[string]string `json:"rules" yaml:"rules"`                         // 규칙 이름 → 모드 (off, warn, deny)
+	RequiredLabels    []string          `json:"requiredLabels" yaml:"requiredLabels"`       // 필수 라벨 키 목록
+	AllowedRegistries []string          `json:"allowedRegistries" yaml:"allowedRegistries"` // 허용 레지스트리 접두사 목록
+}
+
+// PolicyOverride - 특정 context/namespace에 적용되는 정책 재정의
+type PolicyOverride struct {
+	Context   string        `json:"context" yaml:"context"`     // 대상 context (비어있으면 전체)
+	Namespace string        `json:"namespace" yaml:"namespace"` // 대상 네임스페이스 (비어있으면 전체)
+	Profile   PolicyProfile `json:"profile" yaml:"profile"`     // 재정의할 정책 (지정한 항목만 덮어씀)
+}
+
+// PolicyFinding - 정책 위반 항목
+type PolicyFinding struct {
+	DocumentIndex int    `json:"documentIndex"` // YAML 도큐먼트 인덱스 (0부터)
+	Kind          string `json:"kind"`          // 리소스 종류
+	Name          string `json:"name"`          // 리소스 이름
+	Namespace     string `json:"namespace"`     // 네임스페이스
+	Rule          string `json:"rule"`          // 위반한 규칙
+	Mode          string `json:"mode"`          // warn 또는 deny
+	FieldPath     string `json:"fieldPath"`     // 위반 필드 경로 (예: spec.containers[0].image)
+	Message       string `json:"message"`       // 설명
+}
+
+// PolicyEvaluateRequest - 정책 평가 요청 DTO
+type PolicyEvaluateRequest struct {
+	YamlContent string `json:"yamlContent" binding:"required"` // YAML 내용
+	Namespace   string `json:"namespace"`                      // 네임스페이스 (선택사항)
+	Context     string `json:"context"`                        // context (선택사항, 없으면 현재 context)
+}
+
+// PolicyEvaluation - 정책 평가 결과
+type PolicyEvaluation struct {
+	Context  string          `json:"context"`  // 평가에 사용된 context
+	Allowed  bool            `json:"allowed"`  // deny 위반이 없으면 true
+	Findings []PolicyFinding `json:"findings"` // 위반 항목 목록
+}
+
+// PolicyEvaluationResponse - 정책 평가 응답
+type PolicyEvaluationResponse struct {
+	BaseResponse                  // 익명 임베딩
+	Data         PolicyEvaluation `json:"data"`
+}
+
+// PolicyConfigResponse - 정책 설정 조회 응답
+type PolicyConfigResponse struct {
+	BaseResponse              // 익명 임베딩
+	Data         PolicyConfig `json:"data"`
+}
@@ -0,0 +1,46 @@
+package model
+
+// 포트포워드 세션 상태
+const (
+	PortForwardStatusActive  = "active"  // 포워딩 중
+	PortForwardStatusStopped = "stopped" // 사용자 요청으로 중지
+	PortForwardStatusExpired = "expired" // 유휴 시간 초과로 만료
+	PortForwardStatusFailed  = "failed"  // kubectl 프로세스 비정상 종료
+)
+
+// PortForwardRequest - 포트포워드 시작 요청
+type PortForwardRequest struct {
+	Namespace string `json:"namespace"` // 네임스페이스 (기본값: default)
+	Kind      string `json:"kind"`      // 대상 종류 (pod 또는 service, 기본값: service)
+	Name      string `json:"name"`      // 대상 이름
+	Port      int    `json:"port"`      // 대상의 HTTP 포트
+	TTL       string `json:"ttl"`       // 마지막 사용 후 자동 만료까지의 시간 (예: 30m, 기본값: 30m)
+}
+
+// PortForwardSession - 관리되는 포트포워드 세션
+type PortForwardSession struct {
+	ID           string `json:"id"`           // 세션 ID
+	Namespace    string `json:"namespace"`    // 네임스페이스
+	Kind         string `json:"kind"`         // 대상 종류 (pod, service)
+	Name         string `json:"name"`         // 대상 이름
+	RemotePort   int    `json:"remotePort"`   // 대상 포트
+	LocalPort    int    `json:"localPort"`    // 서버 로컬 포트 (127.0.0.1)
+	ProxyPath    string `json:"proxyPath"`    // 프록시 경로 (예: /api/portforwards/{id}/proxy/)
+	Status       string `json:"status"`       // 세션 상태
+	TTL          string `json:"ttl"`          // 유휴 만료 시간
+	CreatedTime  string `json:"createdTime"`  // 생성 시간
+	LastUsedTime string `json:"lastUsedTime"` // 마지막 프록시 사용 시간
+	ExpiresTime  string `json:"expiresTime"`  // 만료 예정 시간
+}
+
+// PortForwardResponse - 포트포워드 세션 응답
+type PortForwardResponse struct {
+	BaseResponse                    // 익명 임베딩
+	Data         PortForwardSession `json:"data"`
+}
+
+// PortForwardListResponse - 포트포워드 세션 목록 응답
+type PortForwardListResponse struct {
+	BaseResponse                      // 익명 임베딩
+	Data         []PortForwardSession `json:"data"`
+}
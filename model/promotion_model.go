@@ -0,0 +1,47 @@
+package model
+
+// PromotionTransform - 승격 시 적용할 변환
+type PromotionTransform struct {
+	Images       map[string]string `json:"images"`       // 이미지 저장소 → 태그 (예: "registry.io/team/web": "v1.4.0", 저장소 마지막 이름만 써도 됨)
+	Replicas     map[string]int    `json:"replicas"`     // 워크로드 이름 또는 kind/name → 레플리카 수
+	SetLabels    map[string]string `json:"setLabels"`    // 추가/변경할 metadata.labels
+	RemoveLabels []string          `json:"removeLabels"` // 제거할 metadata.labels 키
+}
+
+// PromotionRequest - 컨텍스트 간 리소스 복사/승격 요청 DTO
+type PromotionRequest struct {
+	SourceContext   string             `json:"sourceContext"`   // 원본 context (비어 있으면 현재 context)
+	SourceNamespace string             `json:"sourceNamespace"` // 원본 네임스페이스 (기본값: default)
+	Resources       []string           `json:"resources"`       // 대상 리소스 (kind/name, 비어 있으면 labelSelector 또는 네임스페이스 전체)
+	LabelSelector   string             `json:"labelSelector"`   // 라벨 셀렉터
+	Kinds           []string           `json:"kinds"`           // 셀렉터/네임스페이스 전체 복사 시 대상 종류
+	TargetContext   string             `json:"targetContext"`   // 대상 context (비어 있으면 현재 context)
+	TargetNamespace string             `json:"targetNamespace"` // 대상 네임스페이스 (비어 있으면 원본과 동일, 다르면 네임스페이스 변경)
+	Transform       PromotionTransform `json:"transform"`       // 변환 옵션
+	CreateNamespace bool               `json:"createNamespace"` // 대상 네임스페이스가 없으면 생성
+	Apply           bool               `json:"apply"`           // true일 때만 실제 적용 (기본값: diff 미리보기와 dry-run만 수행)
+}
+
+// PromotionResult - 승격 결과
+type PromotionResult struct {
+	SourceContext    string           `json:"sourceContext"`          // 원본 context
+	SourceNamespace  string           `json:"sourceNamespace"`        // 원본 네임스페이스
+	TargetContext    string           `json:"targetContext"`          // 대상 context
+	TargetNamespace  string           `json:"targetNamespace"`        // 대상 네임스페이스
+	Resources        []string         `json:"resources"`              // 복사할 리소스 (kind/name)
+	Skipped          []string         `json:"skipped"`                // 제외된 리소스와 사유
+	Changes          []string         `json:"changes"`                // 적용된 변환 내역
+	YamlContent      string           `json:"yamlContent"`            // 변환된 YAML
+	Diff             string           `json:"diff"`                   // 대상 클러스터 현재 상태와의 차이 (kubectl diff)
+	HasChanges       bool             `json:"hasChanges"`             // 대상과 차이가 있는지 여부
+	NamespaceMissing bool             `json:"namespaceMissing"`       // 대상 네임스페이스가 없어 모든 리소스가 새로 생성됨
+	DryRun           *ApplyYamlResult `json:"dryRun"`                 // dry-run 결과 (정책 검사 포함)
+	Applied          *ApplyYamlResult `json:"applied,omitempty"`      // 실제 적용 결과 (apply=true 인 경우)
+	PromotedTime     string           `json:"promotedTime,omitempty"` // 적용 시간
+}
+
+// PromotionResponse - 승격 응답
+type PromotionResponse struct {
+	BaseResponse                 // 익명 임베딩
+	Data         PromotionResult `json:"data"`
+}
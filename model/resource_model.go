@@ -0,0 +1,67 @@
+package model
+
+// ResourceKind - 클러스터에서 발견된 리소스 종류 (kubectl api-resources)
+type ResourceKind struct {
+	Name       string   `json:"name"`       // 리소스 이름 (복수형, 예: deployments)
+	ShortNames []string `json:"shortNames"` // 축약 이름 (예: deploy)
+	APIVersion string   `json:"apiVersion"` // API 버전 (예: apps/v1)
+	Namespaced bool     `json:"namespaced"` // 네임스페이스 범위 여부
+	Kind       string   `json:"kind"`       // Kind (예: Deployment)
+}
+
+// ResourceKindsResponse - 리소스 종류 목록 응답
+type ResourceKindsResponse struct {
+	BaseResponse                // 익명 임베딩
+	Data         []ResourceKind `json:"data"`
+}
+
+// ResourceListQuery - 리소스 목록 조회 조건
+type ResourceListQuery struct {
+	Context       string // 대상 context (비어 있으면 현재 context)
+	Kind          string // 리소스 종류 (이름, 축약 이름, Kind 모두 허용)
+	Namespace     string // 네임스페이스 (비어 있으면 전체)
+	LabelSelector string // 라벨 셀렉터 (예: app=web,tier!=db)
+	FieldSelector string // 필드 셀렉터 (예: status.phase=Running)
+	Limit         int    // 페이지 크기 (0이면 전체)
+	Continue      string // 다음 페이지 토큰
+}
+
+// ResourceSummary - 테이블 표시용 리소스 요약
+type ResourceSummary struct {
+	Name        string            `json:"name"`        // 리소스 이름
+	Namespace   string            `json:"namespace"`   // 네임스페이스 (클러스터 범위면 빈 값)
+	Kind        string            `json:"kind"`        // Kind
+	Status      string            `json:"status"`      // 종류별 요약 상태 (예: Running, 2/3 Ready)
+	Details     map[string]string `json:"details"`     // 종류별 추가 컬럼 (예: restarts, node, clusterIP)
+	Labels      map[string]string `json:"labels"`      // 라벨
+	CreatedTime string            `json:"createdTime"` // 생성 시간
+	Age         string            `json:"age"`         // 생성 후 경과 시간 (예: 3d4h)
+}
+
+// ResourceListResult - 리소스 목록 조회 결과
+type ResourceListResult struct {
+	Kind               string            `json:"kind"`                         // Kind
+	APIVersion         string            `json:"apiVersion"`                   // API 버전
+	Namespace          string            `json:"namespace"`                    // 조회한 네임스페이스 (전체면 빈 값)
+	Items              []ResourceSummary `json:"items"`                        // 리소스 요약 목록
+	Continue           string            `json:"continue,omitempty"`           // 다음 페이지 토큰
+	RemainingItemCount *int64            `json:"remainingItemCount,omitempty"` // 남은 항목 수 (서버가 알려준 경우)
+}
+
+// ResourceListResponse - 리소스 목록 응답
+type ResourceListResponse struct {
+	BaseResponse                    // 익명 임베딩
+	Data         ResourceListResult `json:"data"`
+}
+
+// ResourceDetail - 리소스 단건 조회 결과
+type ResourceDetail struct {
+	Summary ResourceSummary        `json:"summary"` // 요약
+	Object  map[string]interface{} `json:"object"`  // 전체 오브젝트
+}
+
+// ResourceDetailResponse - 리소스 단건 응답
+type ResourceDetailResponse struct {
+	BaseResponse                // 익명 임베딩
+	Data         ResourceDetail `json:"data"`
+}
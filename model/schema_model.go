@@ -0,0 +1,37 @@
+package model
+
+// SchemaValidateRequest - 스키마 검증 요청 DTO
+type SchemaValidateRequest struct {
+	YamlContent       string `json:"yamlContent" binding:"required"` // 검증할 YAML 내용
+	KubernetesVersion string `json:"kubernetesVersion"`              // 대상 서버 버전 (예: "1.29", 선택사항)
+	CRDContent        string `json:"crdContent"`                     // 추가 CRD 정의 YAML (선택사항)
+}
+
+// SchemaValidationError - 스키마 검증 오류 항목
+type SchemaValidationError struct {
+	DocumentIndex int    `json:"documentIndex"` // 멀티 도큐먼트 내 순서 (0부터)
+	APIVersion    string `json:"apiVersion"`    // 리소스 apiVersion
+	Kind          string `json:"kind"`          // 리소스 종류
+	Name          string `json:"name"`          // 리소스 이름
+	FieldPath     string `json:"fieldPath"`     // 문제가 된 필드 경로 (예: spec.replicas)
+	Message       string `json:"message"`       // 오류 설명
+}
+
+// SchemaValidationResult - 스키마 검증 결과
+type SchemaValidationResult struct {
+	KubernetesVersion string                  `json:"kubernetesVersion"` // 검증에 사용한 서버 버전
+	IsValid           bool                    `json:"isValid"`           // 오류가 없으면 true
+	DocumentCount     int                     `json:"documentCount"`     // 검증한 도큐먼트 수
+	Errors            []SchemaValidationError `json:"errors"`            // 스키마 오류 목록
+	Warnings          []SchemaValidationError `json:"warnings"`          // 스키마를 찾지 못한 리소스 등 경고
+	CheckedTime       string                  `json:"checkedTime"`       // 검증 시간
+
+	Partial bool   `json:"partial"`          // 번들 스키마(세부 구조 일부 미정의)로 검증한 도큐먼트가 있으면 true
+	Notice  string `json:"notice,omitempty"` // 부분 검증 안내
+}
+
+// SchemaValidationResponse - 스키마 검증 응답
+type SchemaValidationResponse struct {
+	BaseResponse                        // 익명 임베딩
+	Data         SchemaValidationResult `json:"data"`
+}
@@ -0,0 +1,62 @@
+package model
+
+// Secret 생성 유형
+const (
+	SecretTypeGeneric        = "generic"         // Opaque (리터럴/파일)
+	SecretTypeDockerRegistry = "docker-registry" // kubernetes.io/dockerconfigjson
+	SecretTypeTLS            = "tls"             // kubernetes.io/tls (인증서/키 PEM 쌍)
+)
+
+// SecretRequest - Secret 생성 요청 DTO (값은 서버에서 base64 인코딩, 응답/로그에 남기지 않음)
+type SecretRequest struct {
+	Name        string            `json:"name" binding:"required"` // Secret 이름
+	Namespace   string            `json:"namespace"`               // 네임스페이스 (기본값: default)
+	Context     string            `json:"context"`                 // 적용할 kubeconfig context (선택사항)
+	Type        string            `json:"type"`                    // generic(기본값), docker-registry, tls
+	Labels      map[string]string `json:"labels"`                  // metadata.labels (선택사항)
+	Annotations map[string]string `json:"annotations"`             // metadata.annotations (선택사항)
+	DryRun      bool              `json:"dryRun"`                  // dry-run 모드 (선택사항)
+
+	// generic
+	Literals map[string]string `json:"literals"` // 키 → 평문 값
+	Files    map[string][]byte `json:"files"`    // 키 → 파일 내용 (JSON에서는 base64, multipart에서는 업로드 파일)
+
+	// docker-registry
+	DockerServer   string `json:"dockerServer"`   // 레지스트리 주소 (기본값: https://index.docker.io/v1/)
+	DockerUsername string `json:"dockerUsername"` // 사용자 이름
+	DockerPassword string `json:"dockerPassword"` // 비밀번호 또는 토큰
+	DockerEmail    string `json:"dockerEmail"`    // 이메일 (선택사항)
+
+	// tls
+	TLSCert string `json:"tlsCert"` // 인증서 PEM (체인 포함 가능)
+	TLSKey  string `json:"tlsKey"`  // 개인 키 PEM
+}
+
+// SecretCertificateInfo - TLS Secret 인증서 정보 (공개 정보만)
+type SecretCertificateInfo struct {
+	Subject   string   `json:"subject"`   // 주체
+	Issuer    string   `json:"issuer"`    // 발급자
+	DNSNames  []string `json:"dnsNames"`  // SAN DNS 이름
+	NotBefore string   `json:"notBefore"` // 유효 시작
+	NotAfter  string   `json:"notAfter"`  // 만료 시간
+	Expired   bool     `json:"expired"`   // 만료 여부
+}
+
+// SecretResult - Secret 생성 결과 (키 이름과 크기만 포함, 값은 포함하지 않음)
+type SecretResult struct {
+	Name         string                 `json:"name"`                  // Secret 이름
+	Namespace    string                 `json:"namespace"`             // 네임스페이스
+	Type         string                 `json:"type"`                  // Secret type (Opaque, kubernetes.io/tls 등)
+	Keys         []string               `json:"keys"`                  // data 키 목록
+	Sizes        map[string]int         `json:"sizes"`                 // 키 → 값 크기 (바이트)
+	RedactedYaml string                 `json:"redactedYaml"`          // 값을 가린 매니페스트 미리보기
+	Certificate  *SecretCertificateInfo `json:"certificate,omitempty"` // TLS 인증서 정보
+	Warnings     []string               `json:"warnings,omitempty"`    // 경고 (만료된 인증서 등)
+	ApplyResult  *ApplyYamlResult       `json:"applyResult"`           // 적용 결과
+}
+
+// SecretResponse - Secret 생성 응답
+type SecretResponse struct {
+	BaseResponse              // 익명 임베딩
+	Data         SecretResult `json:"data"`
+}
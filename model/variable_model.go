@@ -0,0 +1,32 @@
+package model
+
+// EnvironmentConfig - 환경별 변수 세트 (파일로 저장)
+type EnvironmentConfig struct {
+	Environments map[string]map[string]string `json:"environments" yaml:"environments"` // 환경 이름 → 변수 이름 → 값
+}
+
+// EnvironmentConfigResponse - 환경 변수 세트 조회 응답
+type EnvironmentConfigResponse struct {
+	BaseResponse                   // 익명 임베딩
+	Data         EnvironmentConfig `json:"data"`
+}
+
+// SubstituteRequest - 변수 치환 미리보기 요청 DTO
+type SubstituteRequest struct {
+	YamlContent string            `json:"yamlContent" binding:"required"` // 치환할 YAML 내용
+	Environment string            `json:"environment"`                    // 사용할 환경 이름 (선택사항)
+	Variables   map[string]string `json:"variables"`                      // 직접 지정한 변수 (환경 값보다 우선)
+}
+
+// SubstituteResult - 변수 치환 결과
+type SubstituteResult struct {
+	YamlContent       string            `json:"yamlContent"`       // 치환된 YAML 내용
+	Environment       string            `json:"environment"`       // 사용한 환경 이름
+	ResolvedVariables map[string]string `json:"resolvedVariables"` // 실제 사용된 변수와 값
+}
+
+// SubstituteResponse - 변수 치환 미리보기 응답
+type SubstituteResponse struct {
+	BaseResponse                  // 익명 임베딩
+	Data         SubstituteResult `json:"data"`
+}
@@ -0,0 +1,66 @@
+package model
+
+// 워크로드 작업 종류
+const (
+	WorkloadOperationScale   = "scale"
+	WorkloadOperationRestart = "restart"
+	WorkloadOperationPause   = "pause"
+	WorkloadOperationResume  = "resume"
+	WorkloadOperationUndo    = "undo"
+)
+
+// WorkloadOperationRequest - 워크로드 작업 요청 DTO
+type WorkloadOperationRequest struct {
+	Namespace string `json:"namespace"` // 네임스페이스 (기본값: default)
+	Replicas  *int   `json:"replicas"`  // scale: 목표 레플리카 수
+	Revision  int    `json:"revision"`  // undo: 되돌릴 리비전 (0이면 직전 리비전)
+}
+
+// WorkloadOperation - 워크로드 작업 결과 (작업 로그 항목)
+type WorkloadOperation struct {
+	ID               string `json:"id"`                         // 작업 ID
+	Operation        string `json:"operation"`                  // scale, restart, pause, resume, undo
+	Kind             string `json:"kind"`                       // Deployment, StatefulSet, DaemonSet
+	Name             string `json:"name"`                       // 워크로드 이름
+	Namespace        string `json:"namespace"`                  // 네임스페이스
+	Success          bool   `json:"success"`                    // 성공 여부
+	Output           string `json:"output"`                     // kubectl 출력
+	Error            string `json:"error,omitempty"`            // 실패 사유
+	PreviousReplicas *int   `json:"previousReplicas,omitempty"` // scale: 변경 전 레플리카 수
+	Replicas         *int   `json:"replicas,omitempty"`         // scale: 변경 후 레플리카 수
+	Revision         int    `json:"revision,omitempty"`         // undo: 대상 리비전
+	ExecutedTime     string `json:"executedTime"`               // 실행 시간
+}
+
+// WorkloadOperationResponse - 워크로드 작업 응답
+type WorkloadOperationResponse struct {
+	BaseResponse                   // 익명 임베딩
+	Data         WorkloadOperation `json:"data"`
+}
+
+// WorkloadOperationListResponse - 작업 로그 응답
+type WorkloadOperationListResponse struct {
+	BaseResponse                     // 익명 임베딩
+	Data         []WorkloadOperation `json:"data"`
+}
+
+// RolloutRevision - 롤아웃 리비전
+type RolloutRevision struct {
+	Revision    int    `json:"revision"`    // 리비전 번호
+	ChangeCause string `json:"changeCause"` // kubernetes.io/change-cause 어노테이션 (없으면 빈 값)
+	Current     bool   `json:"current"`     // 현재 리비전 여부
+}
+
+// RolloutHistory - 워크로드 롤아웃 이력
+type RolloutHistory struct {
+	Kind      string            `json:"kind"`      // 워크로드 Kind
+	Name      string            `json:"name"`      // 워크로드 이름
+	Namespace string            `json:"namespace"` // 네임스페이스
+	Revisions []RolloutRevision `json:"revisions"` // 리비전 목록 (오름차순)
+}
+
+// RolloutHistoryResponse - 롤아웃 이력 응답
+type RolloutHistoryResponse struct {
+	BaseResponse                // 익명 임베딩
+	Data         RolloutHistory `json:"data"`
+}
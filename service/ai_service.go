@@ -2,6 +2,7 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -19,21 +20,28 @@ type AIService struct {
 	baseURL     string
 	httpClient  *http.Client
 	kubeService *KubeService
+	executor    utils.CommandExecutor
 }
 
 // NewAIService - AI 서비스 생성자
 func NewAIService(deepseekURL string) *AIService {
+	return NewAIServiceWithExecutor(deepseekURL, utils.NewSystemExecutor())
+}
+
+// NewAIServiceWithExecutor - 지정한 명령 실행기를 사용하는 AI 서비스 생성자
+func NewAIServiceWithExecutor(deepseekURL string, executor utils.CommandExecutor) *AIService {
 	return &AIService{
 		baseURL: deepseekURL,
 		httpClient: &http.Client{
 			Timeout: 120 * time.Second,
 		},
-		kubeService: NewKubeService(),
+		kubeService: NewKubeServiceWithExecutor(executor),
+		executor:    executor,
 	}
 }
 
 // GenerateKubernetesYaml - AI에게 Kubernetes YAML 생성 요청
-func (ai *AIService) GenerateKubernetesYaml(request model.AIYamlRequest) (*model.AIYamlResponse, error) {
+func (ai *AIService) GenerateKubernetesYaml(ctx context.Context, request model.AIYamlRequest) (*model.AIYamlResponse, error) {
 	log.Printf("🤖 AI YAML 생성 요청: %s", request.Prompt)
 
 	// AI 프롬프트 구성
@@ -66,7 +74,7 @@ Rules:
 	}
 
 	// AI API 호출
-	yamlContent, err := ai.callDeepSeekAPI(aiRequest)
+	yamlContent, err := ai.callDeepSeekAPI(ctx, aiRequest)
 	if err != nil {
 		return nil, fmt.Errorf("AI API 호출 실패: %v", err)
 	}
@@ -74,10 +82,13 @@ Rules:
 	// YAML 내용 정제
 	cleanYaml := ai.cleanYamlContent(yamlContent)
 
-	// YAML 유효성 검증
-	if err := ai.kubeService.ValidateYaml(cleanYaml); err != nil {
+	// YAML 구문 및 스키마 검증 (결과는 응답에 포함, 적용 여부는 호출자가 판단)
+	validation, err := ai.kubeService.ValidateSchema(cleanYaml, request.KubernetesVersion, "")
+	if err != nil {
 		log.Printf("⚠️ AI가 생성한 YAML이 유효하지 않음: %v", err)
 		// 재시도 로직 또는 기본 템플릿 사용 가능
+	} else if !validation.IsValid {
+		log.Printf("⚠️ AI가 생성한 YAML 스키마 오류: %d건", len(validation.Errors))
 	}
 
 	response := &model.AIYamlResponse{
@@ -90,6 +101,7 @@ Rules:
 			Prompt:        request.Prompt,
 			GeneratedTime: time.Now().Format("2006-01-02 15:04:05"),
 			Source:        "DeepSeek Coder",
+			Validation:    validation,
 		},
 	}
 
@@ -97,8 +109,26 @@ Rules:
 	return response, nil
 }
 
+// AI 생성 및 적용 작업 단계 (작업 진행 상황 항목 이름)
+const (
+	aiStepGenerate = "YAML 생성"
+	aiStepValidate = "스키마 검증"
+	aiStepApply    = "클러스터 적용"
+	aiStepDelete   = "리소스 삭제"
+)
+
 // GenerateAndApplyYaml - AI로 YAML 생성 후 바로 적용
-func (ai *AIService) GenerateAndApplyYaml(request model.AIApplyRequest) (*model.AIApplyResponse, error) {
+func (ai *AIService) GenerateAndApplyYaml(ctx context.Context, request model.AIApplyRequest) (*model.AIApplyResponse, error) {
+	return ai.generateAndApplyYaml(ctx, nil, request)
+}
+
+// GenerateAndApplyYamlJob - AI YAML 생성 및 적용을 작업으로 실행 (생성/검증/적용 단계별 진행 상황 보고)
+func (ai *AIService) GenerateAndApplyYamlJob(run *JobRun, request model.AIApplyRequest) (*model.AIApplyResponse, error) {
+	return ai.generateAndApplyYaml(run.Context(), run, request)
+}
+
+// generateAndApplyYaml - 생성 → 스키마 검증 → 적용 (run이 있으면 단계별 진행 상황 보고, 취소 시 다음 단계 중단)
+func (ai *AIService) generateAndApplyYaml(ctx context.Context, run *JobRun, request model.AIApplyRequest) (*model.AIApplyResponse, error) {
 	log.Printf("🚀 AI YAML 생성 및 적용 요청: %s", request.Prompt)
 
 	// 🆕 삭제 명령어 감지 로직 추가
@@ -114,17 +144,49 @@ func (ai *AIService) GenerateAndApplyYaml(request model.AIApplyRequest) (*model.
 	// 🆕 삭제 명령어라면 별도 처리
 	if isDeleteCommand {
 		log.Printf("🗑️ 삭제 명령어 감지됨: %s", request.Prompt)
-		return ai.HandleDeleteCommand(request)
+		run.SetTotal(1)
+		run.StartItem(aiStepDelete)
+		response, err := ai.HandleDeleteCommand(ctx, request)
+		run.FinishItem(aiStepDelete, err)
+		return response, err
 	}
+	run.SetTotal(3)
 
 	// 1단계: AI로 YAML 생성
 	yamlRequest := model.AIYamlRequest{
-		Prompt: request.Prompt,
+		Prompt:            request.Prompt,
+		KubernetesVersion: request.KubernetesVersion,
+	}
+
+	run.StartItem(aiStepGenerate)
+	yamlResponse, err := ai.GenerateKubernetesYaml(ctx, yamlRequest)
+	if err != nil {
+		err = fmt.Errorf("AI YAML 생성 실패: %v", err)
+		run.FinishItem(aiStepGenerate, err)
+		return nil, err
+	}
+	run.FinishItem(aiStepGenerate, nil)
+	run.Logf("생성된 YAML:\n%s", yamlResponse.Data.GeneratedYaml)
+	if run.Cancelled() {
+		return nil, run.Context().Err()
 	}
 
-	yamlResponse, err := ai.GenerateKubernetesYaml(yamlRequest)
+	// 스키마 오류가 있으면 클러스터에 적용하지 않음
+	run.StartItem(aiStepValidate)
+	validation, err := ai.kubeService.ValidateSchema(yamlResponse.Data.GeneratedYaml, request.KubernetesVersion, "")
 	if err != nil {
-		return nil, fmt.Errorf("AI YAML 생성 실패: %v", err)
+		err = fmt.Errorf("생성된 YAML 검증 실패: %v", err)
+		run.FinishItem(aiStepValidate, err)
+		return nil, err
+	}
+	if !validation.IsValid {
+		err := &SchemaValidationFailedError{Result: validation}
+		run.FinishItem(aiStepValidate, err)
+		return nil, err
+	}
+	run.FinishItem(aiStepValidate, nil)
+	if run.Cancelled() {
+		return nil, run.Context().Err()
 	}
 
 	// 2단계: 생성된 YAML 적용
@@ -134,10 +196,14 @@ func (ai *AIService) GenerateAndApplyYaml(request model.AIApplyRequest) (*model.
 		DryRun:      request.DryRun,
 	}
 
-	applyResult, err := ai.kubeService.ApplyYaml(applyRequest)
+	run.StartItem(aiStepApply)
+	applyResult, err := ai.kubeService.ApplyYaml(ctx, applyRequest)
 	if err != nil {
-		return nil, fmt.Errorf("YAML 적용 실패: %v", err)
+		run.FinishItem(aiStepApply, err)
+		return nil, fmt.Errorf("YAML 적용 실패: %w", err)
 	}
+	run.FinishItem(aiStepApply, nil)
+	run.Logf("적용된 리소스: %s", strings.Join(applyResult.Resources, ", "))
 
 	// 응답 구성
 	response := &model.AIApplyResponse{
@@ -151,6 +217,7 @@ func (ai *AIService) GenerateAndApplyYaml(request model.AIApplyRequest) (*model.
 			Prompt:        request.Prompt,
 			GeneratedTime: yamlResponse.Data.GeneratedTime,
 			Source:        "DeepSeek Coder",
+			Validation:    validation,
 		},
 	}
 
@@ -164,7 +231,7 @@ func (ai *AIService) GenerateAndApplyYaml(request model.AIApplyRequest) (*model.
 }
 
 // QueryKubernetesAI - Kubernetes 관련 질문을 AI에게 물어보기
-func (ai *AIService) QueryKubernetesAI(request model.AIQueryRequest) (*model.AIQueryResponse, error) {
+func (ai *AIService) QueryKubernetesAI(ctx context.Context, request model.AIQueryRequest) (*model.AIQueryResponse, error) {
 	log.Printf("💬 AI 쿠버네티스 질문: %s", request.Question)
 	// 현재 클러스터 정보 수집 (타임아웃 방지를 위해 간소화)
 	var currentContext string
@@ -172,16 +239,16 @@ func (ai *AIService) QueryKubernetesAI(request model.AIQueryRequest) (*model.AIQ
 	// 컨텍스트 조회를 고루틴으로 처리하여 타임아웃 방지
 	contextChan := make(chan string, 1)
 	go func() {
-		contexts, err := ai.kubeService.GetContexts()
+		contexts, err := ai.kubeService.GetContexts(ctx)
 		if err != nil {
 			log.Printf("⚠️ 컨텍스트 조회 실패 (무시하고 계속): %v", err)
 			contextChan <- "unknown"
 			return
 		}
 
-		for _, ctx := range contexts {
-			if ctx.IsCurrent {
-				contextChan <- ctx.Name
+		for _, contextInfo := range contexts {
+			if contextInfo.IsCurrent {
+				contextChan <- contextInfo.Name
 				return
 			}
 		}
@@ -221,7 +288,7 @@ Provide practical, actionable advice with examples when helpful.`
 
 	// AI API 호출
 	log.Printf("🌐 AI API 질문 요청 시작...")
-	answer, err := ai.callDeepSeekAPI(aiRequest)
+	answer, err := ai.callDeepSeekAPI(ctx, aiRequest)
 	if err != nil {
 		return nil, fmt.Errorf("AI API 호출 실패: %v", err)
 	}
@@ -245,8 +312,8 @@ Provide practical, actionable advice with examples when helpful.`
 	return response, nil
 }
 
-// callDeepSeekAPI - DeepSeek API 실제 호출
-func (ai *AIService) callDeepSeekAPI(request model.DeepSeekRequest) (string, error) {
+// callDeepSeekAPI - DeepSeek API 실제 호출 (ctx가 취소되면 모델 호출도 중단)
+func (ai *AIService) callDeepSeekAPI(ctx context.Context, request model.DeepSeekRequest) (string, error) {
 	// JSON 요청 생성
 	jsonData, err := json.Marshal(request)
 	if err != nil {
@@ -255,7 +322,7 @@ func (ai *AIService) callDeepSeekAPI(request model.DeepSeekRequest) (string, err
 
 	// HTTP 요청 생성
 	url := fmt.Sprintf("%s/v1/chat/completions", ai.baseURL)
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return "", fmt.Errorf("HTTP 요청 생성 실패: %v", err)
 	}
@@ -353,7 +420,10 @@ func (ai *AIService) CheckDeepSeekConnection() error {
 }
 
 // 🆕 HandleDeleteCommand - 삭제 명령어 처리 (새로 추가된 함수)
-func (ai *AIService) HandleDeleteCommand(request model.AIApplyRequest) (*model.AIApplyResponse, error) {
+func (ai *AIService) HandleDeleteCommand(ctx context.Context, request model.AIApplyRequest) (*model.AIApplyResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.ApplyCommandTimeout)
+	defer cancel()
+
 	log.Printf("🗑️ AI 삭제 명령어 처리 시작: %s", request.Prompt)
 
 	// AI에게 삭제할 리소스 파악 요청
@@ -387,7 +457,7 @@ Rules:
 	}
 
 	// AI API 호출
-	resourceList, err := ai.callDeepSeekAPI(aiRequest)
+	resourceList, err := ai.callDeepSeekAPI(ctx, aiRequest)
 	if err != nil {
 		return nil, fmt.Errorf("AI API 호출 실패: %v", err)
 	}
@@ -419,7 +489,7 @@ Rules:
 		}
 
 		// kubectl 명령 실행
-		result, err := utils.ExecuteCommand("kubectl", cmd...)
+		result, err := ai.executor.Execute(ctx, "kubectl", cmd...)
 		if err != nil {
 			deleteResults = append(deleteResults, fmt.Sprintf("❌ %s: %v", resource, err))
 			log.Printf("❌ 삭제 실패 %s: %v", resource, err)
@@ -455,12 +525,12 @@ Rules:
 }
 
 // CallDeepSeekAPI - 외부에서 호출 가능한 DeepSeek API 메서드 (Git Controller에서 사용)
-func (ai *AIService) CallDeepSeekAPI(request model.DeepSeekRequest) (string, error) {
-	return ai.callDeepSeekAPI(request)
+func (ai *AIService) CallDeepSeekAPI(ctx context.Context, request model.DeepSeekRequest) (string, error) {
+	return ai.callDeepSeekAPI(ctx, request)
 }
 
 // ProcessGitPrompt - Git 관련 프롬프트 처리 (개선된 버전)
-func (ai *AIService) ProcessGitPrompt(prompt string) (*model.AIGitResponse, error) {
+func (ai *AIService) ProcessGitPrompt(ctx context.Context, prompt string) (*model.AIGitResponse, error) {
 	log.Printf("🤖 Git 프롬프트 처리: %s", prompt)
 
 	// Git 관련 키워드 감지
@@ -523,7 +593,7 @@ Rules:
 	}
 
 	// AI API 호출
-	response, err := ai.callDeepSeekAPI(aiRequest)
+	response, err := ai.callDeepSeekAPI(ctx, aiRequest)
 	if err != nil {
 		return nil, fmt.Errorf("AI API 호출 실패: %v", err)
 	}
@@ -674,7 +744,7 @@ func (ai *AIService) fallbackParseGitPrompt(prompt string) model.GitParseResult
 }
 
 // GenerateGitYamlWithAI - AI로 Git에서 가져온 YAML 분석 및 설명
-func (ai *AIService) GenerateGitYamlWithAI(yamlFiles []model.GitYamlFile, action string) (*model.AIYamlResponse, error) {
+func (ai *AIService) GenerateGitYamlWithAI(ctx context.Context, yamlFiles []model.GitYamlFile, action string) (*model.AIYamlResponse, error) {
 	log.Printf("🤖 Git YAML AI 분석: %d개 파일, 액션: %s", len(yamlFiles), action)
 
 	if len(yamlFiles) == 0 {
@@ -746,7 +816,7 @@ Be educational and helpful in your explanation.`
 	}
 
 	// AI API 호출
-	analysis, err := ai.callDeepSeekAPI(aiRequest)
+	analysis, err := ai.callDeepSeekAPI(ctx, aiRequest)
 	if err != nil {
 		return nil, fmt.Errorf("AI 분석 실패: %v", err)
 	}
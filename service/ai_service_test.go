@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"mykubeapp/model"
+	"mykubeapp/utils/utilstest"
+)
+
+func TestGenerateKubernetesYamlStopsWhenContextCancelled(t *testing.T) {
+	// 응답하지 않고 클라이언트가 연결을 끊을 때까지 대기하는 모델 서버
+	cancelled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+		<-r.Context().Done()
+		close(cancelled)
+	}))
+	defer server.Close()
+
+	aiService := NewAIServiceWithExecutor(server.URL, utilstest.NewFakeExecutor())
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	started := time.Now()
+	_, err := aiService.GenerateKubernetesYaml(ctx, model.AIYamlRequest{Prompt: "nginx deployment"})
+	if err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Fatalf("취소 에러여야 합니다: %v", err)
+	}
+	if elapsed := time.Since(started); elapsed > 5*time.Second {
+		t.Fatalf("취소 후에도 모델 호출이 계속되었습니다 (%s)", elapsed)
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("모델 서버 요청이 중단되지 않았습니다")
+	}
+}
@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// Deployment pod 템플릿에 붙이는 ConfigMap 내용 해시 어노테이션 접두어
+const configMapHashAnnotationPrefix = "checksum.mykubeapp.io/"
+
+// 해시 어노테이션으로 롤아웃하는 Deployment 리소스 종류
+var deploymentKind = &model.ResourceKind{Name: "deployments", APIVersion: "apps/v1", Namespaced: true, Kind: "Deployment"}
+
+// configMapManifest - ConfigMap 매니페스트 (필드 순서 고정)
+type configMapManifest struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name      string            `yaml:"name"`
+		Namespace string            `yaml:"namespace"`
+		Labels    map[string]string `yaml:"labels,omitempty"`
+	} `yaml:"metadata"`
+	Data       map[string]string `yaml:"data,omitempty"`
+	BinaryData map[string]string `yaml:"binaryData,omitempty"`
+}
+
+// configMapConsumer - ConfigMap 참조 여부 판단에 필요한 Deployment 필드
+type configMapConsumer struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec struct {
+		Template struct {
+			Metadata struct {
+				Annotations map[string]string `json:"annotations"`
+			} `json:"metadata"`
+			Spec struct {
+				Volumes []struct {
+					ConfigMap *struct {
+						Name string `json:"name"`
+					} `json:"configMap"`
+					Projected *struct {
+						Sources []struct {
+							ConfigMap *struct {
+								Name string `json:"name"`
+							} `json:"configMap"`
+						} `json:"sources"`
+					} `json:"projected"`
+				} `json:"volumes"`
+				Containers     []configMapContainer `json:"containers"`
+				InitContainers []configMapContainer `json:"initContainers"`
+			} `json:"spec"`
+		} `json:"template"`
+	} `json:"spec"`
+}
+
+// configMapContainer - 컨테이너의 envFrom/env ConfigMap 참조
+type configMapContainer struct {
+	EnvFrom []struct {
+		ConfigMapRef *struct {
+			Name string `json:"name"`
+		} `json:"configMapRef"`
+	} `json:"envFrom"`
+	Env []struct {
+		ValueFrom *struct {
+			ConfigMapKeyRef *struct {
+				Name string `json:"name"`
+			} `json:"configMapKeyRef"`
+		} `json:"valueFrom"`
+	} `json:"env"`
+}
+
+// references - Deployment가 ConfigMap을 볼륨/환경 변수로 참조하는지 여부
+func (c configMapConsumer) references(name string) bool {
+	podSpec := c.Spec.Template.Spec
+	for _, volume := range podSpec.Volumes {
+		if volume.ConfigMap != nil && volume.ConfigMap.Name == name {
+			return true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil && source.ConfigMap.Name == name {
+					return true
+				}
+			}
+		}
+	}
+	for _, container := range append(podSpec.Containers, podSpec.InitContainers...) {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil && envFrom.ConfigMapRef.Name == name {
+				return true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil && env.ValueFrom.ConfigMapKeyRef.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ConfigMapService - 설정 파일로 ConfigMap을 만들고 적용하는 서비스
+type ConfigMapService struct {
+	kubeService *KubeService
+	gitService  *GitService
+}
+
+// NewConfigMapService - ConfigMap 서비스 생성자
+func NewConfigMapService() *ConfigMapService {
+	return NewConfigMapServiceWithServices(NewKubeService(), NewGitService())
+}
+
+// NewConfigMapServiceWithServices - 지정한 서비스를 사용하는 생성자 (테스트에서 가짜 실행기 주입)
+func NewConfigMapServiceWithServices(kubeService *KubeService, gitService *GitService) *ConfigMapService {
+	return &ConfigMapService{
+		kubeService: kubeService,
+		gitService:  gitService,
+	}
+}
+
+// FilesFromGit - Git 레포지토리의 파일 또는 디렉토리(하위 디렉토리 제외)를 키 → 내용으로 읽기
+func (cs *ConfigMapService) FilesFromGit(ctx context.Context, request model.ConfigMapGitRequest) (map[string][]byte, string, error) {
+	if strings.TrimSpace(request.RepoURL) == "" || strings.TrimSpace(request.Path) == "" {
+		return nil, "", fmt.Errorf("repoUrl과 path는 필수입니다")
+	}
+	relativePath := filepath.Clean(filepath.FromSlash(request.Path))
+	if filepath.IsAbs(relativePath) || relativePath == ".." || strings.HasPrefix(relativePath, ".."+string(os.PathSeparator)) {
+		return nil, "", fmt.Errorf("path는 레포지토리 내부 상대 경로여야 합니다: %s", request.Path)
+	}
+
+	repoDir, err := cs.gitService.CloneRepository(ctx, request.RepoURL, request.Branch)
+	if err != nil {
+		return nil, "", err
+	}
+	defer cs.gitService.Cleanup(repoDir)
+
+	target := filepath.Join(repoDir, relativePath)
+	info, err := os.Lstat(target)
+	if err != nil {
+		return nil, "", fmt.Errorf("레포지토리에 경로가 없습니다: %s", request.Path)
+	}
+
+	// 중간 디렉토리가 심볼릭 링크로 레포지토리 밖을 가리키는 경우 차단
+	resolvedRepo, err := filepath.EvalSymlinks(repoDir)
+	if err != nil {
+		return nil, "", fmt.Errorf("레포지토리 경로 확인 실패: %w", err)
+	}
+	resolvedTarget, err := filepath.EvalSymlinks(target)
+	if err != nil || (resolvedTarget != resolvedRepo && !strings.HasPrefix(resolvedTarget, resolvedRepo+string(os.PathSeparator))) {
+		return nil, "", fmt.Errorf("path는 레포지토리 내부 상대 경로여야 합니다: %s", request.Path)
+	}
+
+	files := map[string][]byte{}
+	switch {
+	case info.Mode().IsRegular():
+		content, err := os.ReadFile(target)
+		if err != nil {
+			return nil, "", fmt.Errorf("파일 읽기 실패: %w", err)
+		}
+		files[info.Name()] = content
+	case info.IsDir():
+		// kubectl create configmap --from-file=<dir>와 같이 디렉토리 바로 아래 일반 파일만 사용
+		entries, err := os.ReadDir(target)
+		if err != nil {
+			return nil, "", fmt.Errorf("디렉토리 읽기 실패: %w", err)
+		}
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			content, err := os.ReadFile(filepath.Join(target, entry.Name()))
+			if err != nil {
+				return nil, "", fmt.Errorf("파일 읽기 실패: %w", err)
+			}
+			files[entry.Name()] = content
+		}
+		if len(files) == 0 {
+			return nil, "", fmt.Errorf("디렉토리에 파일이 없습니다: %s", request.Path)
+		}
+	default:
+		return nil, "", fmt.Errorf("일반 파일 또는 디렉토리만 사용할 수 있습니다: %s", request.Path)
+	}
+
+	branch := request.Branch
+	if branch == "" {
+		branch = "main"
+	}
+	source := fmt.Sprintf("%s@%s:%s", request.RepoURL, branch, filepath.ToSlash(relativePath))
+	log.Printf("📄 Git 설정 파일 읽기 완료: %s (%d개)", source, len(files))
+	return files, source, nil
+}
+
+// BuildConfigMap - 파일 내용으로 ConfigMap 매니페스트 생성 (UTF-8이 아닌 파일은 binaryData)
+func (cs *ConfigMapService) BuildConfigMap(request model.ConfigMapRequest, files map[string][]byte) (*model.ConfigMapResult, error) {
+	if len(request.Name) > 253 || !dnsSubdomainPattern.MatchString(request.Name) {
+		return nil, fmt.Errorf("잘못된 ConfigMap 이름입니다: %q (소문자, 숫자, '-', '.'만 사용)", request.Name)
+	}
+	if request.Namespace == "" {
+		request.Namespace = "default"
+	}
+	if err := kubernetes.ValidateNamespaceName(request.Namespace); err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("ConfigMap에 넣을 파일이 없습니다")
+	}
+	if request.Rollout && !request.Apply {
+		return nil, fmt.Errorf("rollout은 apply=true일 때만 사용할 수 있습니다")
+	}
+
+	manifest := configMapManifest{APIVersion: "v1", Kind: "ConfigMap"}
+	manifest.Metadata.Name = request.Name
+	manifest.Metadata.Namespace = request.Namespace
+	manifest.Metadata.Labels = request.Labels
+
+	result := &model.ConfigMapResult{
+		Name:           request.Name,
+		Namespace:      request.Namespace,
+		Keys:           []string{},
+		BinaryKeys:     []string{},
+		HashAnnotation: configMapHashAnnotation(request.Name),
+	}
+
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		if err := validateDataKey(key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// 내용 해시는 키 순서대로 "키\0내용\0"을 이어 붙여 계산
+	hash := sha256.New()
+	total := 0
+	for _, key := range keys {
+		content := files[key]
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write(content)
+		hash.Write([]byte{0})
+
+		if utf8.Valid(content) {
+			if manifest.Data == nil {
+				manifest.Data = map[string]string{}
+			}
+			manifest.Data[key] = string(content)
+			result.Keys = append(result.Keys, key)
+			total += len(content)
+		} else {
+			if manifest.BinaryData == nil {
+				manifest.BinaryData = map[string]string{}
+			}
+			manifest.BinaryData[key] = base64.StdEncoding.EncodeToString(content)
+			result.BinaryKeys = append(result.BinaryKeys, key)
+			total += len(manifest.BinaryData[key])
+		}
+	}
+	if total > maxAppliedDataSize {
+		return nil, fmt.Errorf("ConfigMap 데이터가 너무 큽니다 (인코딩 후 %d바이트, 최대 %d바이트)", total, maxAppliedDataSize)
+	}
+	result.Hash = hex.EncodeToString(hash.Sum(nil))
+
+	content, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("ConfigMap 직렬화 실패: %v", err)
+	}
+	result.YamlContent = string(content)
+	return result, nil
+}
+
+// ApplyConfigMap - 생성한 ConfigMap 적용 후 (rollout=true면) 참조하는 Deployment에 해시 어노테이션 추가
+func (cs *ConfigMapService) ApplyConfigMap(ctx context.Context, request model.ConfigMapRequest, result *model.ConfigMapResult) error {
+	log.Printf("🗂️ ConfigMap 적용 시작: %s/%s (키 %d개, 바이너리 %d개, DryRun: %t)", result.Namespace, result.Name, len(result.Keys), len(result.BinaryKeys), request.DryRun)
+
+	applyResult, err := cs.kubeService.ApplyYaml(ctx, model.ApplyYamlRequest{
+		YamlContent: result.YamlContent,
+		Namespace:   result.Namespace,
+		DryRun:      request.DryRun,
+		Context:     request.Context,
+	})
+	if err != nil {
+		return err
+	}
+	result.ApplyResult = applyResult
+
+	if request.Rollout {
+		rollouts, err := cs.annotateConsumers(ctx, request, result)
+		if err != nil {
+			return err
+		}
+		result.Rollouts = rollouts
+	}
+
+	log.Printf("✅ ConfigMap 적용 완료: %s/%s (Deployment %d개 확인)", result.Namespace, result.Name, len(result.Rollouts))
+	return nil
+}
+
+// annotateConsumers - ConfigMap을 참조하는 Deployment의 pod 템플릿에 내용 해시 어노테이션 패치 (해시가 바뀐 경우만)
+func (cs *ConfigMapService) annotateConsumers(ctx context.Context, request model.ConfigMapRequest, result *model.ConfigMapResult) ([]model.ConfigMapRollout, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.ApplyCommandTimeout)
+	defer cancel()
+
+	cluster := cs.kubeService.cluster
+	output, err := cluster.GetRaw(ctx, request.Context, kubernetes.ResourcePath(deploymentKind, result.Namespace, ""))
+	if err != nil {
+		return nil, fmt.Errorf("Deployment 목록 조회 실패: %w", err)
+	}
+
+	var list struct {
+		Items []configMapConsumer `json:"items"`
+	}
+	if err := json.Unmarshal(output, &list); err != nil {
+		return nil, fmt.Errorf("Deployment 목록 파싱 실패: %v", err)
+	}
+
+	rollouts := []model.ConfigMapRollout{}
+	for _, deployment := range list.Items {
+		if !deployment.references(result.Name) {
+			continue
+		}
+
+		rollout := model.ConfigMapRollout{Deployment: deployment.Metadata.Name}
+		switch {
+		case deployment.Spec.Template.Metadata.Annotations[result.HashAnnotation] == result.Hash:
+			rollout.Status = model.ConfigMapRolloutUnchanged
+		case request.DryRun:
+			rollout.Status = model.ConfigMapRolloutWouldAnnotate
+		default:
+			patch, _ := json.Marshal(map[string]interface{}{
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"annotations": map[string]string{result.HashAnnotation: result.Hash},
+						},
+					},
+				},
+			})
+			object := kubernetes.ObjectReference{Kind: deploymentKind, Namespace: result.Namespace, Name: deployment.Metadata.Name}
+			if err := cluster.Patch(ctx, request.Context, object, patch); err != nil {
+				log.Printf("❌ Deployment %s 어노테이션 실패: %v", deployment.Metadata.Name, err)
+				rollout.Status = model.ConfigMapRolloutFailed
+				rollout.Error = err.Error()
+			} else {
+				log.Printf("🔄 Deployment %s 해시 어노테이션 갱신 (롤아웃)", deployment.Metadata.Name)
+				rollout.Status = model.ConfigMapRolloutAnnotated
+			}
+		}
+		rollouts = append(rollouts, rollout)
+	}
+	return rollouts, nil
+}
+
+// configMapHashAnnotation - ConfigMap별 해시 어노테이션 키 (이름 부분은 63자 제한)
+func configMapHashAnnotation(name string) string {
+	if len(name) > 63 {
+		sum := sha256.Sum256([]byte(name))
+		name = name[:54] + "-" + hex.EncodeToString(sum[:])[:8]
+	}
+	return configMapHashAnnotationPrefix + name
+}
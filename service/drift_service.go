@@ -0,0 +1,610 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 드리프트 비교에서 무시하는 metadata 필드 (서버 관리 필드와 네임스페이스)
+var driftIgnoredMetadata = append([]string{"namespace"}, serverMetadataFields...)
+
+// 수량 문자열로 비교할 경로 (예: 1000m 과 1 은 같은 값)
+var driftQuantityPathMarkers = []string{".resources.", ".requests.", ".limits.", ".hard.", ".capacity.", "storage"}
+
+// DriftService - Git 매니페스트와 클러스터 상태 비교 서비스
+type DriftService struct {
+	executor        utils.CommandExecutor
+	gitService      *GitService
+	variableService *VariableService
+}
+
+// NewDriftService - 드리프트 서비스 생성자
+func NewDriftService() *DriftService {
+	return NewDriftServiceWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewDriftServiceWithExecutor - 지정한 명령 실행기를 사용하는 드리프트 서비스 생성자 (git, kubectl 모두 같은 실행기 사용)
+func NewDriftServiceWithExecutor(executor utils.CommandExecutor) *DriftService {
+	return &DriftService{
+		executor:        executor,
+		gitService:      NewGitServiceWithExecutor(executor),
+		variableService: NewVariableService(),
+	}
+}
+
+// desiredObject - Git에서 읽은 매니페스트 오브젝트
+type desiredObject struct {
+	object     map[string]interface{}
+	namespace  string
+	sourceFile string
+}
+
+// DetectDrift - 레포지토리를 클론해 매니페스트와 클러스터 상태를 비교
+func (ds *DriftService) DetectDrift(ctx context.Context, req model.DriftRequest) (*model.DriftReport, error) {
+	if strings.TrimSpace(req.RepoURL) == "" {
+		return nil, fmt.Errorf("레포지토리 URL은 필수입니다")
+	}
+	if req.Branch == "" {
+		req.Branch = "main"
+	}
+	if req.Namespace == "" {
+		req.Namespace = "default"
+	}
+	if err := kubernetes.ValidateNamespaceName(req.Namespace); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(req.Context, "-") || strings.HasPrefix(req.LabelSelector, "-") {
+		return nil, fmt.Errorf("잘못된 context 또는 셀렉터입니다")
+	}
+
+	repoDir, err := ds.gitService.CloneRepository(ctx, req.RepoURL, req.Branch)
+	if err != nil {
+		return nil, err
+	}
+	defer ds.gitService.Cleanup(repoDir)
+
+	files, err := ds.collectManifests(ctx, repoDir, req)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := ds.parseDesiredObjects(files, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(desired) == 0 {
+		return nil, fmt.Errorf("비교할 매니페스트가 없습니다 (경로: %s)", req.Path)
+	}
+
+	log.Printf("🧭 드리프트 비교 시작: %s@%s/%s (리소스 %d개, context: %s)", req.RepoURL, req.Branch, req.Path, len(desired), req.Context)
+
+	live, err := ds.fetchLiveObjects(ctx, req.Context, req.Namespace, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &model.DriftReport{
+		RepoURL:   req.RepoURL,
+		Branch:    req.Branch,
+		Path:      req.Path,
+		Context:   req.Context,
+		Resources: []model.DriftResource{},
+	}
+	if commit, err := ds.executor.Execute(ctx, "git", "-C", repoDir, "rev-parse", "HEAD"); err == nil {
+		report.Commit = strings.TrimSpace(commit)
+	}
+
+	desiredKeys := map[string]bool{}
+	for _, item := range desired {
+		resource := model.DriftResource{
+			APIVersion: utils.GetNestedString(item.object, "apiVersion"),
+			Kind:       utils.GetNestedString(item.object, "kind"),
+			Namespace:  item.namespace,
+			Name:       utils.GetNestedString(item.object, "metadata", "name"),
+			SourceFile: item.sourceFile,
+		}
+
+		key := driftKey(item.object, item.namespace)
+		desiredKeys[key] = true
+		liveObject, found := live[key]
+		if !found {
+			// 클러스터 범위 리소스는 네임스페이스 없이 조회됨
+			clusterKey := driftKey(item.object, "")
+			liveObject, found = live[clusterKey]
+			if found {
+				resource.Namespace = ""
+				desiredKeys[clusterKey] = true
+			}
+		}
+
+		switch {
+		case !found:
+			resource.Status = model.DriftStatusMissing
+		default:
+			resource.Differences = CompareDesiredToLive(item.object, liveObject)
+			resource.Status = model.DriftStatusInSync
+			if len(resource.Differences) > 0 {
+				resource.Status = model.DriftStatusDrifted
+			}
+		}
+		report.Resources = append(report.Resources, resource)
+	}
+
+	if req.LabelSelector != "" {
+		extras, err := ds.findExtraObjects(ctx, req, desired, desiredKeys)
+		if err != nil {
+			return nil, err
+		}
+		report.Resources = append(report.Resources, extras...)
+	}
+
+	for _, resource := range report.Resources {
+		report.Summary.Total++
+		switch resource.Status {
+		case model.DriftStatusInSync:
+			report.Summary.InSync++
+		case model.DriftStatusDrifted:
+			report.Summary.Drifted++
+		case model.DriftStatusMissing:
+			report.Summary.Missing++
+		case model.DriftStatusExtra:
+			report.Summary.Extra++
+		}
+	}
+	report.InSync = report.Summary.InSync == report.Summary.Total
+	report.CheckedTime = time.Now().Format("2006-01-02 15:04:05")
+
+	log.Printf("✅ 드리프트 비교 완료: 일치 %d, 차이 %d, 누락 %d, 추가 %d",
+		report.Summary.InSync, report.Summary.Drifted, report.Summary.Missing, report.Summary.Extra)
+	return report, nil
+}
+
+// collectManifests - 경로에 해당하는 매니페스트 수집 (kustomization 디렉토리면 빌드 결과)
+func (ds *DriftService) collectManifests(ctx context.Context, repoDir string, req model.DriftRequest) ([]model.GitYamlFile, error) {
+	kustomizePath := req.KustomizePath
+	if kustomizePath == "" && req.Path != "" && IsKustomizationDir(filepath.Join(repoDir, filepath.FromSlash(req.Path))) {
+		kustomizePath = req.Path
+	}
+	if kustomizePath != "" {
+		files, _, err := ds.gitService.FindManifests(ctx, repoDir, kustomizePath, true)
+		return files, err
+	}
+	if req.Path == "" {
+		files, _, err := ds.gitService.FindManifests(ctx, repoDir, "", false)
+		return files, err
+	}
+
+	files, err := ds.gitService.FindYamlFiles(repoDir)
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.Trim(filepath.ToSlash(req.Path), "/")
+	var matched []model.GitYamlFile
+	for _, file := range files {
+		path := filepath.ToSlash(file.Path)
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			matched = append(matched, file)
+		}
+	}
+	return matched, nil
+}
+
+// parseDesiredObjects - 매니페스트 파일을 오브젝트 목록으로 변환 (${VAR} 치환, List 펼치기)
+func (ds *DriftService) parseDesiredObjects(files []model.GitYamlFile, req model.DriftRequest) ([]desiredObject, error) {
+	var desired []desiredObject
+	for _, file := range files {
+		content, _, err := ds.variableService.Apply(file.Content, req.Environment, req.Variables)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Path, err)
+		}
+		documents, err := utils.ParseYamlDocuments(content)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Path, err)
+		}
+
+		for _, document := range documents {
+			objects := []map[string]interface{}{document}
+			if strings.HasSuffix(utils.GetNestedString(document, "kind"), "List") {
+				objects = nil
+				for _, item := range utils.GetNestedSlice(document, "items") {
+					if object, ok := item.(map[string]interface{}); ok {
+						objects = append(objects, object)
+					}
+				}
+			}
+			for _, object := range objects {
+				if utils.GetNestedString(object, "kind") == "" || utils.GetNestedString(object, "metadata", "name") == "" {
+					continue
+				}
+				namespace := utils.GetNestedString(object, "metadata", "namespace")
+				if namespace == "" {
+					namespace = req.Namespace
+				}
+				desired = append(desired, desiredObject{object: object, namespace: namespace, sourceFile: filepath.ToSlash(file.Path)})
+			}
+		}
+	}
+	return desired, nil
+}
+
+// fetchLiveObjects - 매니페스트에 해당하는 클러스터 오브젝트 조회 (키: group/Kind/namespace/name)
+// 한 번에 조회하고, 설치되지 않은 CRD 등으로 실패하면 리소스별로 다시 조회
+func (ds *DriftService) fetchLiveObjects(ctx context.Context, kubeContext, namespace string, desired []desiredObject) (map[string]map[string]interface{}, error) {
+	documents := make([]map[string]interface{}, 0, len(desired))
+	for _, item := range desired {
+		documents = append(documents, item.object)
+	}
+
+	live := map[string]map[string]interface{}{}
+	items, err := ds.getLiveObjects(ctx, kubeContext, namespace, documents)
+	if err == nil {
+		for _, object := range items {
+			live[driftKey(object, utils.GetNestedString(object, "metadata", "namespace"))] = object
+		}
+		return live, nil
+	}
+
+	log.Printf("⚠️ 일괄 조회 실패, 리소스별로 조회합니다: %v", err)
+	for _, item := range desired {
+		items, err := ds.getLiveObjects(ctx, kubeContext, item.namespace, []map[string]interface{}{item.object})
+		if err != nil {
+			continue
+		}
+		for _, object := range items {
+			live[driftKey(object, utils.GetNestedString(object, "metadata", "namespace"))] = object
+		}
+	}
+	return live, nil
+}
+
+// getLiveObjects - kubectl get -f 로 매니페스트의 현재 상태 조회 (없는 리소스는 무시)
+func (ds *DriftService) getLiveObjects(ctx context.Context, kubeContext, namespace string, documents []map[string]interface{}) ([]map[string]interface{}, error) {
+	content, err := utils.MarshalYamlDocuments(documents)
+	if err != nil {
+		return nil, err
+	}
+
+	tempFile := filepath.Join(os.TempDir(), fmt.Sprintf("kubectl-drift-%d.yaml", time.Now().UnixNano()))
+	if err := utils.WriteFile(tempFile, content); err != nil {
+		return nil, fmt.Errorf("임시 파일 생성 실패: %w", err)
+	}
+	defer os.Remove(tempFile)
+
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+	output, err := ds.executor.Execute(ctx, "kubectl", kubernetes.ContextArgs(kubeContext, "get", "-f", tempFile, "-n", namespace, "--ignore-not-found", "-o", "json")...)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(output) == "" {
+		return nil, nil
+	}
+	return decodeExportItems(output)
+}
+
+// findExtraObjects - 라벨 셀렉터와 일치하지만 Git에 없는 리소스 (컨트롤러가 만든 리소스 제외)
+func (ds *DriftService) findExtraObjects(ctx context.Context, req model.DriftRequest, desired []desiredObject, desiredKeys map[string]bool) ([]model.DriftResource, error) {
+	kinds := req.ExtraKinds
+	if len(kinds) == 0 {
+		seen := map[string]bool{}
+		for _, item := range desired {
+			kind := strings.ToLower(utils.GetNestedString(item.object, "kind"))
+			if group := apiGroup(utils.GetNestedString(item.object, "apiVersion")); group != "" {
+				kind += "." + group
+			}
+			if !seen[kind] {
+				seen[kind] = true
+				kinds = append(kinds, kind)
+			}
+		}
+		sort.Strings(kinds)
+	}
+	for _, kind := range kinds {
+		if kind == "" || strings.HasPrefix(kind, "-") || strings.ContainsAny(kind, ", /") {
+			return nil, fmt.Errorf("잘못된 리소스 종류입니다: %s", kind)
+		}
+	}
+
+	namespaces := map[string]bool{}
+	for _, item := range desired {
+		namespaces[item.namespace] = true
+	}
+
+	extras := []model.DriftResource{}
+	reported := map[string]bool{}
+	for namespace := range namespaces {
+		args := kubernetes.ContextArgs(req.Context, "get", strings.Join(kinds, ","), "-n", namespace, "-l", req.LabelSelector, "-o", "json")
+		output, err := ds.executor.Execute(ctx, "kubectl", args...)
+		if err != nil {
+			return nil, fmt.Errorf("추가 리소스 조회 실패: %w", err)
+		}
+		items, err := decodeExportItems(output)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range items {
+			objectNamespace := utils.GetNestedString(object, "metadata", "namespace")
+			key := driftKey(object, objectNamespace)
+			if desiredKeys[key] || reported[key] || len(utils.GetNestedSlice(object, "metadata", "ownerReferences")) > 0 {
+				continue
+			}
+			reported[key] = true
+			extras = append(extras, model.DriftResource{
+				APIVersion: utils.GetNestedString(object, "apiVersion"),
+				Kind:       utils.GetNestedString(object, "kind"),
+				Namespace:  objectNamespace,
+				Name:       utils.GetNestedString(object, "metadata", "name"),
+				Status:     model.DriftStatusExtra,
+			})
+		}
+	}
+
+	sort.Slice(extras, func(i, j int) bool {
+		if extras[i].Kind != extras[j].Kind {
+			return extras[i].Kind < extras[j].Kind
+		}
+		return extras[i].Namespace+"/"+extras[i].Name < extras[j].Namespace+"/"+extras[j].Name
+	})
+	return extras, nil
+}
+
+// CompareDesiredToLive - Git에 선언된 필드만 클러스터 값과 비교 (기본값/서버 관리 필드는 무시)
+func CompareDesiredToLive(desired, live map[string]interface{}) []model.DriftDifference {
+	expected := copyForDrift(desired)
+	delete(expected, "status")
+	if metadata, ok := expected["metadata"].(map[string]interface{}); ok {
+		for _, field := range driftIgnoredMetadata {
+			delete(metadata, field)
+		}
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			for _, annotation := range defaultAnnotations {
+				delete(annotations, annotation)
+			}
+		}
+	}
+
+	// Secret의 stringData는 저장 시 data(base64)로 합쳐짐
+	secret := utils.GetNestedString(expected, "kind") == "Secret"
+	if secret {
+		if stringData, ok := expected["stringData"].(map[string]interface{}); ok {
+			data := map[string]interface{}{}
+			if existing, ok := expected["data"].(map[string]interface{}); ok {
+				for key, value := range existing {
+					data[key] = value
+				}
+			}
+			for key, value := range stringData {
+				data[key] = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(value)))
+			}
+			expected["data"] = data
+			delete(expected, "stringData")
+		}
+	}
+
+	var differences []model.DriftDifference
+	compareDriftValue("", expected, live, &differences)
+
+	// Secret 값은 리포트에 노출하지 않음
+	if secret {
+		for i := range differences {
+			if strings.HasPrefix(differences[i].Path, "data.") {
+				differences[i].Expected = "(redacted)"
+				differences[i].Actual = "(redacted)"
+			}
+		}
+	}
+	return differences
+}
+
+// compareDriftValue - 기대값 기준 재귀 비교 (기대값에 없는 필드는 기본값으로 보고 무시)
+func compareDriftValue(path string, expected, actual interface{}, differences *[]model.DriftDifference) {
+	switch expectedValue := expected.(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		actualMap, ok := actual.(map[string]interface{})
+		if !ok {
+			if len(expectedValue) > 0 {
+				*differences = append(*differences, model.DriftDifference{Path: path, Expected: expected, Actual: actual})
+			}
+			return
+		}
+		keys := make([]string, 0, len(expectedValue))
+		for key := range expectedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			compareDriftValue(joinDriftPath(path, key), expectedValue[key], actualMap[key], differences)
+		}
+	case []interface{}:
+		actualSlice, _ := actual.([]interface{})
+		if len(expectedValue) == 0 {
+			return
+		}
+		if _, named := namedItem(expectedValue[0]); named {
+			// containers, env, volumes 처럼 name으로 구분되는 목록은 이름으로 매칭 (사이드카 주입 등 추가 항목은 무시)
+			for _, item := range expectedValue {
+				name, _ := namedItem(item)
+				var match interface{}
+				for _, candidate := range actualSlice {
+					if candidateName, ok := namedItem(candidate); ok && candidateName == name {
+						match = candidate
+						break
+					}
+				}
+				itemPath := fmt.Sprintf("%s[%s]", path, name)
+				if match == nil {
+					*differences = append(*differences, model.DriftDifference{Path: itemPath, Expected: item, Actual: nil})
+					continue
+				}
+				compareDriftValue(itemPath, item, match, differences)
+			}
+			return
+		}
+		if _, isMap := expectedValue[0].(map[string]interface{}); isMap && len(actualSlice) == len(expectedValue) {
+			for i := range expectedValue {
+				compareDriftValue(fmt.Sprintf("%s[%d]", path, i), expectedValue[i], actualSlice[i], differences)
+			}
+			return
+		}
+		if !driftValuesEqual(path, expected, actual) {
+			*differences = append(*differences, model.DriftDifference{Path: path, Expected: expected, Actual: actual})
+		}
+	default:
+		if !driftValuesEqual(path, expected, actual) {
+			*differences = append(*differences, model.DriftDifference{Path: path, Expected: expected, Actual: actual})
+		}
+	}
+}
+
+// driftValuesEqual - 숫자 타입 차이(int/float), 문자열/숫자 표기(targetPort "8080"), 수량 표기(1000m/1) 차이를 무시하고 비교
+func driftValuesEqual(path string, expected, actual interface{}) bool {
+	expected = normalizeDriftValue(expected)
+	actual = normalizeDriftValue(actual)
+	if reflect.DeepEqual(expected, actual) {
+		return true
+	}
+
+	// 수량 경로는 Git의 숫자(cpu: 0.5)와 클러스터의 문자열("500m")도 수량으로 비교
+	if isDriftQuantityPath(path) {
+		expectedQuantity, ok1 := driftQuantity(expected)
+		actualQuantity, ok2 := driftQuantity(actual)
+		if ok1 && ok2 {
+			return math.Abs(expectedQuantity-actualQuantity) <= 1e-9*math.Max(math.Abs(expectedQuantity), math.Abs(actualQuantity))
+		}
+	}
+
+	_, expectedIsString := expected.(string)
+	_, actualIsString := actual.(string)
+	if expectedIsString && actualIsString {
+		return false
+	}
+
+	_, expectedIsSlice := expected.([]interface{})
+	_, expectedIsMap := expected.(map[string]interface{})
+	if actual != nil && !expectedIsSlice && !expectedIsMap {
+		return fmt.Sprint(expected) == fmt.Sprint(actual)
+	}
+	return false
+}
+
+// isDriftQuantityPath - 리소스 수량 필드 경로인지 확인
+func isDriftQuantityPath(path string) bool {
+	for _, marker := range driftQuantityPathMarkers {
+		if strings.Contains(path+".", marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// driftQuantity - 문자열 또는 숫자 수량을 기본 단위 값으로 변환
+func driftQuantity(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case string:
+		quantity, err := kubernetes.ParseQuantity(v)
+		return quantity, err == nil
+	case float64:
+		quantity, err := kubernetes.ParseQuantity(strconv.FormatFloat(v, 'f', -1, 64))
+		return quantity, err == nil
+	}
+	return 0, false
+}
+
+// normalizeDriftValue - YAML(int)과 JSON(int64/float64) 숫자를 float64로 통일
+func normalizeDriftValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, item := range v {
+			normalized[i] = normalizeDriftValue(item)
+		}
+		return normalized
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			normalized[key] = normalizeDriftValue(item)
+		}
+		return normalized
+	default:
+		return v
+	}
+}
+
+// namedItem - name 필드가 있는 목록 항목이면 이름 반환
+func namedItem(item interface{}) (string, bool) {
+	object, ok := item.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	name, ok := object["name"].(string)
+	return name, ok && name != ""
+}
+
+// copyForDrift - 비교용 사본 (원본 매니페스트를 변경하지 않도록 최상위와 metadata만 복사)
+func copyForDrift(object map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(object))
+	for key, value := range object {
+		copied[key] = value
+	}
+	if metadata, ok := object["metadata"].(map[string]interface{}); ok {
+		metadataCopy := make(map[string]interface{}, len(metadata))
+		for key, value := range metadata {
+			metadataCopy[key] = value
+		}
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			annotationsCopy := make(map[string]interface{}, len(annotations))
+			for key, value := range annotations {
+				annotationsCopy[key] = value
+			}
+			metadataCopy["annotations"] = annotationsCopy
+		}
+		copied["metadata"] = metadataCopy
+	}
+	return copied
+}
+
+// driftKey - 리소스 식별 키 (group/Kind/namespace/name, 버전 차이는 무시)
+func driftKey(object map[string]interface{}, namespace string) string {
+	return strings.Join([]string{
+		apiGroup(utils.GetNestedString(object, "apiVersion")),
+		utils.GetNestedString(object, "kind"),
+		namespace,
+		utils.GetNestedString(object, "metadata", "name"),
+	}, "/")
+}
+
+// apiGroup - apiVersion에서 그룹 추출 (core는 빈 값)
+func apiGroup(apiVersion string) string {
+	if index := strings.Index(apiVersion, "/"); index >= 0 {
+		return apiVersion[:index]
+	}
+	return ""
+}
+
+func joinDriftPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,43 @@
+package service
+
+import "testing"
+
+func TestCompareDesiredToLiveQuantities(t *testing.T) {
+	live := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+			"resources": map[string]interface{}{
+				"requests": map[string]interface{}{"cpu": "500m", "memory": "1Gi"},
+				"limits":   map[string]interface{}{"cpu": "1"},
+			},
+		},
+	}
+
+	for _, test := range []struct {
+		name        string
+		cpu         interface{}
+		limit       interface{}
+		replicas    interface{}
+		differences int
+	}{
+		{name: "소수 CPU", cpu: 0.5, limit: 1, replicas: 2},
+		{name: "문자열 CPU", cpu: "0.5", limit: "1000m", replicas: 2},
+		{name: "다른 CPU", cpu: 0.25, limit: 1, replicas: 2, differences: 1},
+		{name: "수량이 아닌 경로", cpu: "500m", limit: 1, replicas: "2.0", differences: 1},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			desired := map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": test.replicas,
+					"resources": map[string]interface{}{
+						"requests": map[string]interface{}{"cpu": test.cpu, "memory": "1Gi"},
+						"limits":   map[string]interface{}{"cpu": test.limit},
+					},
+				},
+			}
+			if differences := CompareDesiredToLive(desired, live); len(differences) != test.differences {
+				t.Fatalf("차이 = %+v, 기대 개수 %d", differences, test.differences)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 셀렉터/네임스페이스 전체 내보내기 기본 대상 (파드, ReplicaSet 등 컨트롤러가 만드는 리소스 제외)
+var defaultExportKinds = []string{
+	"serviceaccounts", "configmaps", "persistentvolumeclaims", "services",
+	"deployments", "statefulsets", "daemonsets", "cronjobs", "jobs",
+	"ingresses", "horizontalpodautoscalers", "poddisruptionbudgets",
+	"networkpolicies", "roles", "rolebindings",
+}
+
+// 서버가 채우는 metadata 필드
+var serverMetadataFields = []string{
+	"managedFields", "uid", "resourceVersion", "creationTimestamp", "generation",
+	"selfLink", "deletionTimestamp", "deletionGracePeriodSeconds", "ownerReferences",
+}
+
+// 서버/컨트롤러가 자동으로 붙이는 어노테이션
+var defaultAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"deployment.kubernetes.io/revision",
+	"autoscaling.alpha.kubernetes.io/conditions",
+	"autoscaling.alpha.kubernetes.io/current-metrics",
+	"pv.kubernetes.io/bind-completed",
+	"pv.kubernetes.io/bound-by-controller",
+	"volume.beta.kubernetes.io/storage-provisioner",
+	"volume.kubernetes.io/storage-provisioner",
+	"volume.kubernetes.io/selected-node",
+	"control-plane.alpha.kubernetes.io/leader",
+}
+
+// Job 컨트롤러가 셀렉터/템플릿에 붙이는 라벨 (재적용 시 충돌)
+var jobControllerLabels = []string{
+	"controller-uid", "job-name",
+	"batch.kubernetes.io/controller-uid", "batch.kubernetes.io/job-name",
+}
+
+// 적용 순서 (의존 대상이 먼저 생성되도록)
+var exportKindOrder = map[string]int{
+	"Namespace": 0, "ServiceAccount": 1, "Role": 2, "RoleBinding": 3,
+	"ConfigMap": 4, "Secret": 5, "PersistentVolumeClaim": 6, "Service": 7,
+}
+
+// ExportService - 실행 중인 리소스 내보내기 서비스
+type ExportService struct {
+	executor utils.CommandExecutor
+}
+
+// NewExportService - 내보내기 서비스 생성자
+func NewExportService() *ExportService {
+	return NewExportServiceWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewExportServiceWithExecutor - 지정한 명령 실행기를 사용하는 내보내기 서비스 생성자
+func NewExportServiceWithExecutor(executor utils.CommandExecutor) *ExportService {
+	return &ExportService{executor: executor}
+}
+
+// ExportResources - 리소스를 조회해 서버가 채운 필드를 제거한 YAML로 변환
+func (es *ExportService) ExportResources(ctx context.Context, req model.ExportRequest) (*model.ExportResult, error) {
+	documents, result, err := es.ExportObjects(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(documents) > 0 {
+		result.YamlContent, err = utils.MarshalYamlDocuments(documents)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("✅ 리소스 내보내기 완료: %d개 (제외 %d개)", result.DocumentCount, len(result.Skipped))
+	return result, nil
+}
+
+// ExportObjects - 리소스를 조회해 정리된 오브젝트 목록을 적용 순서대로 반환 (YamlContent는 비어 있음)
+func (es *ExportService) ExportObjects(ctx context.Context, req model.ExportRequest) ([]map[string]interface{}, *model.ExportResult, error) {
+	if req.Namespace == "" {
+		req.Namespace = "default"
+	}
+	if err := kubernetes.ValidateNamespaceName(req.Namespace); err != nil {
+		return nil, nil, err
+	}
+
+	args, explicit, err := buildExportArgs(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Printf("📤 리소스 내보내기: kubectl %s", strings.Join(args, " "))
+
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+	output, err := es.executor.Execute(ctx, "kubectl", args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("리소스 조회 실패: %w", err)
+	}
+
+	items, err := decodeExportItems(output)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := &model.ExportResult{
+		Resources: []string{},
+		Skipped:   []string{},
+	}
+	var documents []map[string]interface{}
+	for _, object := range items {
+		reference := exportReference(object)
+		if reason := exportSkipReason(object, explicit, req.IncludeSecrets); reason != "" {
+			result.Skipped = append(result.Skipped, reference+": "+reason)
+			continue
+		}
+
+		CleanExportedObject(object, req.KeepNamespace)
+		documents = append(documents, object)
+	}
+
+	sort.SliceStable(documents, func(i, j int) bool {
+		return exportKindRank(documents[i]) < exportKindRank(documents[j])
+	})
+	for _, document := range documents {
+		result.Resources = append(result.Resources, exportReference(document))
+	}
+	result.DocumentCount = len(documents)
+	result.ExportedTime = time.Now().Format("2006-01-02 15:04:05")
+
+	return documents, result, nil
+}
+
+// buildExportArgs - kubectl get 인자 구성 (명시적 참조 여부 반환)
+func buildExportArgs(req model.ExportRequest) ([]string, bool, error) {
+	if strings.HasPrefix(req.Context, "-") {
+		return nil, false, fmt.Errorf("잘못된 context 이름입니다: %s", req.Context)
+	}
+	args := kubernetes.ContextArgs(req.Context, "get", "-n", req.Namespace, "-o", "json")
+
+	if len(req.Resources) > 0 {
+		if req.LabelSelector != "" {
+			return nil, false, fmt.Errorf("resources와 labelSelector는 함께 사용할 수 없습니다")
+		}
+		for _, reference := range req.Resources {
+			parts := strings.Split(strings.TrimSpace(reference), "/")
+			if len(parts) != 2 || parts[0] == "" || strings.HasPrefix(parts[0], "-") || !objectNamePattern.MatchString(parts[1]) {
+				return nil, false, fmt.Errorf("리소스 참조는 kind/name 형식이어야 합니다: %s", reference)
+			}
+			args = append(args, strings.TrimSpace(reference))
+		}
+		return args, true, nil
+	}
+
+	kinds := req.Kinds
+	if len(kinds) == 0 {
+		kinds = append([]string{}, defaultExportKinds...)
+		if req.IncludeSecrets {
+			kinds = append(kinds, "secrets")
+		}
+	}
+	for _, kind := range kinds {
+		if kind == "" || strings.HasPrefix(kind, "-") || strings.ContainsAny(kind, ", /") {
+			return nil, false, fmt.Errorf("잘못된 리소스 종류입니다: %s", kind)
+		}
+	}
+	args = append(args, strings.Join(kinds, ","))
+
+	if req.LabelSelector != "" {
+		if strings.HasPrefix(req.LabelSelector, "-") {
+			return nil, false, fmt.Errorf("잘못된 셀렉터입니다: %s", req.LabelSelector)
+		}
+		args = append(args, "-l", req.LabelSelector)
+	}
+	return args, false, nil
+}
+
+// decodeExportItems - kubectl get -o json 출력을 오브젝트 목록으로 변환 (단일 오브젝트/List 모두 처리)
+func decodeExportItems(output string) ([]map[string]interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(output))
+	decoder.UseNumber()
+
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, fmt.Errorf("JSON 파싱 실패: %w", err)
+	}
+	object = normalizeJSONNumbers(object).(map[string]interface{})
+
+	if !strings.HasSuffix(utils.GetNestedString(object, "kind"), "List") {
+		return []map[string]interface{}{object}, nil
+	}
+
+	var items []map[string]interface{}
+	for _, item := range utils.GetNestedSlice(object, "items") {
+		if m, ok := item.(map[string]interface{}); ok {
+			items = append(items, m)
+		}
+	}
+	return items, nil
+}
+
+// normalizeJSONNumbers - json.Number를 int64/float64로 변환 (YAML에서 1e+06 같은 지수 표기 방지)
+func normalizeJSONNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeJSONNumbers(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeJSONNumbers(item)
+		}
+		return v
+	case json.Number:
+		if integer, err := v.Int64(); err == nil {
+			return integer
+		}
+		float, _ := v.Float64()
+		return float
+	default:
+		return v
+	}
+}
+
+// exportSkipReason - 내보내기에서 제외할 리소스 판단 (명시적으로 지정한 리소스는 제외하지 않음)
+func exportSkipReason(object map[string]interface{}, explicit, includeSecrets bool) string {
+	if explicit {
+		return ""
+	}
+
+	kind := utils.GetNestedString(object, "kind")
+	name := utils.GetNestedString(object, "metadata", "name")
+	switch {
+	case len(utils.GetNestedSlice(object, "metadata", "ownerReferences")) > 0:
+		return "컨트롤러가 생성한 리소스"
+	case kind == "ConfigMap" && name == "kube-root-ca.crt":
+		return "클러스터가 자동 생성한 ConfigMap"
+	case kind == "ServiceAccount" && name == "default":
+		return "기본 ServiceAccount"
+	case kind == "Secret" && !includeSecrets:
+		return "Secret은 includeSecrets 지정 시에만 내보냄"
+	case kind == "Secret" && utils.GetNestedString(object, "type") == "kubernetes.io/service-account-token":
+		return "ServiceAccount 토큰"
+	}
+	return ""
+}
+
+// CleanExportedObject - 서버가 채운 필드 제거 (status, metadata 시스템 필드, 할당된 IP, 기본 어노테이션 등)
+func CleanExportedObject(object map[string]interface{}, keepNamespace bool) {
+	delete(object, "status")
+
+	if metadata := utils.GetNestedMap(object, "metadata"); metadata != nil {
+		cleanMetadata(metadata)
+		if !keepNamespace {
+			delete(metadata, "namespace")
+		}
+	}
+
+	spec := utils.GetNestedMap(object, "spec")
+	if spec == nil {
+		return
+	}
+
+	// 파드 템플릿의 metadata.creationTimestamp: null 등 정리
+	if template := utils.GetNestedMap(spec, "template", "metadata"); template != nil {
+		cleanMetadata(template)
+	}
+	if template := utils.GetNestedMap(spec, "jobTemplate", "metadata"); template != nil {
+		cleanMetadata(template)
+	}
+	if template := utils.GetNestedMap(spec, "jobTemplate", "spec", "template", "metadata"); template != nil {
+		cleanMetadata(template)
+	}
+
+	switch utils.GetNestedString(object, "kind") {
+	case "Service":
+		cleanServiceSpec(spec)
+	case "PersistentVolumeClaim":
+		delete(spec, "volumeName")
+	case "Job":
+		cleanJobSpec(spec)
+	}
+}
+
+// cleanMetadata - metadata 시스템 필드와 기본 어노테이션 제거
+func cleanMetadata(metadata map[string]interface{}) {
+	for _, field := range serverMetadataFields {
+		delete(metadata, field)
+	}
+
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		for _, annotation := range defaultAnnotations {
+			delete(annotations, annotation)
+		}
+		if len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+	}
+	if labels, ok := metadata["labels"].(map[string]interface{}); ok && len(labels) == 0 {
+		delete(metadata, "labels")
+	}
+}
+
+// cleanServiceSpec - 클러스터가 할당한 IP/포트와 기본값 필드 제거 (헤드리스 clusterIP: None 은 유지)
+func cleanServiceSpec(spec map[string]interface{}) {
+	if clusterIP, _ := spec["clusterIP"].(string); clusterIP != "None" {
+		delete(spec, "clusterIP")
+		delete(spec, "clusterIPs")
+	}
+	for _, field := range []string{"healthCheckNodePort", "ipFamilies", "ipFamilyPolicy", "internalTrafficPolicy"} {
+		delete(spec, field)
+	}
+	if spec["sessionAffinity"] == "None" {
+		delete(spec, "sessionAffinity")
+	}
+
+	for _, item := range utils.GetNestedSlice(spec, "ports") {
+		if port, ok := item.(map[string]interface{}); ok {
+			delete(port, "nodePort")
+		}
+	}
+}
+
+// cleanJobSpec - Job 컨트롤러가 추가한 셀렉터/라벨 제거 (재적용 시 selector 불일치 방지)
+func cleanJobSpec(spec map[string]interface{}) {
+	if manual, _ := spec["manualSelector"].(bool); !manual {
+		delete(spec, "selector")
+	}
+	if labels := utils.GetNestedMap(spec, "template", "metadata", "labels"); labels != nil {
+		for _, label := range jobControllerLabels {
+			delete(labels, label)
+		}
+		if len(labels) == 0 {
+			delete(utils.GetNestedMap(spec, "template", "metadata"), "labels")
+		}
+	}
+}
+
+// exportReference - kind/name 형식 참조
+func exportReference(object map[string]interface{}) string {
+	return strings.ToLower(utils.GetNestedString(object, "kind")) + "/" + utils.GetNestedString(object, "metadata", "name")
+}
+
+// exportKindRank - 적용 순서 (지정되지 않은 종류는 뒤로)
+func exportKindRank(object map[string]interface{}) int {
+	if rank, ok := exportKindOrder[utils.GetNestedString(object, "kind")]; ok {
+		return rank
+	}
+	return len(exportKindOrder)
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -16,23 +17,32 @@ import (
 
 // GitService - Git 관련 서비스
 type GitService struct {
-	tempDir     string
-	kubeService *KubeService
+	tempDir          string
+	kubeService      *KubeService
+	kustomizeService *KustomizeService
+	executor         utils.CommandExecutor
 }
 
 // NewGitService - Git 서비스 생성자
 func NewGitService() *GitService {
+	return NewGitServiceWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewGitServiceWithExecutor - 지정한 명령 실행기를 사용하는 Git 서비스 생성자 (git, kubectl 모두 같은 실행기 사용)
+func NewGitServiceWithExecutor(executor utils.CommandExecutor) *GitService {
 	tempDir := filepath.Join(os.TempDir(), "kubectl-git-repos")
 	os.MkdirAll(tempDir, 0755)
 
 	return &GitService{
-		tempDir:     tempDir,
-		kubeService: NewKubeService(),
+		tempDir:          tempDir,
+		kubeService:      NewKubeServiceWithExecutor(executor),
+		kustomizeService: NewKustomizeServiceWithExecutor(executor),
+		executor:         executor,
 	}
 }
 
 // CloneRepository - Git 레포지토리 클론
-func (gs *GitService) CloneRepository(repoURL, branch string) (string, error) {
+func (gs *GitService) CloneRepository(ctx context.Context, repoURL, branch string) (string, error) {
 	log.Printf("📦 Git 레포지토리 클론 시작: %s (branch: %s)", repoURL, branch)
 
 	// 레포지토리 이름 추출
@@ -42,7 +52,7 @@ func (gs *GitService) CloneRepository(repoURL, branch string) (string, error) {
 	}
 
 	// 클론 대상 디렉토리
-	cloneDir := filepath.Join(gs.tempDir, fmt.Sprintf("%s_%d", repoName, time.Now().Unix()))
+	cloneDir := filepath.Join(gs.tempDir, fmt.Sprintf("%s_%d", repoName, time.Now().UnixNano()))
 
 	// 기존 디렉토리가 있으면 삭제
 	if utils.FileExists(cloneDir) {
@@ -60,10 +70,12 @@ func (gs *GitService) CloneRepository(repoURL, branch string) (string, error) {
 	// 얕은 클론으로 속도 향상
 	args = append(args, "--depth", "1", repoURL, cloneDir)
 
+	ctx, cancel := context.WithTimeout(ctx, utils.GitCommandTimeout)
+	defer cancel()
 	// git clone 실행
-	_, err := utils.ExecuteCommand("git", args...)
+	_, err := gs.executor.Execute(ctx, "git", args...)
 	if err != nil {
-		return "", fmt.Errorf("Git 클론 실패: %v", err)
+		return "", fmt.Errorf("Git 클론 실패: %w", err)
 	}
 
 	log.Printf("✅ Git 레포지토리 클론 완료: %s", cloneDir)
@@ -86,6 +98,17 @@ func (gs *GitService) FindYamlFiles(repoDir string) ([]model.GitYamlFile, error)
 			return filepath.SkipDir
 		}
 
+		// kustomization 디렉토리는 개별 파일로 적용하지 않고 빌드 대상으로 처리
+		if info.IsDir() && IsKustomizationDir(path) {
+			return filepath.SkipDir
+		}
+
+		// Helm 차트의 템플릿은 렌더링 전에는 유효한 매니페스트가 아니므로 스킵
+		if info.IsDir() && IsHelmChartDir(path) {
+			log.Printf("ℹ️ Helm 차트 디렉토리 스킵 (/api/helm 사용): %s", path)
+			return filepath.SkipDir
+		}
+
 		// YAML 파일 확인
 		if !info.IsDir() && gs.isYamlFile(info.Name()) {
 			relativePath, _ := filepath.Rel(repoDir, path)
@@ -114,7 +137,7 @@ func (gs *GitService) FindYamlFiles(repoDir string) ([]model.GitYamlFile, error)
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("디렉토리 탐색 실패: %v", err)
+		return nil, fmt.Errorf("디렉토리 탐색 실패: %w", err)
 	}
 
 	log.Printf("✅ YAML 파일 검색 완료: %d개 발견", len(yamlFiles))
@@ -122,7 +145,7 @@ func (gs *GitService) FindYamlFiles(repoDir string) ([]model.GitYamlFile, error)
 }
 
 // GetSpecificYamlFile - 특정 YAML 파일 가져오기
-func (gs *GitService) GetSpecificYamlFile(repoDir, filename string) (*model.GitYamlFile, error) {
+func (gs *GitService) GetSpecificYamlFile(ctx context.Context, repoDir, filename string) (*model.GitYamlFile, error) {
 	log.Printf("📄 특정 YAML 파일 검색: %s", filename)
 
 	var foundFile *model.GitYamlFile
@@ -143,7 +166,18 @@ func (gs *GitService) GetSpecificYamlFile(repoDir, filename string) (*model.GitY
 
 			content, err := ioutil.ReadFile(path)
 			if err != nil {
-				return fmt.Errorf("파일 읽기 실패: %v", err)
+				return fmt.Errorf("파일 읽기 실패: %w", err)
+			}
+
+			// kustomization 파일이면 해당 디렉토리를 빌드한 결과를 반환
+			if IsKustomizationFile(info.Name()) {
+				relativeDir, _ := filepath.Rel(repoDir, filepath.Dir(path))
+				rendered, err := gs.BuildKustomization(ctx, repoDir, relativeDir)
+				if err != nil {
+					return err
+				}
+				foundFile = rendered
+				return fmt.Errorf("found")
 			}
 
 			foundFile = &model.GitYamlFile{
@@ -160,7 +194,7 @@ func (gs *GitService) GetSpecificYamlFile(repoDir, filename string) (*model.GitY
 	})
 
 	if err != nil && err.Error() != "found" {
-		return nil, fmt.Errorf("파일 검색 실패: %v", err)
+		return nil, fmt.Errorf("파일 검색 실패: %w", err)
 	}
 
 	if foundFile == nil {
@@ -171,26 +205,153 @@ func (gs *GitService) GetSpecificYamlFile(repoDir, filename string) (*model.GitY
 	return foundFile, nil
 }
 
-// ApplyYamlFromGit - Git에서 가져온 YAML 적용
-func (gs *GitService) ApplyYamlFromGit(yamlFiles []model.GitYamlFile, namespace string, dryRun bool) (*model.GitApplyResult, error) {
+// FindManifests - 일반 YAML 파일과 kustomization 빌드 결과를 함께 수집
+// kustomizePath가 지정되면 해당 overlay만 빌드하고, 없으면 유일한 최종 kustomization을 자동 빌드한다.
+// 최종 kustomization이 여러 개인데 경로가 없으면 requireBuild일 때 에러, 아니면 목록만 반환한다.
+func (gs *GitService) FindManifests(ctx context.Context, repoDir, kustomizePath string, requireBuild bool) ([]model.GitYamlFile, []model.KustomizeTarget, error) {
+	targets, err := gs.kustomizeService.FindKustomizations(repoDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 특정 overlay 지정 시 해당 빌드 결과만 사용
+	if kustomizePath != "" {
+		target, err := gs.kustomizeService.SelectTarget(targets, kustomizePath)
+		if err != nil {
+			return nil, targets, err
+		}
+		rendered, err := gs.BuildKustomization(ctx, repoDir, target.Path)
+		if err != nil {
+			return nil, targets, err
+		}
+		return []model.GitYamlFile{*rendered}, targets, nil
+	}
+
+	yamlFiles, err := gs.FindYamlFiles(repoDir)
+	if err != nil {
+		return nil, targets, err
+	}
+
+	if len(targets) > 0 {
+		target, err := gs.kustomizeService.SelectTarget(targets, "")
+		if err != nil {
+			if requireBuild {
+				return nil, targets, err
+			}
+			log.Printf("ℹ️ kustomization 자동 빌드 생략: %v", err)
+			return yamlFiles, targets, nil
+		}
+		rendered, err := gs.BuildKustomization(ctx, repoDir, target.Path)
+		if err != nil {
+			return nil, targets, err
+		}
+		yamlFiles = append(yamlFiles, *rendered)
+	}
+
+	return yamlFiles, targets, nil
+}
+
+// BuildKustomization - kustomization 디렉토리를 빌드하여 하나의 YAML 파일처럼 반환
+func (gs *GitService) BuildKustomization(ctx context.Context, repoDir, kustomizePath string) (*model.GitYamlFile, error) {
+	build, err := gs.kustomizeService.Build(ctx, repoDir, kustomizePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GitYamlFile{
+		Path:          build.Path + " (kustomize)",
+		FullPath:      filepath.Join(repoDir, filepath.FromSlash(build.Path)),
+		Content:       build.RenderedYaml,
+		Size:          int64(len(build.RenderedYaml)),
+		IsKubernetes:  true,
+		KustomizePath: build.Path,
+	}, nil
+}
+
+// ApplyYamlFromGit - Git에서 가져온 YAML 적용 (environment/variables가 있으면 ${VAR} 치환)
+func (gs *GitService) ApplyYamlFromGit(ctx context.Context, yamlFiles []model.GitYamlFile, namespace string, dryRun bool, environment string, variables map[string]string) (*model.GitApplyResult, error) {
+	return gs.applyYamlFiles(ctx, nil, yamlFiles, namespace, dryRun, environment, variables)
+}
+
+// ApplyRepositoryJob - 레포지토리 클론부터 적용까지 작업으로 실행 (파일별 진행 상황 보고, 취소 시 남은 파일 건너뜀)
+// 취소로 건너뛴 파일이 있으면 그때까지의 적용 결과와 함께 오류를 반환하여 작업이 성공으로 기록되지 않게 함
+func (gs *GitService) ApplyRepositoryJob(run *JobRun, request model.GitApplyRequest) (*model.GitApplyData, error) {
+	ctx := run.Context()
+	if request.Branch == "" {
+		request.Branch = "main"
+	}
+
+	run.Logf("레포지토리 클론: %s (branch: %s)", request.RepoURL, request.Branch)
+	repoDir, err := gs.CloneRepository(ctx, request.RepoURL, request.Branch)
+	if err != nil {
+		run.Logf("클론 실패: %v", err)
+		return nil, err
+	}
+	defer gs.Cleanup(repoDir)
+
+	var yamlFiles []model.GitYamlFile
+	if request.Filename != "" && request.KustomizePath == "" {
+		yamlFile, err := gs.GetSpecificYamlFile(ctx, repoDir, request.Filename)
+		if err != nil {
+			run.Logf("파일 검색 실패: %v", err)
+			return nil, err
+		}
+		yamlFiles = append(yamlFiles, *yamlFile)
+	} else {
+		yamlFiles, _, err = gs.FindManifests(ctx, repoDir, request.KustomizePath, true)
+		if err != nil {
+			run.Logf("YAML 파일 검색 실패: %v", err)
+			return nil, err
+		}
+	}
+	if len(yamlFiles) == 0 {
+		return nil, fmt.Errorf("레포지토리에서 Kubernetes YAML 파일을 찾을 수 없습니다")
+	}
+	run.Logf("적용 대상 파일 %d개", len(yamlFiles))
+
+	applyResult, err := gs.applyYamlFiles(ctx, run, yamlFiles, request.Namespace, request.DryRun, request.Environment, request.Variables)
+	if applyResult == nil {
+		return nil, err
+	}
+
+	return &model.GitApplyData{
+		RepoURL:     request.RepoURL,
+		Branch:      request.Branch,
+		ApplyResult: *applyResult,
+		RetrievedAt: time.Now().Format("2006-01-02 15:04:05"),
+	}, err
+}
+
+// applyYamlFiles - 파일별 적용 (run이 있으면 진행 상황 보고, 취소되면 남은 파일은 적용하지 않고 결과와 함께 오류 반환)
+func (gs *GitService) applyYamlFiles(ctx context.Context, run *JobRun, yamlFiles []model.GitYamlFile, namespace string, dryRun bool, environment string, variables map[string]string) (*model.GitApplyResult, error) {
 	log.Printf("🚀 Git YAML 적용 시작 (파일 수: %d, DryRun: %t)", len(yamlFiles), dryRun)
+	run.SetTotal(len(yamlFiles))
 
 	var results []model.GitFileApplyResult
 	var allResources []string
-	successCount := 0
+	var resolvedVariables map[string]string
+	successCount, skippedCount := 0, 0
 
 	for _, yamlFile := range yamlFiles {
+		if run.Cancelled() {
+			run.SkipItem(yamlFile.Path, "작업 취소")
+			skippedCount++
+			continue
+		}
 		log.Printf("📝 적용 중: %s", yamlFile.Path)
+		run.StartItem(yamlFile.Path)
 
 		// YAML 적용 요청 생성
 		applyRequest := model.ApplyYamlRequest{
 			YamlContent: yamlFile.Content,
 			Namespace:   namespace,
 			DryRun:      dryRun,
+			Environment: environment,
+			Variables:   variables,
 		}
 
 		// YAML 적용
-		applyResult, err := gs.kubeService.ApplyYaml(applyRequest)
+		applyResult, err := gs.kubeService.ApplyYaml(ctx, applyRequest)
 
 		fileResult := model.GitFileApplyResult{
 			FilePath: yamlFile.Path,
@@ -199,14 +360,27 @@ func (gs *GitService) ApplyYamlFromGit(yamlFiles []model.GitYamlFile, namespace
 
 		if err != nil {
 			fileResult.Error = err.Error()
+			if violation, ok := err.(*PolicyViolationError); ok {
+				fileResult.PolicyFindings = violation.Evaluation.Findings
+			}
 			log.Printf("❌ 적용 실패 %s: %v", yamlFile.Path, err)
+			run.Logf("적용 실패 %s: %v", yamlFile.Path, err)
 		} else {
 			fileResult.Output = applyResult.Output
 			fileResult.Resources = applyResult.Resources
+			fileResult.PolicyFindings = applyResult.PolicyFindings
 			allResources = append(allResources, applyResult.Resources...)
+			for name, value := range applyResult.ResolvedVariables {
+				if resolvedVariables == nil {
+					resolvedVariables = make(map[string]string)
+				}
+				resolvedVariables[name] = value
+			}
 			successCount++
 			log.Printf("✅ 적용 성공 %s: %d개 리소스", yamlFile.Path, len(applyResult.Resources))
+			run.Logf("적용 성공 %s: %s", yamlFile.Path, strings.Join(applyResult.Resources, ", "))
 		}
+		run.FinishItem(yamlFile.Path, err)
 
 		results = append(results, fileResult)
 	}
@@ -214,13 +388,23 @@ func (gs *GitService) ApplyYamlFromGit(yamlFiles []model.GitYamlFile, namespace
 	result := &model.GitApplyResult{
 		TotalFiles:   len(yamlFiles),
 		SuccessFiles: successCount,
-		FailedFiles:  len(yamlFiles) - successCount,
+		FailedFiles:  len(results) - successCount,
+		SkippedFiles: skippedCount,
 		AppliedTime:  time.Now().Format("2006-01-02 15:04:05"),
 		Results:      results,
 		AllResources: gs.removeDuplicates(allResources),
 		DryRun:       dryRun,
+
+		ResolvedVariables: resolvedVariables,
+	}
+	if resolvedVariables != nil {
+		result.Environment = environment
 	}
 
+	if skippedCount > 0 {
+		log.Printf("🛑 Git YAML 적용 취소 (성공: %d/%d, 건너뜀: %d)", successCount, len(yamlFiles), skippedCount)
+		return result, fmt.Errorf("작업이 취소되어 %d개 파일을 적용하지 않았습니다: %w", skippedCount, ctx.Err())
+	}
 	log.Printf("✅ Git YAML 적용 완료 (성공: %d/%d)", successCount, len(yamlFiles))
 	return result, nil
 }
@@ -243,7 +427,7 @@ func (gs *GitService) CleanupAll() error {
 	if utils.FileExists(gs.tempDir) {
 		err := os.RemoveAll(gs.tempDir)
 		if err != nil {
-			return fmt.Errorf("전체 임시 디렉토리 삭제 실패: %v", err)
+			return fmt.Errorf("전체 임시 디렉토리 삭제 실패: %w", err)
 		}
 		log.Printf("🧹 전체 임시 디렉토리 삭제 완료: %s", gs.tempDir)
 	}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 릴리스 이름 규칙 (DNS-1123 라벨)
+var helmReleaseNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// HelmService - Helm 차트 렌더링/설치 서비스 (helm 바이너리 사용)
+type HelmService struct {
+	executor      utils.CommandExecutor
+	kubeService   *KubeService
+	policyService *PolicyService
+}
+
+// NewHelmService - Helm 서비스 생성자
+func NewHelmService() *HelmService {
+	return NewHelmServiceWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewHelmServiceWithExecutor - 지정한 명령 실행기를 사용하는 Helm 서비스 생성자 (helm, kubectl 모두 같은 실행기 사용)
+func NewHelmServiceWithExecutor(executor utils.CommandExecutor) *HelmService {
+	return &HelmService{
+		executor:      executor,
+		kubeService:   NewKubeServiceWithExecutor(executor),
+		policyService: NewPolicyService(),
+	}
+}
+
+// IsHelmChartDir - 디렉토리에 Chart.yaml이 있는지 확인
+func IsHelmChartDir(dir string) bool {
+	return utils.FileExists(filepath.Join(dir, "Chart.yaml"))
+}
+
+// FindCharts - 루트 디렉토리 아래의 Helm 차트 탐색 (하위 charts/ 의존성 차트는 제외)
+func (hs *HelmService) FindCharts(rootDir string) ([]model.HelmChartInfo, error) {
+	log.Printf("🔍 Helm 차트 검색: %s", rootDir)
+
+	var charts []model.HelmChartInfo
+
+	err := filepath.Walk(rootDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if !IsHelmChartDir(path) {
+			return nil
+		}
+
+		chart, err := hs.readChart(rootDir, path)
+		if err != nil {
+			log.Printf("⚠️ Chart.yaml 읽기 실패 (스킵): %s - %v", path, err)
+		} else {
+			charts = append(charts, *chart)
+		}
+		// 차트 내부(templates, charts/ 의존성)는 더 탐색하지 않음
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, fmt.Errorf("디렉토리 탐색 실패: %w", err)
+	}
+
+	sort.Slice(charts, func(i, j int) bool { return charts[i].Path < charts[j].Path })
+	log.Printf("✅ Helm 차트 검색 완료: %d개 발견", len(charts))
+	return charts, nil
+}
+
+// readChart - Chart.yaml 메타데이터 읽기
+func (hs *HelmService) readChart(rootDir, chartDir string) (*model.HelmChartInfo, error) {
+	content, err := utils.ReadFile(filepath.Join(chartDir, "Chart.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	var chartFile struct {
+		Name        string `yaml:"name"`
+		Version     string `yaml:"version"`
+		AppVersion  string `yaml:"appVersion"`
+		Description string `yaml:"description"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &chartFile); err != nil {
+		return nil, fmt.Errorf("Chart.yaml 파싱 실패: %w", err)
+	}
+
+	relativePath, _ := filepath.Rel(rootDir, chartDir)
+	return &model.HelmChartInfo{
+		Path:        filepath.ToSlash(relativePath),
+		Name:        chartFile.Name,
+		Version:     chartFile.Version,
+		AppVersion:  chartFile.AppVersion,
+		Description: chartFile.Description,
+	}, nil
+}
+
+// SelectChart - 요청 경로에 맞는 차트 선택 (경로가 없으면 유일한 차트 선택)
+func (hs *HelmService) SelectChart(charts []model.HelmChartInfo, chartPath string) (*model.HelmChartInfo, error) {
+	chartPath = strings.Trim(filepath.ToSlash(chartPath), "/")
+	if chartPath == "" {
+		chartPath = "."
+	}
+
+	for _, chart := range charts {
+		if chart.Path == chartPath || (chartPath != "." && chart.Name == chartPath) {
+			return &chart, nil
+		}
+	}
+
+	switch {
+	case len(charts) == 0:
+		return nil, fmt.Errorf("Helm 차트(Chart.yaml)를 찾을 수 없습니다")
+	case len(charts) == 1 && chartPath == ".":
+		return &charts[0], nil
+	}
+
+	var paths []string
+	for _, chart := range charts {
+		paths = append(paths, chart.Path)
+	}
+	return nil, fmt.Errorf("차트를 선택해주세요 (chartPath): %s", strings.Join(paths, ", "))
+}
+
+// Render - 차트를 helm template으로 렌더링
+func (hs *HelmService) Render(ctx context.Context, rootDir string, chart model.HelmChartInfo, releaseName, namespace string, values map[string]interface{}) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+
+	log.Printf("🎨 Helm 차트 렌더링: %s (release: %s)", chart.Path, releaseName)
+
+	if err := hs.validateReleaseName(releaseName); err != nil {
+		return "", err
+	}
+
+	valuesFile, err := hs.writeValuesFile(values)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(valuesFile)
+
+	chartDir := filepath.Join(rootDir, filepath.FromSlash(chart.Path))
+	args := []string{"template", releaseName, chartDir,
+		"--namespace", hs.namespaceOrDefault(namespace),
+		"-f", valuesFile,
+	}
+
+	output, err := hs.executor.Execute(ctx, "helm", args...)
+	if err != nil {
+		return "", fmt.Errorf("helm template 실패: %w", err)
+	}
+
+	log.Printf("✅ Helm 차트 렌더링 완료: %s", chart.Path)
+	return output, nil
+}
+
+// BuildRenderResult - 렌더링 결과 구성
+func (hs *HelmService) BuildRenderResult(charts []model.HelmChartInfo, chart model.HelmChartInfo, releaseName, namespace, rendered string) (*model.HelmRenderResult, error) {
+	documents, err := utils.ParseYamlDocuments(rendered)
+	if err != nil {
+		return nil, fmt.Errorf("렌더링 결과 파싱 실패: %w", err)
+	}
+
+	var resources []string
+	for _, document := range documents {
+		resources = append(resources, fmt.Sprintf("%s/%s",
+			strings.ToLower(utils.GetNestedString(document, "kind")),
+			utils.GetNestedString(document, "metadata", "name")))
+	}
+
+	return &model.HelmRenderResult{
+		Chart:        chart,
+		Charts:       charts,
+		ReleaseName:  releaseName,
+		Namespace:    hs.namespaceOrDefault(namespace),
+		RenderedYaml: rendered,
+		Resources:    resources,
+		RenderedTime: time.Now().Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+// UpgradeInstall - 정책 검사 후 helm upgrade --install 실행
+func (hs *HelmService) UpgradeInstall(ctx context.Context, rootDir string, chart model.HelmChartInfo, releaseName, namespace string, values map[string]interface{}, dryRun bool) (*model.HelmInstallResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.HelmCommandTimeout)
+	defer cancel()
+
+	log.Printf("🚀 Helm 설치/업그레이드: %s (release: %s, DryRun: %t)", chart.Path, releaseName, dryRun)
+
+	namespace = hs.namespaceOrDefault(namespace)
+
+	// 적용 전 정책 검사 (렌더링 결과 기준)
+	rendered, err := hs.Render(ctx, rootDir, chart, releaseName, namespace, values)
+	if err != nil {
+		return nil, err
+	}
+	evaluation, err := hs.policyService.Evaluate(rendered, hs.kubeService.GetCurrentContext(ctx), namespace)
+	if err != nil {
+		return nil, fmt.Errorf("정책 평가 실패: %w", err)
+	}
+	if !evaluation.Allowed {
+		return nil, &PolicyViolationError{Evaluation: evaluation}
+	}
+
+	valuesFile, err := hs.writeValuesFile(values)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(valuesFile)
+
+	chartDir := filepath.Join(rootDir, filepath.FromSlash(chart.Path))
+	args := []string{"upgrade", "--install", releaseName, chartDir,
+		"--namespace", namespace,
+		"--create-namespace",
+		"-f", valuesFile,
+	}
+	if dryRun {
+		args = append(args, "--dry-run")
+	}
+
+	output, err := hs.executor.Execute(ctx, "helm", args...)
+	if err != nil {
+		return nil, fmt.Errorf("helm upgrade --install 실패: %w", err)
+	}
+
+	result := &model.HelmInstallResult{
+		Chart:         chart,
+		Output:        output,
+		DryRun:        dryRun,
+		InstalledTime: time.Now().Format("2006-01-02 15:04:05"),
+		Release: model.HelmRelease{
+			Name:      releaseName,
+			Namespace: namespace,
+			Chart:     chart.Name + "-" + chart.Version,
+		},
+	}
+	if len(evaluation.Findings) > 0 {
+		result.PolicyFindings = evaluation.Findings
+	}
+
+	// 실제 설치인 경우 릴리스 상태 조회
+	if !dryRun {
+		if release, err := hs.GetRelease(ctx, namespace, releaseName); err != nil {
+			log.Printf("⚠️ 릴리스 상태 조회 실패 (무시): %v", err)
+		} else {
+			result.Release = *release
+		}
+	}
+
+	log.Printf("✅ Helm 설치/업그레이드 완료: %s", releaseName)
+	return result, nil
+}
+
+// ListReleases - 릴리스 목록 조회 (namespace가 비어있으면 전체)
+func (hs *HelmService) ListReleases(ctx context.Context, namespace string) ([]model.HelmRelease, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+
+	log.Printf("📋 Helm 릴리스 목록 조회 (namespace: %s)", namespace)
+
+	args := []string{"list", "-o", "json"}
+	if namespace != "" {
+		args = append(args, "--namespace", namespace)
+	} else {
+		args = append(args, "--all-namespaces")
+	}
+
+	output, err := hs.executor.Execute(ctx, "helm", args...)
+	if err != nil {
+		return nil, fmt.Errorf("helm list 실패: %w", err)
+	}
+
+	releases := []model.HelmRelease{}
+	if err := json.Unmarshal([]byte(output), &releases); err != nil {
+		return nil, fmt.Errorf("helm list 결과 파싱 실패: %w", err)
+	}
+
+	log.Printf("✅ Helm 릴리스 목록 조회 완료 (총 %d개)", len(releases))
+	return releases, nil
+}
+
+// GetRelease - 특정 릴리스 조회
+func (hs *HelmService) GetRelease(ctx context.Context, namespace, releaseName string) (*model.HelmRelease, error) {
+	releases, err := hs.ListReleases(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, release := range releases {
+		if release.Name == releaseName {
+			return &release, nil
+		}
+	}
+	return nil, fmt.Errorf("릴리스를 찾을 수 없습니다: %s/%s", namespace, releaseName)
+}
+
+// GetReleaseHistory - 릴리스 리비전 이력 조회
+func (hs *HelmService) GetReleaseHistory(ctx context.Context, namespace, releaseName string) ([]model.HelmReleaseRevision, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+
+	log.Printf("📜 Helm 릴리스 이력 조회: %s/%s", namespace, releaseName)
+
+	if err := hs.validateReleaseName(releaseName); err != nil {
+		return nil, err
+	}
+
+	output, err := hs.executor.Execute(ctx, "helm", "history", releaseName,
+		"--namespace", hs.namespaceOrDefault(namespace), "-o", "json")
+	if err != nil {
+		return nil, fmt.Errorf("helm history 실패: %w", err)
+	}
+
+	history := []model.HelmReleaseRevision{}
+	if err := json.Unmarshal([]byte(output), &history); err != nil {
+		return nil, fmt.Errorf("helm history 결과 파싱 실패: %w", err)
+	}
+	return history, nil
+}
+
+// writeValuesFile - values 맵을 임시 YAML 파일로 저장
+func (hs *HelmService) writeValuesFile(values map[string]interface{}) (string, error) {
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+
+	data, err := yaml.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("values 직렬화 실패: %w", err)
+	}
+
+	valuesFile := filepath.Join(os.TempDir(), fmt.Sprintf("helm-values-%d.yaml", time.Now().UnixNano()))
+	if err := os.WriteFile(valuesFile, data, 0600); err != nil {
+		return "", fmt.Errorf("values 파일 쓰기 실패: %w", err)
+	}
+	return valuesFile, nil
+}
+
+// validateReleaseName - 릴리스 이름 검증
+func (hs *HelmService) validateReleaseName(releaseName string) error {
+	if !helmReleaseNamePattern.MatchString(releaseName) || len(releaseName) > 53 {
+		return fmt.Errorf("잘못된 릴리스 이름입니다: %s (소문자, 숫자, '-'만 허용, 최대 53자)", releaseName)
+	}
+	return nil
+}
+
+// namespaceOrDefault - 네임스페이스 기본값 처리
+func (hs *HelmService) namespaceOrDefault(namespace string) string {
+	if namespace == "" {
+		return "default"
+	}
+	return namespace
+}
@@ -0,0 +1,451 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"mykubeapp/model"
+)
+
+// 비동기 작업 제한
+const (
+	maxRunningJobs     = 5               // 동시에 실행할 수 있는 작업 수
+	maxJobLogEntries   = 1000            // 작업당 보관하는 로그 수 (초과 시 오래된 로그부터 삭제)
+	jobRetention       = 1 * time.Hour   // 종료된 작업 보관 시간
+	jobJanitorCycle    = 1 * time.Minute // 종료된 작업 정리 주기
+	jobSubscriberQueue = 64              // 구독자별 이벤트 버퍼
+)
+
+// JobNotFoundError - 작업이 없거나 보관 기간이 지나 삭제됨
+type JobNotFoundError struct {
+	ID string
+}
+
+func (e *JobNotFoundError) Error() string {
+	return fmt.Sprintf("작업을 찾을 수 없습니다: %s", e.ID)
+}
+
+// JobNotFinishedError - 아직 종료되지 않은 작업의 결과 조회
+type JobNotFinishedError struct {
+	ID     string
+	Status string
+}
+
+func (e *JobNotFinishedError) Error() string {
+	return fmt.Sprintf("작업이 아직 종료되지 않았습니다: %s (%s)", e.ID, e.Status)
+}
+
+// JobFunc - 작업 본문 (run으로 진행 상황/로그를 보고하고, run.Context()가 취소되면 중단)
+type JobFunc func(run *JobRun) (interface{}, error)
+
+// jobEntry - 작업 상태와 로그, 결과, 구독자
+type jobEntry struct {
+	job          model.Job
+	logs         []model.JobLogEntry
+	result       interface{}
+	cancel       context.CancelFunc
+	subscribers  map[chan model.JobEvent]struct{}
+	finishedTime time.Time
+}
+
+// JobService - 오래 걸리는 작업을 백그라운드로 실행하고 상태/진행 상황/로그/결과 관리
+type JobService struct {
+	mutex sync.Mutex
+	jobs  map[string]*jobEntry
+}
+
+// NewJobService - 작업 서비스 생성자 (종료된 작업 정리 고루틴 시작)
+func NewJobService() *JobService {
+	js := &JobService{
+		jobs: make(map[string]*jobEntry),
+	}
+	go js.runJanitor()
+	return js
+}
+
+// Submit - 작업 등록 후 백그라운드 실행
+func (js *JobService) Submit(jobType, description string, fn JobFunc) (*model.Job, error) {
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	running := 0
+	for _, entry := range js.jobs {
+		if entry.finishedTime.IsZero() {
+			running++
+		}
+	}
+	if running >= maxRunningJobs {
+		return nil, fmt.Errorf("동시에 실행할 수 있는 작업은 최대 %d개입니다", maxRunningJobs)
+	}
+
+	now := time.Now()
+	ctx, cancel := context.WithCancel(context.Background())
+	entry := &jobEntry{
+		job: model.Job{
+			ID:          fmt.Sprintf("job_%d", now.UnixNano()),
+			Type:        jobType,
+			Description: description,
+			Status:      model.JobStatusPending,
+			Progress:    model.JobProgress{Items: []model.JobProgressItem{}},
+			CreatedTime: now.Format("2006-01-02 15:04:05"),
+		},
+		cancel:      cancel,
+		subscribers: make(map[chan model.JobEvent]struct{}),
+	}
+	js.jobs[entry.job.ID] = entry
+
+	log.Printf("🧵 작업 등록: %s (%s) %s", entry.job.ID, jobType, description)
+
+	run := &JobRun{service: js, entry: entry, ctx: ctx}
+	go js.execute(run, fn)
+
+	job := js.snapshot(entry)
+	return &job, nil
+}
+
+// execute - 작업 실행 후 결과/상태 기록 (패닉도 실패로 기록)
+// 상태는 작업 결과로 먼저 판단하므로 취소 요청 후에도 작업이 끝까지 성공하면 성공,
+// 시작 전에 취소되었거나 취소 후 오류로 끝난 경우에만 취소로 기록
+func (js *JobService) execute(run *JobRun, fn JobFunc) {
+	js.mutex.Lock()
+	if run.ctx.Err() == nil {
+		run.entry.job.Status = model.JobStatusRunning
+		run.entry.job.StartedTime = time.Now().Format("2006-01-02 15:04:05")
+		js.publish(run.entry, model.JobEvent{Type: model.JobEventStatus, Job: js.jobPointer(run.entry)})
+	}
+	js.mutex.Unlock()
+
+	var result interface{}
+	var err error
+	started := run.ctx.Err() == nil
+	if started {
+		func() {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					err = fmt.Errorf("작업 실행 중 예기치 않은 오류: %v", recovered)
+				}
+			}()
+			result, err = fn(run)
+		}()
+	}
+
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	entry := run.entry
+	entry.result = result
+	switch {
+	case started && err == nil:
+		entry.job.Status = model.JobStatusSucceeded
+	case run.ctx.Err() != nil:
+		entry.job.Status = model.JobStatusCancelled
+		entry.job.Error = "사용자 요청으로 취소되었습니다"
+	default:
+		entry.job.Status = model.JobStatusFailed
+		entry.job.Error = err.Error()
+	}
+	entry.job.HasResult = result != nil
+	entry.job.Progress.Current = ""
+	entry.finishedTime = time.Now()
+	entry.job.FinishedTime = entry.finishedTime.Format("2006-01-02 15:04:05")
+	entry.cancel()
+
+	log.Printf("🧵 작업 종료: %s (%s)", entry.job.ID, entry.job.Status)
+
+	js.publish(entry, model.JobEvent{Type: model.JobEventEnd, Job: js.jobPointer(entry)})
+	for subscriber := range entry.subscribers {
+		close(subscriber)
+	}
+	entry.subscribers = map[chan model.JobEvent]struct{}{}
+}
+
+// GetJob - 작업 상태 조회
+func (js *JobService) GetJob(id string) (*model.Job, error) {
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	entry, ok := js.jobs[id]
+	if !ok {
+		return nil, &JobNotFoundError{ID: id}
+	}
+	job := js.snapshot(entry)
+	return &job, nil
+}
+
+// ListJobs - 보관 중인 작업 목록 (최근 제출 순)
+func (js *JobService) ListJobs() []model.Job {
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	jobs := make([]model.Job, 0, len(js.jobs))
+	for _, entry := range js.jobs {
+		jobs = append(jobs, js.snapshot(entry))
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].ID > jobs[j].ID
+	})
+	return jobs
+}
+
+// GetLogs - since 순번 이후의 작업 로그 조회
+func (js *JobService) GetLogs(id string, since int) ([]model.JobLogEntry, error) {
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	entry, ok := js.jobs[id]
+	if !ok {
+		return nil, &JobNotFoundError{ID: id}
+	}
+	logs := []model.JobLogEntry{}
+	for _, logEntry := range entry.logs {
+		if logEntry.Index >= since {
+			logs = append(logs, logEntry)
+		}
+	}
+	return logs, nil
+}
+
+// GetResult - 종료된 작업의 결과 조회
+func (js *JobService) GetResult(id string) (*model.Job, interface{}, error) {
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	entry, ok := js.jobs[id]
+	if !ok {
+		return nil, nil, &JobNotFoundError{ID: id}
+	}
+	job := js.snapshot(entry)
+	if entry.finishedTime.IsZero() {
+		return &job, nil, &JobNotFinishedError{ID: id, Status: job.Status}
+	}
+	return &job, entry.result, nil
+}
+
+// CancelJob - 작업 취소 요청 (이미 종료된 작업은 그대로 반환)
+func (js *JobService) CancelJob(id string) (*model.Job, error) {
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	entry, ok := js.jobs[id]
+	if !ok {
+		return nil, &JobNotFoundError{ID: id}
+	}
+	if entry.finishedTime.IsZero() {
+		log.Printf("🛑 작업 취소 요청: %s", id)
+		entry.cancel()
+		js.appendLog(entry, "작업 취소 요청됨")
+	}
+	job := js.snapshot(entry)
+	return &job, nil
+}
+
+// Subscribe - 작업 이벤트 구독 (현재 상태와 이벤트 채널 반환, 작업이 종료되면 채널이 닫힘)
+func (js *JobService) Subscribe(id string) (*model.Job, <-chan model.JobEvent, func(), error) {
+	js.mutex.Lock()
+	defer js.mutex.Unlock()
+
+	entry, ok := js.jobs[id]
+	if !ok {
+		return nil, nil, nil, &JobNotFoundError{ID: id}
+	}
+	job := js.snapshot(entry)
+
+	events := make(chan model.JobEvent, jobSubscriberQueue)
+	if !entry.finishedTime.IsZero() {
+		close(events)
+		return &job, events, func() {}, nil
+	}
+
+	entry.subscribers[events] = struct{}{}
+	unsubscribe := func() {
+		js.mutex.Lock()
+		defer js.mutex.Unlock()
+		if _, ok := entry.subscribers[events]; ok {
+			delete(entry.subscribers, events)
+			close(events)
+		}
+	}
+	return &job, events, unsubscribe, nil
+}
+
+// publish - 구독자에게 이벤트 전달 (mutex 보유 상태에서 호출, 느린 구독자는 가장 오래된 이벤트를 버림)
+func (js *JobService) publish(entry *jobEntry, event model.JobEvent) {
+	event.JobID = entry.job.ID
+	for subscriber := range entry.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			select {
+			case <-subscriber:
+			default:
+			}
+			select {
+			case subscriber <- event:
+			default:
+			}
+		}
+	}
+}
+
+// appendLog - 로그 추가 및 구독자 알림 (mutex 보유 상태에서 호출)
+func (js *JobService) appendLog(entry *jobEntry, message string) {
+	logEntry := model.JobLogEntry{
+		Index:   entry.job.LogCount,
+		Time:    time.Now().Format("2006-01-02 15:04:05"),
+		Message: message,
+	}
+	entry.job.LogCount++
+	entry.logs = append(entry.logs, logEntry)
+	if len(entry.logs) > maxJobLogEntries {
+		entry.logs = entry.logs[len(entry.logs)-maxJobLogEntries:]
+	}
+	js.publish(entry, model.JobEvent{Type: model.JobEventLog, Log: &logEntry})
+}
+
+// snapshot - 외부로 내보낼 작업 상태 복사본 (mutex 보유 상태에서 호출)
+func (js *JobService) snapshot(entry *jobEntry) model.Job {
+	job := entry.job
+	job.Progress.Items = append([]model.JobProgressItem{}, entry.job.Progress.Items...)
+	return job
+}
+
+func (js *JobService) jobPointer(entry *jobEntry) *model.Job {
+	job := js.snapshot(entry)
+	return &job
+}
+
+// runJanitor - 보관 기간이 지난 종료 작업 주기적 삭제
+func (js *JobService) runJanitor() {
+	ticker := time.NewTicker(jobJanitorCycle)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		js.mutex.Lock()
+		for id, entry := range js.jobs {
+			if !entry.finishedTime.IsZero() && time.Since(entry.finishedTime) > jobRetention {
+				delete(js.jobs, id)
+				log.Printf("🧹 보관 기간이 지난 작업 삭제: %s", id)
+			}
+		}
+		js.mutex.Unlock()
+	}
+}
+
+// JobRun - 실행 중인 작업의 진행 상황 보고 핸들 (nil이면 보고 없이 동기 실행)
+type JobRun struct {
+	service *JobService
+	entry   *jobEntry
+	ctx     context.Context
+}
+
+// Context - 작업 취소 시 취소되는 컨텍스트
+func (run *JobRun) Context() context.Context {
+	if run == nil {
+		return context.Background()
+	}
+	return run.ctx
+}
+
+// Cancelled - 작업 취소 여부
+func (run *JobRun) Cancelled() bool {
+	return run != nil && run.ctx.Err() != nil
+}
+
+// Logf - 작업 로그 기록
+func (run *JobRun) Logf(format string, args ...interface{}) {
+	if run == nil {
+		return
+	}
+	message := fmt.Sprintf(format, args...)
+
+	run.service.mutex.Lock()
+	defer run.service.mutex.Unlock()
+	run.service.appendLog(run.entry, message)
+}
+
+// SetTotal - 전체 항목 수 설정
+func (run *JobRun) SetTotal(total int) {
+	if run == nil {
+		return
+	}
+	run.service.mutex.Lock()
+	defer run.service.mutex.Unlock()
+
+	run.entry.job.Progress.Total = total
+	run.publishProgress()
+}
+
+// StartItem - 항목 처리 시작
+func (run *JobRun) StartItem(name string) {
+	if run == nil {
+		return
+	}
+	run.service.mutex.Lock()
+	defer run.service.mutex.Unlock()
+
+	run.entry.job.Progress.Current = name
+	run.setItem(name, model.JobItemStatusRunning, "")
+	run.publishProgress()
+}
+
+// FinishItem - 항목 처리 완료 (err가 있으면 실패로 기록)
+func (run *JobRun) FinishItem(name string, err error) {
+	if run == nil {
+		return
+	}
+	run.service.mutex.Lock()
+	defer run.service.mutex.Unlock()
+
+	progress := &run.entry.job.Progress
+	if err != nil {
+		progress.Failed++
+		run.setItem(name, model.JobItemStatusFailed, err.Error())
+	} else {
+		progress.Completed++
+		run.setItem(name, model.JobItemStatusSucceeded, "")
+	}
+	if progress.Current == name {
+		progress.Current = ""
+	}
+	run.publishProgress()
+}
+
+// SkipItem - 취소 등으로 처리하지 않은 항목 기록
+func (run *JobRun) SkipItem(name, reason string) {
+	if run == nil {
+		return
+	}
+	run.service.mutex.Lock()
+	defer run.service.mutex.Unlock()
+
+	run.setItem(name, model.JobItemStatusSkipped, reason)
+	run.publishProgress()
+}
+
+// setItem - 항목 상태 갱신 (없으면 추가, mutex 보유 상태에서 호출)
+func (run *JobRun) setItem(name, status, message string) {
+	item := model.JobProgressItem{
+		Name:        name,
+		Status:      status,
+		Message:     message,
+		UpdatedTime: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	items := run.entry.job.Progress.Items
+	for i := range items {
+		if items[i].Name == name {
+			items[i] = item
+			return
+		}
+	}
+	run.entry.job.Progress.Items = append(items, item)
+}
+
+// publishProgress - 진행 상황 이벤트 전달 (mutex 보유 상태에서 호출)
+func (run *JobRun) publishProgress() {
+	job := run.service.snapshot(run.entry)
+	run.service.publish(run.entry, model.JobEvent{Type: model.JobEventProgress, Progress: &job.Progress})
+}
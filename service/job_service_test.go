@@ -0,0 +1,142 @@
+package service
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+	"mykubeapp/utils/utilstest"
+)
+
+// waitJob - 작업이 종료될 때까지 대기 후 상태 반환
+func waitJob(t *testing.T, js *JobService, id string) *model.Job {
+	t.Helper()
+	for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); time.Sleep(5 * time.Millisecond) {
+		job, err := js.GetJob(id)
+		if err != nil {
+			t.Fatalf("작업 조회 실패: %v", err)
+		}
+		if job.FinishedTime != "" {
+			return job
+		}
+	}
+	t.Fatalf("작업 %s가 종료되지 않았습니다", id)
+	return nil
+}
+
+func TestJobStatusFollowsOperationResult(t *testing.T) {
+	js := NewJobService()
+
+	// 취소 요청 후에도 작업이 끝까지 성공하면 성공으로 기록
+	started, release := make(chan struct{}), make(chan struct{})
+	job, err := js.Submit("test", "취소를 무시하고 완료", func(run *JobRun) (interface{}, error) {
+		close(started)
+		<-release
+		return "applied", nil
+	})
+	if err != nil {
+		t.Fatalf("작업 등록 실패: %v", err)
+	}
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		failed := waitJob(t, js, job.ID)
+		t.Fatalf("apply가 호출되지 않았습니다: %s %s", failed.Status, failed.Error)
+	}
+	js.CancelJob(job.ID)
+	close(release)
+	if finished := waitJob(t, js, job.ID); finished.Status != model.JobStatusSucceeded || !finished.HasResult {
+		t.Fatalf("작업 상태 = %s (결과 %t), 기대값 %s", finished.Status, finished.HasResult, model.JobStatusSucceeded)
+	}
+
+	// 취소 후 오류로 끝나면 취소로 기록
+	started = make(chan struct{})
+	job, err = js.Submit("test", "취소되면 중단", func(run *JobRun) (interface{}, error) {
+		close(started)
+		<-run.Context().Done()
+		return nil, run.Context().Err()
+	})
+	if err != nil {
+		t.Fatalf("작업 등록 실패: %v", err)
+	}
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		failed := waitJob(t, js, job.ID)
+		t.Fatalf("apply가 호출되지 않았습니다: %s %s", failed.Status, failed.Error)
+	}
+	js.CancelJob(job.ID)
+	if finished := waitJob(t, js, job.ID); finished.Status != model.JobStatusCancelled {
+		t.Fatalf("작업 상태 = %s, 기대값 %s", finished.Status, model.JobStatusCancelled)
+	}
+}
+
+func TestGitApplyJobCancelledMidRun(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	executor := utilstest.NewFakeExecutor().
+		On("kubectl config current-context", "dev\n").
+		On("kubectl api-resources", "configmaps   cm   v1   true   ConfigMap\n").
+		On("kubectl config view --minify", "default").
+		On("kubectl get -f", "").
+		On("kubectl create -f", strings.Repeat(`{"kind": "SelfSubjectAccessReview", "status": {"allowed": true}}`+"\n", 2))
+	executor.OnFunc("git clone", func(call utilstest.FakeCommandCall) (string, error) {
+		cloneDir := call.Args[len(call.Args)-1]
+		for i := 1; i <= 3; i++ {
+			content := fmt.Sprintf("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app-%d\n", i)
+			if err := utils.WriteFile(filepath.Join(cloneDir, fmt.Sprintf("app-%d.yaml", i)), content); err != nil {
+				return "", err
+			}
+		}
+		return "", nil
+	})
+
+	// 첫 파일 적용 중에 작업을 취소
+	started, release := make(chan struct{}), make(chan struct{})
+	executor.OnFunc("kubectl apply", func(call utilstest.FakeCommandCall) (string, error) {
+		close(started)
+		<-release
+		return "configmap/app-1 created\n", nil
+	})
+
+	js := NewJobService()
+	gitService := NewGitServiceWithExecutor(executor)
+	job, err := js.Submit(model.JobTypeGitApply, "demo", func(run *JobRun) (interface{}, error) {
+		data, err := gitService.ApplyRepositoryJob(run, model.GitApplyRequest{RepoURL: "https://github.com/example/demo.git"})
+		if data == nil {
+			return nil, err
+		}
+		return data, err
+	})
+	if err != nil {
+		t.Fatalf("작업 등록 실패: %v", err)
+	}
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		failed := waitJob(t, js, job.ID)
+		t.Fatalf("apply가 호출되지 않았습니다: %s %s", failed.Status, failed.Error)
+	}
+	js.CancelJob(job.ID)
+	close(release)
+
+	// 일부만 적용하고 나머지를 건너뛴 작업은 성공이 아닌 취소
+	finished := waitJob(t, js, job.ID)
+	if finished.Status != model.JobStatusCancelled || !finished.HasResult {
+		t.Fatalf("작업 상태 = %s (결과 %t), 기대값 %s", finished.Status, finished.HasResult, model.JobStatusCancelled)
+	}
+	_, result, err := js.GetResult(job.ID)
+	if err != nil {
+		t.Fatalf("결과 조회 실패: %v", err)
+	}
+	applied := result.(*model.GitApplyData).ApplyResult
+	if applied.TotalFiles != 3 || applied.SuccessFiles != 1 || applied.SkippedFiles != 2 {
+		t.Fatalf("적용 결과 = 전체 %d, 성공 %d, 건너뜀 %d", applied.TotalFiles, applied.SuccessFiles, applied.SkippedFiles)
+	}
+	if calls := executor.CallsTo("kubectl apply"); len(calls) != 1 {
+		t.Fatalf("apply 호출 수 = %d, 기대값 1", len(calls))
+	}
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"gopkg.in/yaml.v2" // YAML 파싱을 위해 추가 필요
 	"log"
@@ -10,17 +11,30 @@ import (
 	"strings"
 	"time"
 
+	"mykubeapp/kubernetes"
 	"mykubeapp/model"
 	"mykubeapp/utils"
 )
 
 // KubeService - Spring의 @Service와 유사한 역할
 type KubeService struct {
-	configPath string
+	configPath       string
+	policyService    *PolicyService
+	variableService  *VariableService
+	schemaService    *SchemaService
+	namespaceManager *kubernetes.NamespaceManager
+	executor         utils.CommandExecutor
+	cluster          kubernetes.ClusterBackend
+	discovery        *kubernetes.DiscoveryCache // context별 리소스 종류 캐시 (권한 사전 검사에서 사용)
 }
 
 // NewKubeService - 서비스 생성자
 func NewKubeService() *KubeService {
+	return NewKubeServiceWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewKubeServiceWithExecutor - 지정한 명령 실행기를 사용하는 서비스 생성자 (테스트에서 가짜 실행기 주입)
+func NewKubeServiceWithExecutor(executor utils.CommandExecutor) *KubeService {
 	// 홈 디렉토리의 .kube/config 경로 설정
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -31,8 +45,17 @@ func NewKubeService() *KubeService {
 	configPath := filepath.Join(homeDir, ".kube", "config")
 	log.Printf("🔧 Kube config 경로: %s", configPath)
 
+	cluster := kubernetes.NewClusterBackend(executor)
+
 	return &KubeService{
-		configPath: configPath,
+		configPath:       configPath,
+		policyService:    NewPolicyService(),
+		variableService:  NewVariableService(),
+		schemaService:    NewSchemaService(),
+		namespaceManager: kubernetes.NewNamespaceManagerWithExecutor(executor),
+		executor:         executor,
+		cluster:          cluster,
+		discovery:        kubernetes.NewDiscoveryCache(cluster),
 	}
 }
 
@@ -48,7 +71,7 @@ func (ks *KubeService) GetCurrentConfig() (string, error) {
 	// 파일 내용 읽기
 	content, err := utils.ReadFile(ks.configPath)
 	if err != nil {
-		return "", fmt.Errorf("config 파일 읽기 실패: %v", err)
+		return "", fmt.Errorf("config 파일 읽기 실패: %w", err)
 	}
 
 	log.Printf("✅ Config 파일 읽기 성공 (크기: %d bytes)", len(content))
@@ -56,7 +79,10 @@ func (ks *KubeService) GetCurrentConfig() (string, error) {
 }
 
 // AddConfig - kubectl 명령어를 사용하여 새로운 config 추가
-func (ks *KubeService) AddConfig(request model.AddConfigRequest) error {
+func (ks *KubeService) AddConfig(ctx context.Context, request model.AddConfigRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.ConfigCommandTimeout)
+	defer cancel()
+
 	log.Printf("📝 Config 추가 요청: %s", request.ClusterName)
 
 	// 기존 config 백업
@@ -67,21 +93,21 @@ func (ks *KubeService) AddConfig(request model.AddConfigRequest) error {
 	}
 
 	// kubectl 명령어를 사용하여 클러스터 추가
-	err := ks.addClusterConfig(request)
+	err := ks.addClusterConfig(ctx, request)
 	if err != nil {
-		return fmt.Errorf("클러스터 설정 추가 실패: %v", err)
+		return fmt.Errorf("클러스터 설정 추가 실패: %w", err)
 	}
 
 	// 사용자 자격 증명 추가
-	err = ks.addUserConfig(request)
+	err = ks.addUserConfig(ctx, request)
 	if err != nil {
-		return fmt.Errorf("사용자 설정 추가 실패: %v", err)
+		return fmt.Errorf("사용자 설정 추가 실패: %w", err)
 	}
 
 	// 컨텍스트 추가
-	err = ks.addContextConfig(request)
+	err = ks.addContextConfig(ctx, request)
 	if err != nil {
-		return fmt.Errorf("컨텍스트 설정 추가 실패: %v", err)
+		return fmt.Errorf("컨텍스트 설정 추가 실패: %w", err)
 	}
 
 	log.Printf("✅ Config 추가 완료: %s", request.ClusterName)
@@ -89,7 +115,7 @@ func (ks *KubeService) AddConfig(request model.AddConfigRequest) error {
 }
 
 // addClusterConfig - 클러스터 설정 추가
-func (ks *KubeService) addClusterConfig(request model.AddConfigRequest) error {
+func (ks *KubeService) addClusterConfig(ctx context.Context, request model.AddConfigRequest) error {
 	log.Printf("🔧 클러스터 설정 추가: %s", request.ClusterName)
 
 	// kubectl config set-cluster 명령 실행
@@ -101,9 +127,9 @@ func (ks *KubeService) addClusterConfig(request model.AddConfigRequest) error {
 	// 인증서 검증 스킵 (개발용)
 	args = append(args, "--insecure-skip-tls-verify=true")
 
-	_, err := utils.ExecuteCommand("kubectl", args...)
+	_, err := ks.executor.Execute(ctx, "kubectl", args...)
 	if err != nil {
-		return fmt.Errorf("클러스터 설정 실패: %v", err)
+		return fmt.Errorf("클러스터 설정 실패: %w", err)
 	}
 
 	log.Printf("✅ 클러스터 설정 완료: %s", request.ClusterName)
@@ -111,20 +137,20 @@ func (ks *KubeService) addClusterConfig(request model.AddConfigRequest) error {
 }
 
 // addUserConfig - 사용자 설정 추가
-func (ks *KubeService) addUserConfig(request model.AddConfigRequest) error {
+func (ks *KubeService) addUserConfig(ctx context.Context, request model.AddConfigRequest) error {
 	log.Printf("🔧 사용자 설정 추가: %s", request.User)
 
 	// 토큰이 있으면 토큰 기반 인증 설정
 	if request.Token != "" {
-		_, err := utils.ExecuteCommand("kubectl", "config", "set-credentials", request.User, "--token="+request.Token)
+		_, err := ks.executor.Execute(ctx, "kubectl", "config", "set-credentials", request.User, "--token="+request.Token)
 		if err != nil {
-			return fmt.Errorf("토큰 기반 사용자 설정 실패: %v", err)
+			return fmt.Errorf("토큰 기반 사용자 설정 실패: %w", err)
 		}
 	} else {
 		// 토큰이 없으면 기본 사용자만 생성
-		_, err := utils.ExecuteCommand("kubectl", "config", "set-credentials", request.User)
+		_, err := ks.executor.Execute(ctx, "kubectl", "config", "set-credentials", request.User)
 		if err != nil {
-			return fmt.Errorf("기본 사용자 설정 실패: %v", err)
+			return fmt.Errorf("기본 사용자 설정 실패: %w", err)
 		}
 	}
 
@@ -133,14 +159,14 @@ func (ks *KubeService) addUserConfig(request model.AddConfigRequest) error {
 }
 
 // addContextConfig - 컨텍스트 설정 추가
-func (ks *KubeService) addContextConfig(request model.AddConfigRequest) error {
+func (ks *KubeService) addContextConfig(ctx context.Context, request model.AddConfigRequest) error {
 	log.Printf("🔧 컨텍스트 설정 추가: %s", request.ContextName)
 
-	_, err := utils.ExecuteCommand("kubectl", "config", "set-context", request.ContextName,
+	_, err := ks.executor.Execute(ctx, "kubectl", "config", "set-context", request.ContextName,
 		"--cluster="+request.ClusterName,
 		"--user="+request.User)
 	if err != nil {
-		return fmt.Errorf("컨텍스트 설정 실패: %v", err)
+		return fmt.Errorf("컨텍스트 설정 실패: %w", err)
 	}
 
 	log.Printf("✅ 컨텍스트 설정 완료: %s", request.ContextName)
@@ -148,17 +174,20 @@ func (ks *KubeService) addContextConfig(request model.AddConfigRequest) error {
 }
 
 // GetContexts - kubectl config get-contexts 실행하여 context 목록 반환
-func (ks *KubeService) GetContexts() ([]model.ContextInfo, error) {
+func (ks *KubeService) GetContexts(ctx context.Context) ([]model.ContextInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.ConfigCommandTimeout)
+	defer cancel()
+
 	log.Println("📋 Context 목록 조회 중...")
 
 	// kubectl config get-contexts 명령 실행 (이름만)
-	output, err := utils.ExecuteCommand("kubectl", "config", "get-contexts", "--output=name")
+	output, err := ks.executor.Execute(ctx, "kubectl", "config", "get-contexts", "--output=name")
 	if err != nil {
-		return nil, fmt.Errorf("kubectl 명령 실행 실패: %v", err)
+		return nil, fmt.Errorf("kubectl 명령 실행 실패: %w", err)
 	}
 
 	// 현재 context 조회
-	currentContext, err := utils.ExecuteCommand("kubectl", "config", "current-context")
+	currentContext, err := ks.executor.Execute(ctx, "kubectl", "config", "current-context")
 	if err != nil {
 		log.Printf("⚠️  현재 context 조회 실패: %v", err)
 		currentContext = ""
@@ -183,14 +212,30 @@ func (ks *KubeService) GetContexts() ([]model.ContextInfo, error) {
 	return contexts, nil
 }
 
+// GetCurrentContext - 현재 context 이름 조회 (실패 시 빈 문자열)
+func (ks *KubeService) GetCurrentContext(ctx context.Context) string {
+	ctx, cancel := context.WithTimeout(ctx, utils.ConfigCommandTimeout)
+	defer cancel()
+
+	currentContext, err := ks.executor.Execute(ctx, "kubectl", "config", "current-context")
+	if err != nil {
+		log.Printf("⚠️  현재 context 조회 실패: %v", err)
+		return ""
+	}
+	return strings.TrimSpace(currentContext)
+}
+
 // UseContext - 특정 context 사용 설정
-func (ks *KubeService) UseContext(contextName string) error {
+func (ks *KubeService) UseContext(ctx context.Context, contextName string) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.ConfigCommandTimeout)
+	defer cancel()
+
 	log.Printf("🔄 Context 변경: %s", contextName)
 
 	// kubectl config use-context 명령 실행
-	_, err := utils.ExecuteCommand("kubectl", "config", "use-context", contextName)
+	_, err := ks.executor.Execute(ctx, "kubectl", "config", "use-context", contextName)
 	if err != nil {
-		return fmt.Errorf("context 변경 실패: %v", err)
+		return fmt.Errorf("context 변경 실패: %w", err)
 	}
 
 	log.Printf("✅ Context 변경 완료: %s", contextName)
@@ -198,7 +243,10 @@ func (ks *KubeService) UseContext(contextName string) error {
 }
 
 // DeleteContext - 특정 context 삭제
-func (ks *KubeService) DeleteContext(contextName string) error {
+func (ks *KubeService) DeleteContext(ctx context.Context, contextName string) error {
+	ctx, cancel := context.WithTimeout(ctx, utils.ConfigCommandTimeout)
+	defer cancel()
+
 	log.Printf("🗑️ Context 삭제 요청: %s", contextName)
 
 	// 컨텍스트 이름 검증
@@ -207,7 +255,7 @@ func (ks *KubeService) DeleteContext(contextName string) error {
 	}
 
 	// 현재 사용 중인 컨텍스트인지 확인
-	currentContext, err := utils.ExecuteCommand("kubectl", "config", "current-context")
+	currentContext, err := ks.executor.Execute(ctx, "kubectl", "config", "current-context")
 	if err == nil {
 		currentContext = strings.TrimSpace(currentContext)
 		if currentContext == contextName {
@@ -216,9 +264,9 @@ func (ks *KubeService) DeleteContext(contextName string) error {
 	}
 
 	// 컨텍스트 존재 여부 확인
-	contexts, err := ks.GetContexts()
+	contexts, err := ks.GetContexts(ctx)
 	if err != nil {
-		return fmt.Errorf("컨텍스트 목록 조회 실패: %v", err)
+		return fmt.Errorf("컨텍스트 목록 조회 실패: %w", err)
 	}
 
 	contextExists := false
@@ -241,9 +289,9 @@ func (ks *KubeService) DeleteContext(contextName string) error {
 	}
 
 	// kubectl config delete-context 명령 실행
-	_, err = utils.ExecuteCommand("kubectl", "config", "delete-context", contextName)
+	_, err = ks.executor.Execute(ctx, "kubectl", "config", "delete-context", contextName)
 	if err != nil {
-		return fmt.Errorf("컨텍스트 삭제 실패: %v", err)
+		return fmt.Errorf("컨텍스트 삭제 실패: %w", err)
 	}
 
 	log.Printf("✅ Context 삭제 완료: %s", contextName)
@@ -262,13 +310,13 @@ func (ks *KubeService) GetContextDetail(contextName string) (*model.ContextDetai
 	// kube config 파일 읽기
 	configContent, err := ks.GetCurrentConfig()
 	if err != nil {
-		return nil, fmt.Errorf("config 파일 읽기 실패: %v", err)
+		return nil, fmt.Errorf("config 파일 읽기 실패: %w", err)
 	}
 
 	// YAML 파싱
 	var kubeConfig model.KubeConfig
 	if err := yaml.Unmarshal([]byte(configContent), &kubeConfig); err != nil {
-		return nil, fmt.Errorf("config 파싱 실패: %v", err)
+		return nil, fmt.Errorf("config 파싱 실패: %w", err)
 	}
 
 	// 현재 컨텍스트 확인
@@ -344,52 +392,105 @@ func (ks *KubeService) determineAuthMethod(user model.UserConfigData) string {
 	return "None"
 }
 
-// ApplyYaml - YAML 내용을 kubectl apply로 적용
-func (ks *KubeService) ApplyYaml(request model.ApplyYamlRequest) (*model.ApplyYamlResult, error) {
+// ApplyYaml - YAML 내용을 클러스터 백엔드(kubectl 또는 API 서버)로 적용
+func (ks *KubeService) ApplyYaml(ctx context.Context, request model.ApplyYamlRequest) (*model.ApplyYamlResult, error) {
 	log.Printf("🚀 YAML 적용 시작 (DryRun: %t)", request.DryRun)
 
-	// 임시 파일 생성
-	tempFile, err := ks.createTempYamlFile(request.YamlContent)
-	if err != nil {
-		return nil, fmt.Errorf("임시 파일 생성 실패: %v", err)
+	// 대상 context (정책 평가에 사용, 지정하지 않으면 현재 context)
+	if strings.HasPrefix(request.Context, "-") {
+		return nil, fmt.Errorf("잘못된 context 이름입니다: %s", request.Context)
 	}
-	defer func(name string) {
-		err := os.Remove(name)
-		if err != nil {
-
+	// 네임스페이스는 kubectl 인자(-n, 자동 생성)로 전달되므로 어떤 호출보다 먼저 검증
+	if request.Namespace != "" {
+		if err := kubernetes.ValidateNamespaceName(request.Namespace); err != nil {
+			return nil, err
 		}
-	}(tempFile) // 함수 종료 시 임시 파일 삭제
+	}
+	targetContext := request.Context
+	if targetContext == "" {
+		targetContext = ks.GetCurrentContext(ctx)
+	}
 
-	// kubectl apply 명령어 구성
-	args := []string{"apply", "-f", tempFile}
+	// ${VAR} 변수 치환 (검증/적용 전에 수행)
+	yamlContent, resolvedVariables, err := ks.variableService.Apply(request.YamlContent, request.Environment, request.Variables)
+	if err != nil {
+		return nil, err
+	}
+	request.YamlContent = yamlContent
 
-	// 네임스페이스 지정
-	if request.Namespace != "" {
-		args = append(args, "-n", request.Namespace)
+	// 적용 전 정책 검사 (deny 위반이 있으면 차단)
+	evaluation, err := ks.policyService.Evaluate(request.YamlContent, targetContext, request.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("정책 평가 실패: %w", err)
+	}
+	if !evaluation.Allowed {
+		return nil, &PolicyViolationError{Evaluation: evaluation}
 	}
 
-	// dry-run 모드
-	if request.DryRun {
-		args = append(args, "--dry-run=client")
+	// 적용 전 권한 사전 검사 (부족한 권한이 있으면 적용하지 않고 목록 반환, skipPermissionCheck면 검사하지 않고 결과에 표시)
+	// 디스커버리/존재 여부 조회/SelfSubjectAccessReview 실패처럼 검사 자체를 못 하면 경고만 남기고 적용 (실제 권한 부족만 차단)
+	permissionCheckSkipped := request.SkipPermissionCheck
+	permissionCheckWarning := ""
+	if request.SkipPermissionCheck {
+		log.Printf("⚠️  요청에 따라 권한 사전 검사를 건너뜁니다")
+	} else {
+		check, err := NewPermissionServiceWithKubeService(ks).CheckManifest(ctx, request)
+		switch {
+		case err != nil:
+			log.Printf("⚠️  권한 사전 검사를 수행하지 못해 건너뜁니다: %v", err)
+			permissionCheckSkipped = true
+			permissionCheckWarning = fmt.Sprintf("권한 사전 검사를 수행하지 못했습니다: %v", err)
+		case len(check.Missing) > 0:
+			check.Context = targetContext
+			return nil, &PermissionDeniedError{Check: check}
+		}
 	}
 
-	// 상세 출력
-	args = append(args, "-v=0")
+	// 대상 네임스페이스 자동 생성 (dry-run에서는 생성하지 않음)
+	createdNamespace := false
+	if request.CreateNamespace && request.Namespace != "" && !request.DryRun {
+		namespaceManager := ks.namespaceManager
+		if request.Context != "" {
+			namespaceManager = namespaceManager.ForContext(request.Context)
+		}
+		createdNamespace, err = namespaceManager.EnsureNamespace(ctx, request.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("네임스페이스 생성 실패: %w", err)
+		}
+	}
 
-	// kubectl 명령 실행
-	output, err := utils.ExecuteCommand("kubectl", args...)
+	ctx, cancel := context.WithTimeout(ctx, utils.ApplyCommandTimeout)
+	defer cancel()
+	// 클러스터 백엔드로 적용 (kubectl apply 또는 server-side apply)
+	output, err := ks.cluster.Apply(ctx, kubernetes.ManifestRequest{
+		Context:        request.Context,
+		Namespace:      request.Namespace,
+		YamlContent:    request.YamlContent,
+		DryRun:         request.DryRun,
+		ForceConflicts: request.ForceConflicts,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("kubectl apply 실패: %v", err)
+		return nil, err
 	}
 
 	// 적용된 리소스 목록 추출
 	resources := ks.extractResourcesFromOutput(output)
 
 	result := &model.ApplyYamlResult{
-		Output:      output,
-		AppliedTime: time.Now().Format("2006-01-02 15:04:05"),
-		Resources:   resources,
-		DryRun:      request.DryRun,
+		Output:                 output,
+		AppliedTime:            time.Now().Format("2006-01-02 15:04:05"),
+		Resources:              resources,
+		DryRun:                 request.DryRun,
+		CreatedNamespace:       createdNamespace,
+		PermissionCheckSkipped: permissionCheckSkipped,
+		PermissionCheckWarning: permissionCheckWarning,
+	}
+	if len(evaluation.Findings) > 0 {
+		result.PolicyFindings = evaluation.Findings
+	}
+	if resolvedVariables != nil {
+		result.Environment = request.Environment
+		result.ResolvedVariables = resolvedVariables
 	}
 
 	if request.DryRun {
@@ -401,37 +502,19 @@ func (ks *KubeService) ApplyYaml(request model.ApplyYamlRequest) (*model.ApplyYa
 	return result, nil
 }
 
-// DeleteYaml - YAML 내용을 kubectl delete로 삭제
-func (ks *KubeService) DeleteYaml(request model.DeleteYamlRequest) (*model.ApplyYamlResult, error) {
+// DeleteYaml - YAML 내용을 클러스터 백엔드로 삭제
+func (ks *KubeService) DeleteYaml(ctx context.Context, request model.DeleteYamlRequest) (*model.ApplyYamlResult, error) {
 	log.Printf("🗑️ YAML 삭제 시작")
 
-	// 임시 파일 생성
-	tempFile, err := ks.createTempYamlFile(request.YamlContent)
-	if err != nil {
-		return nil, fmt.Errorf("임시 파일 생성 실패: %v", err)
-	}
-	defer func(name string) {
-		err := os.Remove(name)
-		if err != nil {
-
-		}
-	}(tempFile) // 함수 종료 시 임시 파일 삭제
-
-	// kubectl delete 명령어 구성
-	args := []string{"delete", "-f", tempFile}
-
-	// 네임스페이스 지정
-	if request.Namespace != "" {
-		args = append(args, "-n", request.Namespace)
-	}
-
-	// 리소스가 없어도 에러 무시
-	args = append(args, "--ignore-not-found=true")
-
-	// kubectl 명령 실행
-	output, err := utils.ExecuteCommand("kubectl", args...)
+	ctx, cancel := context.WithTimeout(ctx, utils.ApplyCommandTimeout)
+	defer cancel()
+	// 클러스터 백엔드로 삭제 (리소스가 없어도 에러 무시)
+	output, err := ks.cluster.Delete(ctx, kubernetes.ManifestRequest{
+		Namespace:   request.Namespace,
+		YamlContent: request.YamlContent,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("kubectl delete 실패: %v", err)
+		return nil, err
 	}
 
 	// 삭제된 리소스 목록 추출
@@ -448,22 +531,6 @@ func (ks *KubeService) DeleteYaml(request model.DeleteYamlRequest) (*model.Apply
 	return result, nil
 }
 
-// createTempYamlFile - 임시 YAML 파일 생성
-func (ks *KubeService) createTempYamlFile(yamlContent string) (string, error) {
-	// 임시 디렉토리에 파일 생성
-	tempDir := os.TempDir()
-	tempFile := filepath.Join(tempDir, fmt.Sprintf("kubectl-apply-%d.yaml", time.Now().UnixNano()))
-
-	// YAML 내용을 파일에 쓰기
-	err := os.WriteFile(tempFile, []byte(yamlContent), 0644)
-	if err != nil {
-		return "", fmt.Errorf("임시 파일 쓰기 실패: %v", err)
-	}
-
-	log.Printf("📝 임시 YAML 파일 생성: %s", tempFile)
-	return tempFile, nil
-}
-
 // extractResourcesFromOutput - kubectl 출력에서 리소스 목록 추출
 func (ks *KubeService) extractResourcesFromOutput(output string) []string {
 	var resources []string
@@ -492,13 +559,19 @@ func (ks *KubeService) extractResourcesFromOutput(output string) []string {
 	return uniqueResources
 }
 
-// ValidateYaml - YAML 구문 검증 (선택적으로 사용 가능)
+// ValidateYaml - YAML 구문 및 기본 서버 버전 스키마 검증 (스키마 오류는 SchemaValidationFailedError)
 func (ks *KubeService) ValidateYaml(yamlContent string) error {
-	// 기본적인 YAML 구문 검증
-	var temp interface{}
-	err := yaml.Unmarshal([]byte(yamlContent), &temp)
+	result, err := ks.ValidateSchema(yamlContent, "", "")
 	if err != nil {
-		return fmt.Errorf("잘못된 YAML 형식: %v", err)
+		return err
+	}
+	if !result.IsValid {
+		return &SchemaValidationFailedError{Result: result}
 	}
 	return nil
 }
+
+// ValidateSchema - 지정한 쿠버네티스 버전(빈 값이면 기본 버전)의 스키마로 YAML 검증
+func (ks *KubeService) ValidateSchema(yamlContent, kubernetesVersion, crdContent string) (*model.SchemaValidationResult, error) {
+	return ks.schemaService.Validate(yamlContent, kubernetesVersion, crdContent)
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// kustomization 파일로 인식하는 파일명
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+// KustomizeService - kustomize 빌드 서비스 (kubectl kustomize 사용)
+type KustomizeService struct {
+	executor utils.CommandExecutor
+}
+
+// NewKustomizeService - kustomize 서비스 생성자
+func NewKustomizeService() *KustomizeService {
+	return NewKustomizeServiceWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewKustomizeServiceWithExecutor - 지정한 명령 실행기를 사용하는 kustomize 서비스 생성자
+func NewKustomizeServiceWithExecutor(executor utils.CommandExecutor) *KustomizeService {
+	return &KustomizeService{executor: executor}
+}
+
+// IsKustomizationFile - kustomization 파일명인지 확인
+func IsKustomizationFile(filename string) bool {
+	for _, name := range kustomizationFileNames {
+		if filename == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsKustomizationDir - 디렉토리에 kustomization 파일이 있는지 확인
+func IsKustomizationDir(dir string) bool {
+	return kustomizationFile(dir) != ""
+}
+
+// kustomizationFile - 디렉토리의 kustomization 파일 경로 (없으면 빈 문자열)
+func kustomizationFile(dir string) string {
+	for _, name := range kustomizationFileNames {
+		path := filepath.Join(dir, name)
+		if utils.FileExists(path) {
+			return path
+		}
+	}
+	return ""
+}
+
+// FindKustomizations - 루트 디렉토리 아래의 kustomization 디렉토리 탐색
+func (ks *KustomizeService) FindKustomizations(rootDir string) ([]model.KustomizeTarget, error) {
+	log.Printf("🔍 kustomization 검색: %s", rootDir)
+
+	targets := make(map[string]*model.KustomizeTarget)
+
+	err := filepath.Walk(rootDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if info.IsDir() || !IsKustomizationFile(info.Name()) {
+			return nil
+		}
+
+		dir := filepath.Dir(path)
+		relativeDir, _ := filepath.Rel(rootDir, dir)
+		if _, exists := targets[relativeDir]; exists {
+			return nil
+		}
+
+		target := &model.KustomizeTarget{
+			Path:       filepath.ToSlash(relativeDir),
+			IsLeaf:     true,
+			References: []string{},
+		}
+		for _, reference := range ks.readReferences(path) {
+			referencedDir := filepath.Join(dir, reference)
+			if IsKustomizationDir(referencedDir) {
+				relativeRef, _ := filepath.Rel(rootDir, referencedDir)
+				target.References = append(target.References, filepath.ToSlash(relativeRef))
+				target.IsOverlay = true
+			}
+		}
+		targets[relativeDir] = target
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("디렉토리 탐색 실패: %w", err)
+	}
+
+	// 다른 kustomization이 참조하는 디렉토리는 최종 빌드 대상이 아님
+	for _, target := range targets {
+		for _, reference := range target.References {
+			if referenced, ok := targets[filepath.FromSlash(reference)]; ok {
+				referenced.IsLeaf = false
+			}
+		}
+	}
+
+	var result []model.KustomizeTarget
+	for _, target := range targets {
+		result = append(result, *target)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Path < result[j].Path })
+
+	log.Printf("✅ kustomization 검색 완료: %d개 발견", len(result))
+	return result, nil
+}
+
+// readReferences - kustomization 파일의 resources/bases/components 항목 읽기
+func (ks *KustomizeService) readReferences(path string) []string {
+	content, err := utils.ReadFile(path)
+	if err != nil {
+		log.Printf("⚠️ kustomization 읽기 실패 (스킵): %s - %v", path, err)
+		return nil
+	}
+
+	var kustomization struct {
+		Resources  []string `yaml:"resources"`
+		Bases      []string `yaml:"bases"`
+		Components []string `yaml:"components"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &kustomization); err != nil {
+		log.Printf("⚠️ kustomization 파싱 실패 (스킵): %s - %v", path, err)
+		return nil
+	}
+
+	var references []string
+	references = append(references, kustomization.Resources...)
+	references = append(references, kustomization.Bases...)
+	references = append(references, kustomization.Components...)
+	return references
+}
+
+// SelectTarget - 요청 경로에 맞는 빌드 대상 선택 (경로가 없으면 유일한 leaf 선택)
+func (ks *KustomizeService) SelectTarget(targets []model.KustomizeTarget, path string) (*model.KustomizeTarget, error) {
+	path = strings.Trim(filepath.ToSlash(path), "/")
+	if path != "" {
+		for _, target := range targets {
+			if target.Path == path {
+				return &target, nil
+			}
+		}
+		return nil, fmt.Errorf("kustomization을 찾을 수 없습니다: %s", path)
+	}
+
+	var leaves []string
+	var leaf model.KustomizeTarget
+	for _, target := range targets {
+		if target.IsLeaf {
+			leaves = append(leaves, target.Path)
+			leaf = target
+		}
+	}
+
+	switch len(leaves) {
+	case 0:
+		return nil, fmt.Errorf("빌드할 kustomization이 없습니다")
+	case 1:
+		return &leaf, nil
+	}
+	return nil, fmt.Errorf("빌드 대상이 여러 개입니다. kustomizePath를 지정해주세요: %s", strings.Join(leaves, ", "))
+}
+
+// Build - kustomization 디렉토리를 빌드하여 렌더링된 매니페스트 반환
+func (ks *KustomizeService) Build(ctx context.Context, rootDir, path string) (*model.KustomizeBuildResult, error) {
+	log.Printf("🏗️ kustomize 빌드: %s", path)
+
+	buildDir, err := filepath.Abs(filepath.Join(rootDir, filepath.FromSlash(path)))
+	if err != nil {
+		return nil, fmt.Errorf("경로 변환 실패: %w", err)
+	}
+	absRoot, _ := filepath.Abs(rootDir)
+	if rel, err := filepath.Rel(absRoot, buildDir); err != nil || strings.HasPrefix(rel, "..") {
+		return nil, fmt.Errorf("잘못된 kustomization 경로입니다: %s", path)
+	}
+	if !IsKustomizationDir(buildDir) {
+		return nil, fmt.Errorf("kustomization 파일이 없는 디렉토리입니다: %s", path)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+	output, err := ks.executor.Execute(ctx, "kubectl", "kustomize", buildDir)
+	if err != nil {
+		return nil, fmt.Errorf("kustomize 빌드 실패: %w", err)
+	}
+
+	documents, err := utils.ParseYamlDocuments(output)
+	if err != nil {
+		return nil, fmt.Errorf("빌드 결과 파싱 실패: %w", err)
+	}
+
+	var resources []string
+	for _, document := range documents {
+		resources = append(resources, fmt.Sprintf("%s/%s",
+			strings.ToLower(utils.GetNestedString(document, "kind")),
+			utils.GetNestedString(document, "metadata", "name")))
+	}
+
+	result := &model.KustomizeBuildResult{
+		Path:          filepath.ToSlash(path),
+		RenderedYaml:  output,
+		Resources:     resources,
+		DocumentCount: len(documents),
+	}
+
+	log.Printf("✅ kustomize 빌드 완료: %s (%d개 리소스)", path, len(resources))
+	return result, nil
+}
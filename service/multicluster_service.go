@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 다중 context 적용 동시 실행 수
+const (
+	defaultMultiClusterConcurrency = 4
+	maxMultiClusterConcurrency     = 10
+)
+
+// MultiClusterService - 여러 context에 같은 매니페스트를 적용하는 서비스
+type MultiClusterService struct {
+	kubeService *KubeService
+	configPath  string
+}
+
+// NewMultiClusterService - 다중 context 적용 서비스 생성자
+func NewMultiClusterService() *MultiClusterService {
+	return NewMultiClusterServiceWithKubeService(NewKubeService())
+}
+
+// NewMultiClusterServiceWithKubeService - 지정한 KubeService를 사용하는 생성자 (테스트에서 가짜 실행기 주입)
+func NewMultiClusterServiceWithKubeService(kubeService *KubeService) *MultiClusterService {
+	// 환경변수 CONTEXT_GROUPS_CONFIG 우선, 없으면 $HOME/.kube/mykubeapp-context-groups.yaml
+	configPath := os.Getenv("CONTEXT_GROUPS_CONFIG")
+	if configPath == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			homeDir = "."
+		}
+		configPath = filepath.Join(homeDir, ".kube", "mykubeapp-context-groups.yaml")
+	}
+
+	return &MultiClusterService{
+		kubeService: kubeService,
+		configPath:  configPath,
+	}
+}
+
+// GetContextGroups - 저장된 context 그룹 조회
+func (ms *MultiClusterService) GetContextGroups() (*model.ContextGroupConfig, error) {
+	config := &model.ContextGroupConfig{Groups: map[string][]string{}}
+	if !utils.FileExists(ms.configPath) {
+		return config, nil
+	}
+
+	content, err := utils.ReadFile(ms.configPath)
+	if err != nil {
+		return nil, fmt.Errorf("context 그룹 설정 파일 읽기 실패: %v", err)
+	}
+	if err := yaml.Unmarshal([]byte(content), config); err != nil {
+		return nil, fmt.Errorf("context 그룹 설정 파일 파싱 실패: %v", err)
+	}
+	if config.Groups == nil {
+		config.Groups = map[string][]string{}
+	}
+	return config, nil
+}
+
+// SaveContextGroups - context 그룹 저장
+func (ms *MultiClusterService) SaveContextGroups(config model.ContextGroupConfig) error {
+	for groupName, contexts := range config.Groups {
+		if strings.TrimSpace(groupName) == "" {
+			return fmt.Errorf("그룹 이름은 비어 있을 수 없습니다")
+		}
+		for _, contextName := range contexts {
+			if contextName == "" || strings.HasPrefix(contextName, "-") {
+				return fmt.Errorf("잘못된 context 이름입니다 (%s): %q", groupName, contextName)
+			}
+		}
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("context 그룹 설정 직렬화 실패: %v", err)
+	}
+
+	if utils.FileExists(ms.configPath) {
+		if err := utils.BackupFile(ms.configPath); err != nil {
+			log.Printf("⚠️  context 그룹 설정 파일 백업 실패 (계속 진행): %v", err)
+		}
+	}
+
+	if err := utils.WriteFile(ms.configPath, string(data)); err != nil {
+		return fmt.Errorf("context 그룹 설정 파일 저장 실패: %v", err)
+	}
+
+	log.Printf("✅ context 그룹 저장 완료: %s (%d개 그룹)", ms.configPath, len(config.Groups))
+	return nil
+}
+
+// resolveTargets - 요청의 contexts와 group을 합쳐 대상 context 목록 생성 (중복 제거, 카나리가 맨 앞)
+func (ms *MultiClusterService) resolveTargets(ctx context.Context, request model.MultiClusterApplyRequest) ([]string, error) {
+	var targets []string
+	seen := map[string]bool{}
+	add := func(contextName string) {
+		contextName = strings.TrimSpace(contextName)
+		if contextName != "" && !seen[contextName] {
+			seen[contextName] = true
+			targets = append(targets, contextName)
+		}
+	}
+
+	add(request.Canary)
+	for _, contextName := range request.Contexts {
+		add(contextName)
+	}
+	if request.Group != "" {
+		config, err := ms.GetContextGroups()
+		if err != nil {
+			return nil, err
+		}
+		groupContexts, ok := config.Groups[request.Group]
+		if !ok {
+			return nil, fmt.Errorf("존재하지 않는 context 그룹입니다: %s", request.Group)
+		}
+		for _, contextName := range groupContexts {
+			add(contextName)
+		}
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("대상 context(contexts 또는 group)는 필수입니다")
+	}
+
+	// kubeconfig에 없는 context는 적용 전에 거부
+	contexts, err := ms.kubeService.GetContexts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	known := map[string]bool{}
+	for _, info := range contexts {
+		known[info.Name] = true
+	}
+	var unknown []string
+	for _, contextName := range targets {
+		if strings.HasPrefix(contextName, "-") || !known[contextName] {
+			unknown = append(unknown, contextName)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("kubeconfig에 없는 context입니다: %s", strings.Join(unknown, ", "))
+	}
+	return targets, nil
+}
+
+// ApplyToContexts - 대상 context마다 ApplyYaml 실행 (카나리 먼저, 나머지는 제한된 동시성으로)
+func (ms *MultiClusterService) ApplyToContexts(ctx context.Context, request model.MultiClusterApplyRequest) (*model.MultiClusterApplyResult, error) {
+	targets, err := ms.resolveTargets(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	// 변수 누락은 모든 context에서 똑같이 실패하므로 ApplyYaml과 같은 규칙으로 적용 전에 한 번만 확인
+	if _, _, err := ms.kubeService.variableService.Apply(request.YamlContent, request.Environment, request.Variables); err != nil {
+		return nil, err
+	}
+
+	concurrency := request.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultMultiClusterConcurrency
+	}
+	if concurrency > maxMultiClusterConcurrency {
+		concurrency = maxMultiClusterConcurrency
+	}
+	if concurrency > len(targets) {
+		concurrency = len(targets)
+	}
+
+	log.Printf("🌐 다중 context 적용 시작: %s (동시 %d개, 카나리: %q, DryRun: %t)", strings.Join(targets, ", "), concurrency, request.Canary, request.DryRun)
+
+	results := make([]model.MultiClusterContextResult, len(targets))
+	for i, contextName := range targets {
+		results[i] = model.MultiClusterContextResult{
+			Context: contextName,
+			Canary:  request.Canary != "" && contextName == request.Canary,
+			Status:  model.MultiClusterStatusSkipped,
+		}
+	}
+
+	remaining := targets
+	offset := 0
+	if request.Canary != "" {
+		ms.applyToContext(ctx, request, &results[0])
+		remaining = targets[1:]
+		offset = 1
+		if results[0].Status != model.MultiClusterStatusSucceeded {
+			log.Printf("❌ 카나리 context %s 적용 실패, 나머지 %d개 context 건너뜀", request.Canary, len(remaining))
+			for i := range remaining {
+				results[offset+i].Error = "카나리 context 적용 실패로 건너뜀"
+			}
+			remaining = nil
+		}
+	}
+
+	// 실패 시 중단 옵션: 실패가 기록되면 아직 시작하지 않은 context는 건너뜀
+	var stopMutex sync.Mutex
+	stopped := false
+
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range remaining {
+		result := &results[offset+i]
+
+		semaphore <- struct{}{}
+		stopMutex.Lock()
+		skip := stopped
+		stopMutex.Unlock()
+		if skip || ctx.Err() != nil {
+			<-semaphore
+			if skip {
+				result.Error = "이전 context 적용 실패로 건너뜀"
+			} else {
+				result.Error = "요청이 취소되어 건너뜀"
+			}
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			ms.applyToContext(ctx, request, result)
+			if result.Status == model.MultiClusterStatusFailed && request.StopOnFailure {
+				stopMutex.Lock()
+				stopped = true
+				stopMutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	summary := &model.MultiClusterApplyResult{
+		Group:       request.Group,
+		DryRun:      request.DryRun,
+		Concurrency: concurrency,
+		Total:       len(results),
+		Results:     results,
+		AppliedTime: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	for _, result := range results {
+		switch result.Status {
+		case model.MultiClusterStatusSucceeded:
+			summary.Succeeded++
+		case model.MultiClusterStatusFailed:
+			summary.Failed++
+		default:
+			summary.Skipped++
+		}
+	}
+
+	log.Printf("✅ 다중 context 적용 완료 (성공 %d, 실패 %d, 건너뜀 %d)", summary.Succeeded, summary.Failed, summary.Skipped)
+	return summary, nil
+}
+
+// applyToContext - context 하나에 적용하고 결과 기록
+func (ms *MultiClusterService) applyToContext(ctx context.Context, request model.MultiClusterApplyRequest, result *model.MultiClusterContextResult) {
+	started := time.Now()
+	applied, err := ms.kubeService.ApplyYaml(ctx, model.ApplyYamlRequest{
+		YamlContent:         request.YamlContent,
+		Context:             result.Context,
+		Namespace:           request.Namespace,
+		CreateNamespace:     request.CreateNamespace,
+		DryRun:              request.DryRun,
+		ForceConflicts:      request.ForceConflicts,
+		SkipPermissionCheck: request.SkipPermissionCheck,
+		Environment:         request.Environment,
+		Variables:           request.Variables,
+	})
+	result.DurationMs = time.Since(started).Milliseconds()
+
+	if err != nil {
+		log.Printf("❌ context %s 적용 실패: %v", result.Context, err)
+		result.Status = model.MultiClusterStatusFailed
+		result.Error = err.Error()
+		return
+	}
+	result.Status = model.MultiClusterStatusSucceeded
+	result.Result = applied
+}
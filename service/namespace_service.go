@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+)
+
+// NamespaceConfirmationRequiredError - 삭제 확인 값이 없거나 일치하지 않을 때의 에러
+type NamespaceConfirmationRequiredError struct {
+	Preview *model.NamespaceDeletePreview
+}
+
+func (e *NamespaceConfirmationRequiredError) Error() string {
+	return fmt.Sprintf("네임스페이스 '%s' 삭제 시 리소스 %d개가 함께 삭제됩니다. 확인하려면 confirm=%s 를 지정하세요",
+		e.Preview.Namespace, len(e.Preview.Resources), e.Preview.ConfirmValue)
+}
+
+// NamespaceService - 네임스페이스 관리 서비스
+type NamespaceService struct {
+	manager *kubernetes.NamespaceManager
+}
+
+// NewNamespaceService - 네임스페이스 서비스 생성자
+func NewNamespaceService() *NamespaceService {
+	return &NamespaceService{
+		manager: kubernetes.NewNamespaceManager(),
+	}
+}
+
+// ListNamespaces - 네임스페이스 목록 조회
+func (ns *NamespaceService) ListNamespaces(ctx context.Context) ([]model.NamespaceInfo, error) {
+	log.Printf("📁 네임스페이스 목록 조회")
+	return ns.manager.ListNamespaces(ctx)
+}
+
+// GetNamespace - 네임스페이스 상세 조회
+func (ns *NamespaceService) GetNamespace(ctx context.Context, name string) (*model.NamespaceInfo, error) {
+	log.Printf("📁 네임스페이스 조회: %s", name)
+	return ns.manager.GetNamespace(ctx, name)
+}
+
+// CreateNamespace - 네임스페이스 생성 후 생성된 정보 반환
+func (ns *NamespaceService) CreateNamespace(ctx context.Context, request model.CreateNamespaceRequest) (*model.NamespaceInfo, error) {
+	log.Printf("📁 네임스페이스 생성 요청: %s", request.Name)
+	if err := kubernetes.ValidateNamespaceName(request.Name); err != nil {
+		return nil, err
+	}
+
+	exists, err := ns.manager.NamespaceExists(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("이미 존재하는 네임스페이스입니다: %s", request.Name)
+	}
+
+	if err := ns.manager.CreateNamespace(ctx, request); err != nil {
+		return nil, err
+	}
+	return ns.manager.GetNamespace(ctx, request.Name)
+}
+
+// PreviewDelete - 삭제 시 함께 제거될 리소스 목록 조회
+func (ns *NamespaceService) PreviewDelete(ctx context.Context, name string) (*model.NamespaceDeletePreview, error) {
+	if err := kubernetes.ValidateNamespaceName(name); err != nil {
+		return nil, err
+	}
+	if kubernetes.IsSystemNamespace(name) {
+		return nil, fmt.Errorf("시스템 네임스페이스는 삭제할 수 없습니다: %s", name)
+	}
+
+	exists, err := ns.manager.NamespaceExists(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("존재하지 않는 네임스페이스입니다: %s", name)
+	}
+
+	resources, err := ns.manager.ListNamespaceResources(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.NamespaceDeletePreview{
+		Namespace:      name,
+		Resources:      resources,
+		ResourceCounts: kubernetes.CountResourcesByKind(resources),
+		ConfirmValue:   name,
+	}, nil
+}
+
+// DeleteNamespace - 확인 값(네임스페이스 이름)이 일치할 때만 삭제
+func (ns *NamespaceService) DeleteNamespace(ctx context.Context, name, confirm string) (*model.NamespaceDeleteResult, error) {
+	preview, err := ns.PreviewDelete(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if confirm != preview.ConfirmValue {
+		log.Printf("⚠️  네임스페이스 삭제 확인 필요: %s (리소스 %d개)", name, len(preview.Resources))
+		return nil, &NamespaceConfirmationRequiredError{Preview: preview}
+	}
+
+	output, err := ns.manager.DeleteNamespace(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.NamespaceDeleteResult{
+		Namespace:   name,
+		Deleted:     true,
+		Preview:     *preview,
+		Output:      output,
+		DeletedTime: time.Now().Format("2006-01-02 15:04:05"),
+	}, nil
+}
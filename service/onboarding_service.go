@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 온보딩 번들 리소스에 붙는 관리 라벨
+const onboardingManagedBy = "mykubeapp-onboarding"
+
+// OnboardingService - 팀 네임스페이스 온보딩 번들 서비스
+type OnboardingService struct {
+	executor    utils.CommandExecutor
+	kubeService *KubeService
+}
+
+// NewOnboardingService - 온보딩 서비스 생성자
+func NewOnboardingService() *OnboardingService {
+	return NewOnboardingServiceWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewOnboardingServiceWithExecutor - 지정한 명령 실행기를 사용하는 온보딩 서비스 생성자
+func NewOnboardingServiceWithExecutor(executor utils.CommandExecutor) *OnboardingService {
+	return &OnboardingService{
+		executor:    executor,
+		kubeService: NewKubeServiceWithExecutor(executor),
+	}
+}
+
+// OnboardTeam - 번들 매니페스트 생성 후 kubectl apply (재실행해도 같은 결과)
+func (obs *OnboardingService) OnboardTeam(ctx context.Context, request model.TeamOnboardingRequest) (*model.TeamOnboardingResult, error) {
+	if err := obs.normalizeRequest(&request); err != nil {
+		return nil, err
+	}
+
+	log.Printf("👥 팀 온보딩 시작: %s (네임스페이스: %s, DryRun: %t)", request.Team, request.Namespace, request.DryRun)
+
+	documents := obs.BuildBundle(request)
+	manifests, err := utils.MarshalYamlDocuments(documents)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.TeamOnboardingResult{
+		Team:          request.Team,
+		Namespace:     request.Namespace,
+		Manifests:     manifests,
+		Resources:     bundleResources(documents),
+		DryRun:        request.DryRun,
+		OnboardedTime: time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	if request.DryRun {
+		log.Printf("✅ 팀 온보딩 매니페스트 생성 완료 (dry-run, 리소스 %d개)", len(documents))
+		return result, nil
+	}
+
+	// kubectl apply는 선언형이므로 재실행 시 기존 리소스를 갱신만 함
+	applyResult, err := obs.kubeService.ApplyYaml(ctx, model.ApplyYamlRequest{YamlContent: manifests})
+	if err != nil {
+		return nil, fmt.Errorf("온보딩 번들 적용 실패: %w", err)
+	}
+	result.ApplyResult = applyResult
+
+	if request.CreateContext {
+		contextName, kubeconfig, err := obs.createScopedContext(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		result.ContextName = contextName
+		result.Kubeconfig = kubeconfig
+	}
+
+	log.Printf("✅ 팀 온보딩 완료: %s (리소스 %d개)", request.Team, len(documents))
+	return result, nil
+}
+
+// normalizeRequest - 필수 값 검증 및 기본값 채우기
+func (obs *OnboardingService) normalizeRequest(request *model.TeamOnboardingRequest) error {
+	request.Team = strings.TrimSpace(request.Team)
+	if err := kubernetes.ValidateNamespaceName(request.Team); err != nil {
+		return fmt.Errorf("잘못된 팀 이름입니다: %w", err)
+	}
+	if request.Namespace == "" {
+		request.Namespace = request.Team
+	}
+	if err := kubernetes.ValidateNamespaceName(request.Namespace); err != nil {
+		return err
+	}
+	if kubernetes.IsSystemNamespace(request.Namespace) {
+		return fmt.Errorf("시스템 네임스페이스에는 온보딩할 수 없습니다: %s", request.Namespace)
+	}
+
+	var groups []string
+	for _, group := range request.Groups {
+		if group = strings.TrimSpace(group); group != "" {
+			groups = append(groups, group)
+		}
+	}
+	if len(groups) == 0 {
+		return fmt.Errorf("팀 그룹 이름이 최소 하나 필요합니다")
+	}
+	request.Groups = groups
+
+	quota := &request.Quota
+	quota.RequestsCPU = defaultString(quota.RequestsCPU, "4")
+	quota.RequestsMemory = defaultString(quota.RequestsMemory, "8Gi")
+	quota.LimitsCPU = defaultString(quota.LimitsCPU, "8")
+	quota.LimitsMemory = defaultString(quota.LimitsMemory, "16Gi")
+	quota.Pods = defaultString(quota.Pods, "50")
+	quota.Storage = defaultString(quota.Storage, "100Gi")
+	quota.PVCs = defaultString(quota.PVCs, "10")
+
+	limits := &request.LimitRange
+	limits.DefaultCPU = defaultString(limits.DefaultCPU, "500m")
+	limits.DefaultMemory = defaultString(limits.DefaultMemory, "512Mi")
+	limits.DefaultRequestCPU = defaultString(limits.DefaultRequestCPU, "100m")
+	limits.DefaultRequestMemory = defaultString(limits.DefaultRequestMemory, "128Mi")
+
+	request.TokenDuration = defaultString(request.TokenDuration, "24h")
+	return nil
+}
+
+// BuildBundle - Namespace, ResourceQuota, LimitRange, ServiceAccount, Role, RoleBinding, NetworkPolicy 매니페스트 생성
+func (obs *OnboardingService) BuildBundle(request model.TeamOnboardingRequest) []map[string]interface{} {
+	namespace := request.Namespace
+	labels := map[string]interface{}{
+		"team":                         request.Team,
+		"app.kubernetes.io/managed-by": onboardingManagedBy,
+	}
+
+	namespaceLabels := map[string]interface{}{}
+	for key, value := range request.Labels {
+		namespaceLabels[key] = value
+	}
+	for key, value := range labels {
+		namespaceLabels[key] = value
+	}
+
+	metadata := func(name string, namespaced bool) map[string]interface{} {
+		meta := map[string]interface{}{"name": name, "labels": labels}
+		if namespaced {
+			meta["namespace"] = namespace
+		}
+		return meta
+	}
+
+	serviceAccountName := request.Team + "-deployer"
+	roleName := request.Team + "-developer"
+
+	subjects := []interface{}{}
+	for _, group := range request.Groups {
+		subjects = append(subjects, map[string]interface{}{
+			"kind":     "Group",
+			"apiGroup": "rbac.authorization.k8s.io",
+			"name":     group,
+		})
+	}
+	subjects = append(subjects, map[string]interface{}{
+		"kind":      "ServiceAccount",
+		"name":      serviceAccountName,
+		"namespace": namespace,
+	})
+
+	allVerbs := []interface{}{"get", "list", "watch", "create", "update", "patch", "delete"}
+	readVerbs := []interface{}{"get", "list", "watch"}
+
+	return []map[string]interface{}{
+		{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]interface{}{"name": namespace, "labels": namespaceLabels},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "ResourceQuota",
+			"metadata":   metadata(request.Team+"-quota", true),
+			"spec": map[string]interface{}{
+				"hard": map[string]interface{}{
+					"requests.cpu":           request.Quota.RequestsCPU,
+					"requests.memory":        request.Quota.RequestsMemory,
+					"limits.cpu":             request.Quota.LimitsCPU,
+					"limits.memory":          request.Quota.LimitsMemory,
+					"pods":                   request.Quota.Pods,
+					"requests.storage":       request.Quota.Storage,
+					"persistentvolumeclaims": request.Quota.PVCs,
+				},
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "LimitRange",
+			"metadata":   metadata(request.Team+"-limits", true),
+			"spec": map[string]interface{}{
+				"limits": []interface{}{
+					map[string]interface{}{
+						"type": "Container",
+						"default": map[string]interface{}{
+							"cpu":    request.LimitRange.DefaultCPU,
+							"memory": request.LimitRange.DefaultMemory,
+						},
+						"defaultRequest": map[string]interface{}{
+							"cpu":    request.LimitRange.DefaultRequestCPU,
+							"memory": request.LimitRange.DefaultRequestMemory,
+						},
+					},
+				},
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "ServiceAccount",
+			"metadata":   metadata(serviceAccountName, true),
+		},
+		{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "Role",
+			"metadata":   metadata(roleName, true),
+			"rules": []interface{}{
+				map[string]interface{}{
+					"apiGroups": []interface{}{"", "apps", "batch", "autoscaling", "networking.k8s.io", "policy"},
+					"resources": []interface{}{
+						"pods", "pods/log", "pods/exec", "pods/portforward", "services", "configmaps", "secrets",
+						"persistentvolumeclaims", "deployments", "statefulsets", "daemonsets", "replicasets",
+						"jobs", "cronjobs", "horizontalpodautoscalers", "ingresses", "poddisruptionbudgets",
+					},
+					"verbs": allVerbs,
+				},
+				map[string]interface{}{
+					"apiGroups": []interface{}{""},
+					"resources": []interface{}{"events", "resourcequotas", "limitranges", "serviceaccounts"},
+					"verbs":     readVerbs,
+				},
+			},
+		},
+		{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "RoleBinding",
+			"metadata":   metadata(roleName, true),
+			"subjects":   subjects,
+			"roleRef": map[string]interface{}{
+				"apiGroup": "rbac.authorization.k8s.io",
+				"kind":     "Role",
+				"name":     roleName,
+			},
+		},
+		{
+			// 모든 ingress/egress 차단 (클러스터 DNS 조회만 허용)
+			"apiVersion": "networking.k8s.io/v1",
+			"kind":       "NetworkPolicy",
+			"metadata":   metadata("default-deny", true),
+			"spec": map[string]interface{}{
+				"podSelector": map[string]interface{}{},
+				"policyTypes": []interface{}{"Ingress", "Egress"},
+				"egress": []interface{}{
+					map[string]interface{}{
+						"ports": []interface{}{
+							map[string]interface{}{"protocol": "UDP", "port": 53},
+							map[string]interface{}{"protocol": "TCP", "port": 53},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// createScopedContext - ServiceAccount 토큰으로 새 네임스페이스 범위 context 생성 (현재 context는 변경하지 않음)
+func (obs *OnboardingService) createScopedContext(ctx context.Context, request model.TeamOnboardingRequest) (string, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+
+	currentContext := obs.kubeService.GetCurrentContext(ctx)
+	if currentContext == "" {
+		return "", "", fmt.Errorf("현재 context를 확인할 수 없어 kubeconfig context를 생성할 수 없습니다")
+	}
+
+	clusterName, err := obs.executor.Execute(ctx, "kubectl", "config", "view", "--minify", "-o", "jsonpath={.contexts[0].context.cluster}")
+	if err != nil {
+		return "", "", fmt.Errorf("현재 클러스터 조회 실패: %w", err)
+	}
+	clusterName = strings.TrimSpace(clusterName)
+
+	serviceAccountName := request.Team + "-deployer"
+	token, err := obs.executor.ExecuteSensitive(ctx, "kubectl", "create", "token", serviceAccountName,
+		"-n", request.Namespace, "--duration="+request.TokenDuration)
+	if err != nil {
+		return "", "", fmt.Errorf("ServiceAccount 토큰 발급 실패: %w", err)
+	}
+
+	userName := fmt.Sprintf("%s-%s", request.Namespace, serviceAccountName)
+	contextName := fmt.Sprintf("%s@%s", request.Namespace, clusterName)
+
+	if _, err := obs.executor.ExecuteSensitive(ctx, "kubectl", "config", "set-credentials", userName, "--token="+strings.TrimSpace(token)); err != nil {
+		return "", "", fmt.Errorf("사용자 설정 실패: %w", err)
+	}
+	if _, err := obs.executor.Execute(ctx, "kubectl", "config", "set-context", contextName,
+		"--cluster="+clusterName, "--user="+userName, "--namespace="+request.Namespace); err != nil {
+		return "", "", fmt.Errorf("컨텍스트 설정 실패: %w", err)
+	}
+	log.Printf("✅ 네임스페이스 범위 context 생성: %s", contextName)
+
+	if !request.ExportKubeconfig {
+		return contextName, "", nil
+	}
+
+	kubeconfig, err := obs.executor.ExecuteSensitive(ctx, "kubectl", "config", "view", "--minify", "--flatten", "--context="+contextName)
+	if err != nil {
+		return "", "", fmt.Errorf("kubeconfig 내보내기 실패: %w", err)
+	}
+	return contextName, kubeconfig, nil
+}
+
+// bundleResources - 번들 리소스 목록 (kind/name)
+func bundleResources(documents []map[string]interface{}) []string {
+	resources := make([]string, 0, len(documents))
+	for _, document := range documents {
+		resources = append(resources, utils.GetNestedString(document, "kind")+"/"+utils.GetNestedString(document, "metadata", "name"))
+	}
+	return resources
+}
+
+// defaultString - 빈 문자열이면 기본값 반환
+func defaultString(value, fallback string) string {
+	if strings.TrimSpace(value) == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 요약에 포함할 최근 Warning 이벤트 기본 개수
+const defaultOverviewWarningEvents = 20
+
+// OverviewService - 컨텍스트별 클러스터 상태 요약 서비스
+type OverviewService struct {
+	executor utils.CommandExecutor
+}
+
+// NewOverviewService - 클러스터 요약 서비스 생성자
+func NewOverviewService() *OverviewService {
+	return NewOverviewServiceWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewOverviewServiceWithExecutor - 지정한 명령 실행기를 사용하는 클러스터 요약 서비스 생성자
+func NewOverviewServiceWithExecutor(executor utils.CommandExecutor) *OverviewService {
+	return &OverviewService{executor: executor}
+}
+
+// GetClusterOverview - 버전, 노드, 리소스 요청량, 파드 phase, 워크로드, Warning 이벤트를 병렬로 수집
+// 일부 항목 조회에 실패해도(권한 부족 등) 나머지 항목과 함께 Errors에 기록해 반환
+func (ovs *OverviewService) GetClusterOverview(ctx context.Context, contextName string, eventLimit int) (*model.ClusterOverview, error) {
+	if contextName == "" || strings.HasPrefix(contextName, "-") {
+		return nil, fmt.Errorf("잘못된 context 이름입니다: %s", contextName)
+	}
+	if _, err := ovs.executor.Execute(ctx, "kubectl", "config", "get-contexts", contextName, "-o", "name"); err != nil {
+		return nil, fmt.Errorf("context를 찾을 수 없습니다: %s", contextName)
+	}
+	if eventLimit <= 0 {
+		eventLimit = defaultOverviewWarningEvents
+	}
+
+	log.Printf("🩺 클러스터 요약 수집 시작: %s", contextName)
+
+	overview := &model.ClusterOverview{
+		Context:              contextName,
+		CPU:                  model.ResourceOverview{Unit: "millicores"},
+		Memory:               model.ResourceOverview{Unit: "bytes"},
+		Nodes:                model.NodeOverview{NotReady: []string{}, Unschedulable: []string{}},
+		PodPhases:            map[string]int{},
+		Namespaces:           []model.NamespacePodSummary{},
+		UnavailableWorkloads: []model.UnavailableWorkload{},
+		WarningEvents:        []model.ClusterEventMessage{},
+	}
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	collect := func(section string, args []string, apply func(object map[string]interface{})) {
+		defer wg.Done()
+
+		object, err := ovs.getContextJSON(ctx, contextName, args...)
+		mutex.Lock()
+		defer mutex.Unlock()
+		if err != nil {
+			overview.Errors = append(overview.Errors, fmt.Sprintf("%s 조회 실패: %v", section, err))
+			return
+		}
+		apply(object)
+	}
+
+	wg.Add(5)
+	go collect("서버 버전", []string{"version"}, func(object map[string]interface{}) {
+		overview.ServerVersion = utils.GetNestedString(object, "serverVersion", "gitVersion")
+		overview.Platform = utils.GetNestedString(object, "serverVersion", "platform")
+	})
+	go collect("노드", []string{"get", "nodes"}, func(object map[string]interface{}) {
+		summarizeNodes(overview, utils.GetNestedSlice(object, "items"))
+	})
+	go collect("파드", []string{"get", "pods", "--all-namespaces"}, func(object map[string]interface{}) {
+		summarizePods(overview, utils.GetNestedSlice(object, "items"))
+	})
+	go collect("워크로드", []string{"get", "deployments,statefulsets,daemonsets", "--all-namespaces"}, func(object map[string]interface{}) {
+		overview.UnavailableWorkloads = findUnavailableWorkloads(utils.GetNestedSlice(object, "items"))
+	})
+	go collect("Warning 이벤트", []string{"get", "events", "--all-namespaces", "--field-selector", "type=Warning"}, func(object map[string]interface{}) {
+		overview.WarningEvents = recentWarningEvents(utils.GetNestedSlice(object, "items"), eventLimit)
+	})
+	wg.Wait()
+
+	if overview.CPU.Allocatable > 0 {
+		overview.CPU.RequestedPercent = percent(overview.CPU.Requested, overview.CPU.Allocatable)
+	}
+	if overview.Memory.Allocatable > 0 {
+		overview.Memory.RequestedPercent = percent(overview.Memory.Requested, overview.Memory.Allocatable)
+	}
+	sort.Strings(overview.Errors)
+	overview.CollectedTime = time.Now().Format("2006-01-02 15:04:05")
+
+	log.Printf("✅ 클러스터 요약 수집 완료: %s (노드 %d/%d Ready, 실패 항목 %d개)",
+		contextName, overview.Nodes.Ready, overview.Nodes.Total, len(overview.Errors))
+	return overview, nil
+}
+
+// getContextJSON - 지정한 context로 kubectl 실행 후 JSON 파싱
+func (ovs *OverviewService) getContextJSON(ctx context.Context, contextName string, args ...string) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+
+	args = kubernetes.ContextArgs(contextName, append(args, "-o", "json")...)
+
+	output, err := ovs.executor.Execute(ctx, "kubectl", args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(output), &object); err != nil {
+		return nil, fmt.Errorf("JSON 파싱 실패: %w", err)
+	}
+	return object, nil
+}
+
+// summarizeNodes - 노드 Ready 상태와 할당 가능 CPU/메모리 합계
+func summarizeNodes(overview *model.ClusterOverview, items []interface{}) {
+	for _, item := range items {
+		node, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := utils.GetNestedString(node, "metadata", "name")
+		status := utils.GetNestedMap(node, "status")
+
+		overview.Nodes.Total++
+		if conditionStatus(status, "Ready") == "True" {
+			overview.Nodes.Ready++
+		} else {
+			overview.Nodes.NotReady = append(overview.Nodes.NotReady, name)
+		}
+		if unschedulable, _ := utils.GetNestedValue(node, "spec", "unschedulable"); unschedulable == true {
+			overview.Nodes.Unschedulable = append(overview.Nodes.Unschedulable, name)
+		}
+
+		allocatable := utils.GetNestedMap(status, "allocatable")
+		if cpu, err := kubernetes.ParseCPUMillis(fmt.Sprint(allocatable["cpu"])); err == nil {
+			overview.CPU.Allocatable += cpu
+		}
+		if memory, err := kubernetes.ParseMemoryBytes(fmt.Sprint(allocatable["memory"])); err == nil {
+			overview.Memory.Allocatable += memory
+		}
+	}
+}
+
+// summarizePods - 네임스페이스별 phase 개수와 노드에 배치된 실행 중 파드의 요청량/제한량 합계
+func summarizePods(overview *model.ClusterOverview, items []interface{}) {
+	namespaces := map[string]*model.NamespacePodSummary{}
+
+	for _, item := range items {
+		pod, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		namespace := utils.GetNestedString(pod, "metadata", "namespace")
+		phase := utils.GetNestedString(pod, "status", "phase")
+		if phase == "" {
+			phase = "Unknown"
+		}
+
+		summary, exists := namespaces[namespace]
+		if !exists {
+			summary = &model.NamespacePodSummary{Namespace: namespace, Phases: map[string]int{}}
+			namespaces[namespace] = summary
+		}
+		summary.Total++
+		summary.Phases[phase]++
+		overview.PodPhases[phase]++
+
+		// 종료된 파드와 아직 스케줄되지 않은 파드는 노드 자원을 점유하지 않음
+		if phase == "Succeeded" || phase == "Failed" || utils.GetNestedString(pod, "spec", "nodeName") == "" {
+			continue
+		}
+		spec := utils.GetNestedMap(pod, "spec")
+		overview.CPU.Requested += podResource(spec, "requests", "cpu", kubernetes.ParseCPUMillis)
+		overview.CPU.Limits += podResource(spec, "limits", "cpu", kubernetes.ParseCPUMillis)
+		overview.Memory.Requested += podResource(spec, "requests", "memory", kubernetes.ParseMemoryBytes)
+		overview.Memory.Limits += podResource(spec, "limits", "memory", kubernetes.ParseMemoryBytes)
+	}
+
+	for _, summary := range namespaces {
+		overview.Namespaces = append(overview.Namespaces, *summary)
+	}
+	sort.Slice(overview.Namespaces, func(i, j int) bool {
+		return overview.Namespaces[i].Namespace < overview.Namespaces[j].Namespace
+	})
+}
+
+// podResource - 파드의 유효 요청량 (컨테이너 합계와 가장 큰 init 컨테이너 값 중 큰 값, 스케줄러 계산 방식)
+func podResource(spec map[string]interface{}, field, resource string, parse func(string) (int64, error)) int64 {
+	containerValue := func(item interface{}) int64 {
+		container, ok := item.(map[string]interface{})
+		if !ok {
+			return 0
+		}
+		value := utils.GetNestedString(container, "resources", field, resource)
+		if value == "" {
+			return 0
+		}
+		parsed, err := parse(value)
+		if err != nil {
+			return 0
+		}
+		return parsed
+	}
+
+	var sum int64
+	for _, container := range utils.GetNestedSlice(spec, "containers") {
+		sum += containerValue(container)
+	}
+	for _, container := range utils.GetNestedSlice(spec, "initContainers") {
+		if value := containerValue(container); value > sum {
+			sum = value
+		}
+	}
+	return sum
+}
+
+// findUnavailableWorkloads - 원하는 레플리카 수보다 사용 가능한 수가 적은 워크로드
+func findUnavailableWorkloads(items []interface{}) []model.UnavailableWorkload {
+	workloads := []model.UnavailableWorkload{}
+	for _, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		kind := utils.GetNestedString(object, "kind")
+		spec := utils.GetNestedMap(object, "spec")
+		status := utils.GetNestedMap(object, "status")
+
+		var desired, available int
+		switch kind {
+		case "Deployment":
+			desired = intValueOr(spec["replicas"], 1)
+			available = intValue(status["availableReplicas"])
+		case "StatefulSet":
+			desired = intValueOr(spec["replicas"], 1)
+			available = intValueOr(status["availableReplicas"], intValue(status["readyReplicas"]))
+		case "DaemonSet":
+			desired = intValue(status["desiredNumberScheduled"])
+			available = intValue(status["numberAvailable"])
+		default:
+			continue
+		}
+
+		if available < desired {
+			workloads = append(workloads, model.UnavailableWorkload{
+				Kind:        kind,
+				Namespace:   utils.GetNestedString(object, "metadata", "namespace"),
+				Name:        utils.GetNestedString(object, "metadata", "name"),
+				Desired:     desired,
+				Available:   available,
+				Unavailable: desired - available,
+			})
+		}
+	}
+
+	sort.Slice(workloads, func(i, j int) bool {
+		if workloads[i].Namespace != workloads[j].Namespace {
+			return workloads[i].Namespace < workloads[j].Namespace
+		}
+		return workloads[i].Name < workloads[j].Name
+	})
+	return workloads
+}
+
+// recentWarningEvents - 마지막 발생 시간 기준 최신 Warning 이벤트 limit개
+func recentWarningEvents(items []interface{}, limit int) []model.ClusterEventMessage {
+	events := []model.ClusterEventMessage{}
+	for _, item := range items {
+		event, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		message := model.ClusterEventMessage{
+			Type:           model.EventMessageTypeEvent,
+			EventType:      utils.GetNestedString(event, "type"),
+			Reason:         utils.GetNestedString(event, "reason"),
+			Note:           utils.GetNestedString(event, "message"),
+			Namespace:      utils.GetNestedString(event, "metadata", "namespace"),
+			ObjectKind:     utils.GetNestedString(event, "involvedObject", "kind"),
+			ObjectName:     utils.GetNestedString(event, "involvedObject", "name"),
+			Source:         utils.GetNestedString(event, "source", "component"),
+			Count:          intValue(event["count"]),
+			FirstTimestamp: utils.GetNestedString(event, "firstTimestamp"),
+			LastTimestamp:  utils.GetNestedString(event, "lastTimestamp"),
+		}
+		if message.Source == "" {
+			message.Source = utils.GetNestedString(event, "reportingComponent")
+		}
+		if observed := utils.GetNestedString(event, "series", "lastObservedTime"); observed != "" {
+			message.LastTimestamp = observed
+			message.Count = intValue(utils.GetNestedMap(event, "series")["count"])
+		}
+		if message.LastTimestamp == "" {
+			message.LastTimestamp = utils.GetNestedString(event, "eventTime")
+		}
+		events = append(events, message)
+	}
+
+	// RFC3339 타임스탬프는 문자열 비교로 시간 순 정렬 가능
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp > events[j].LastTimestamp
+	})
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events
+}
+
+// percent - 소수점 첫째 자리까지의 백분율
+func percent(part, total int64) float64 {
+	return float64(part*1000/total) / 10
+}
@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// PermissionDeniedError - 권한 사전 검사에서 부족한 권한이 발견되어 적용하지 않았을 때의 에러
+type PermissionDeniedError struct {
+	Check *model.PermissionCheck
+}
+
+func (e *PermissionDeniedError) Error() string {
+	var missing []string
+	for _, permission := range e.Check.Missing {
+		scope := permission.Resource
+		if permission.Namespace != "" {
+			scope += " (" + permission.Namespace + ")"
+		}
+		missing = append(missing, fmt.Sprintf("%s %s [%s]", permission.Verb, scope, permission.Object))
+	}
+	return fmt.Sprintf("권한이 부족하여 적용하지 않았습니다 (%d건): %s", len(missing), strings.Join(missing, "; "))
+}
+
+// PermissionService - 현재 자격 증명의 RBAC 권한 조회 서비스
+type PermissionService struct {
+	kubeService *KubeService
+}
+
+// NewPermissionService - 권한 서비스 생성자
+func NewPermissionService() *PermissionService {
+	return NewPermissionServiceWithKubeService(NewKubeService())
+}
+
+// NewPermissionServiceWithKubeService - 지정한 KubeService를 사용하는 생성자 (테스트에서 가짜 실행기 주입)
+func NewPermissionServiceWithKubeService(kubeService *KubeService) *PermissionService {
+	return &PermissionService{kubeService: kubeService}
+}
+
+// GetPermissionMatrix - SelfSubjectRulesReview로 네임스페이스의 동사 × 리소스 권한 매트릭스 생성
+//
+// 규칙 목록은 네임스페이스 기준이므로 클러스터 범위 리소스는 참고용입니다.
+// 적용 전 사전 검사는 오브젝트별 SelfSubjectAccessReview로 정확히 확인합니다.
+func (ps *PermissionService) GetPermissionMatrix(ctx context.Context, query model.PermissionMatrixQuery) (*model.PermissionMatrix, error) {
+	if strings.HasPrefix(query.Context, "-") {
+		return nil, fmt.Errorf("잘못된 context 이름입니다: %s", query.Context)
+	}
+	if query.Namespace != "" {
+		if err := kubernetes.ValidateNamespaceName(query.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	contextName := query.Context
+	if contextName == "" {
+		contextName = ps.kubeService.GetCurrentContext(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+	cluster := ps.kubeService.cluster
+
+	namespace := query.Namespace
+	if namespace == "" {
+		defaultNamespace, err := cluster.DefaultNamespace(ctx, query.Context)
+		if err != nil {
+			return nil, fmt.Errorf("기본 네임스페이스 조회 실패: %w", err)
+		}
+		namespace = defaultNamespace
+	}
+	log.Printf("🛡️ 권한 매트릭스 조회 (context: %s, namespace: %s)", contextName, namespace)
+
+	kinds, err := ps.kubeService.discovery.Kinds(ctx, query.Context)
+	if err != nil {
+		return nil, err
+	}
+	kinds, err = filterPermissionKinds(kinds, query.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := kubernetes.ReviewRules(ctx, cluster, query.Context, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	matrix := &model.PermissionMatrix{
+		Context:         contextName,
+		Namespace:       namespace,
+		Verbs:           model.PermissionVerbs,
+		Rows:            make([]model.PermissionRow, 0, len(kinds)),
+		Incomplete:      review.Incomplete,
+		EvaluationError: review.EvaluationError,
+		CheckedTime:     time.Now().Format("2006-01-02 15:04:05"),
+	}
+	for _, kind := range kinds {
+		row := model.PermissionRow{
+			Resource:   kind.Name,
+			Group:      apiGroup(kind.APIVersion),
+			Kind:       kind.Kind,
+			Namespaced: kind.Namespaced,
+			Verbs:      make(map[string]string, len(model.PermissionVerbs)),
+		}
+		for _, verb := range model.PermissionVerbs {
+			row.Verbs[verb] = review.Evaluate(row.Group, row.Resource, verb)
+		}
+		matrix.Rows = append(matrix.Rows, row)
+	}
+
+	log.Printf("✅ 권한 매트릭스 조회 완료 (리소스 %d개, 규칙 %d개, 불완전: %t)", len(matrix.Rows), len(review.Rules), review.Incomplete)
+	return matrix, nil
+}
+
+// CheckManifest - 매니페스트의 모든 오브젝트에 대해 적용에 필요한 권한을 SelfSubjectAccessReview로 사전 검사
+//
+// 오브젝트가 이미 있으면 patch, 없으면 create를 확인하고, kubectl 백엔드는 적용 전에 조회하므로 get도 확인합니다.
+// kubectl 백엔드의 dry-run(--dry-run=client)은 조회만 하므로 get만, API 백엔드의 dry-run(dryRun=All)은 실제 적용과 같은 권한을 확인합니다.
+// createNamespace로 네임스페이스를 만들 때는 namespaces의 get과 (없으면) create도 확인합니다.
+// 존재 여부는 이름만 한 번에 조회하고(본문이 로그에 남지 않음), 조회에 실패하면 patch와 create를 모두 요구합니다.
+// 권한 검사도 한 번의 요청으로 묶어 보냅니다.
+func (ps *PermissionService) CheckManifest(ctx context.Context, request model.ApplyYamlRequest) (*model.PermissionCheck, error) {
+	documents, err := utils.ParseYamlDocuments(request.YamlContent)
+	if err != nil {
+		return nil, err
+	}
+
+	contextName := request.Context
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+	cluster := ps.kubeService.cluster
+
+	kinds, err := ps.kubeService.discovery.Kinds(ctx, contextName)
+	if err != nil {
+		return nil, err
+	}
+	kindsByGroupKind := make(map[string]*model.ResourceKind, len(kinds))
+	for i := range kinds {
+		kindsByGroupKind[apiGroup(kinds[i].APIVersion)+"/"+kinds[i].Kind] = &kinds[i]
+	}
+
+	// kubectl apply는 조회 후 patch/create하므로 get도 필요하고, --dry-run=client만 쓰기 없이 조회로 끝남
+	// API 백엔드의 dry-run은 dryRun=All PATCH라 실제 적용과 같은 patch/create 권한으로 인가됨
+	checkGet := cluster.Name() == kubernetes.BackendKubectl
+	clientDryRun := request.DryRun && checkGet
+
+	// 오브젝트별 필요한 동사 (existence가 0 이상이면 존재 여부에 따라 existingVerb 또는 create 추가)
+	type objectCheck struct {
+		ref          string
+		namespace    string
+		resource     string
+		request      kubernetes.AccessRequest
+		verbs        []string
+		existence    int    // references 인덱스 (-1이면 존재 여부와 무관)
+		existingVerb string // 이미 있을 때 필요한 동사 (빈 값이면 쓰기 없음)
+	}
+	check := &model.PermissionCheck{Context: contextName, Missing: []model.MissingPermission{}}
+	var objects []objectCheck
+	var references []kubernetes.ObjectReference
+
+	// 적용 방식에 필요한 권한: 이미 있으면 patch, 없으면 create (client dry-run은 쓰기 없음)
+	addObject := func(kind *model.ResourceKind, ref, namespace, name string, verbs []string, existingVerb string) {
+		object := objectCheck{
+			ref:          ref,
+			namespace:    namespace,
+			resource:     kind.Name,
+			request:      kubernetes.AccessRequest{Group: apiGroup(kind.APIVersion), Resource: kind.Name, Namespace: namespace},
+			verbs:        verbs,
+			existence:    -1,
+			existingVerb: existingVerb,
+		}
+		if object.request.Group != "" {
+			object.resource += "." + object.request.Group
+		}
+		if !clientDryRun {
+			object.existence = len(references)
+			references = append(references, kubernetes.ObjectReference{Kind: kind, Namespace: namespace, Name: name})
+		}
+		objects = append(objects, object)
+	}
+
+	// 네임스페이스 자동 생성 (EnsureNamespace는 조회 후 없으면 생성)
+	if request.CreateNamespace && request.Namespace != "" && !request.DryRun {
+		kind, ok := kindsByGroupKind["/Namespace"]
+		if !ok {
+			kind = &model.ResourceKind{Name: "namespaces", APIVersion: "v1", Kind: "Namespace"}
+		}
+		addObject(kind, "namespace/"+request.Namespace, "", request.Namespace, []string{"get"}, "")
+	}
+
+	defaultNamespace := ""
+	for _, document := range documents {
+		apiVersion := utils.GetNestedString(document, "apiVersion")
+		kindName := utils.GetNestedString(document, "kind")
+		name := utils.GetNestedString(document, "metadata", "name")
+		if apiVersion == "" || kindName == "" || name == "" {
+			continue
+		}
+		check.Objects++
+
+		group := apiGroup(apiVersion)
+		ref := strings.ToLower(kindName)
+		if group != "" {
+			ref += "." + group
+		}
+		ref += "/" + name
+
+		// 같은 매니페스트에서 생성하는 CRD처럼 아직 없는 종류는 검사하지 않음
+		kind, ok := kindsByGroupKind[group+"/"+kindName]
+		if !ok {
+			check.Unchecked = append(check.Unchecked, ref)
+			continue
+		}
+
+		objectNamespace := ""
+		if kind.Namespaced {
+			objectNamespace = utils.GetNestedString(document, "metadata", "namespace")
+			if objectNamespace == "" {
+				objectNamespace = request.Namespace
+			}
+			if objectNamespace == "" {
+				if defaultNamespace == "" {
+					if defaultNamespace, err = cluster.DefaultNamespace(ctx, contextName); err != nil {
+						return nil, fmt.Errorf("기본 네임스페이스 조회 실패: %w", err)
+					}
+				}
+				objectNamespace = defaultNamespace
+			}
+		}
+
+		var verbs []string
+		if checkGet {
+			verbs = append(verbs, "get")
+		}
+		addObject(kind, ref, objectNamespace, name, verbs, "patch")
+	}
+
+	// 존재 여부를 kubectl/API 요청 한 번으로 조회 (실패하면 patch, create를 모두 요구)
+	var existing []bool
+	if len(references) > 0 {
+		if existing, err = cluster.ExistingObjects(ctx, contextName, references); err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			log.Printf("⚠️  오브젝트 존재 여부 조회 실패, patch와 create 권한을 모두 확인합니다: %v", err)
+		}
+	}
+
+	var requests []kubernetes.AccessRequest
+	requestIndex := map[kubernetes.AccessRequest]int{}
+	for i := range objects {
+		object := &objects[i]
+		if object.existence >= 0 {
+			switch {
+			case existing == nil:
+				if object.existingVerb != "" {
+					object.verbs = append(object.verbs, object.existingVerb)
+				}
+				object.verbs = append(object.verbs, "create")
+			case existing[object.existence]:
+				if object.existingVerb != "" {
+					object.verbs = append(object.verbs, object.existingVerb)
+				}
+			default:
+				object.verbs = append(object.verbs, "create")
+			}
+		}
+
+		for _, verb := range object.verbs {
+			accessRequest := object.request
+			accessRequest.Verb = verb
+			if _, ok := requestIndex[accessRequest]; !ok {
+				requestIndex[accessRequest] = len(requests)
+				requests = append(requests, accessRequest)
+			}
+		}
+	}
+
+	if len(requests) > 0 {
+		decisions, err := kubernetes.CheckAccess(ctx, cluster, contextName, requests)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range objects {
+			for _, verb := range object.verbs {
+				accessRequest := object.request
+				accessRequest.Verb = verb
+				decision := decisions[requestIndex[accessRequest]]
+				if decision.Allowed {
+					continue
+				}
+				check.Missing = append(check.Missing, model.MissingPermission{
+					Object:    object.ref,
+					Namespace: object.namespace,
+					Resource:  object.resource,
+					Verb:      verb,
+					Reason:    decision.Reason,
+				})
+			}
+		}
+	}
+
+	if len(check.Missing) > 0 {
+		log.Printf("🚫 권한 사전 검사: 부족한 권한 %d건 (오브젝트 %d개)", len(check.Missing), check.Objects)
+	} else {
+		log.Printf("🛡️ 권한 사전 검사 통과 (오브젝트 %d개, 검사 %d건)", check.Objects, len(requests))
+	}
+	return check, nil
+}
+
+// filterPermissionKinds - 이름, 이름.그룹, Kind, 축약 이름으로 리소스 종류 필터링
+func filterPermissionKinds(kinds []model.ResourceKind, resources []string) ([]model.ResourceKind, error) {
+	if len(resources) == 0 {
+		return kinds, nil
+	}
+
+	var filtered []model.ResourceKind
+	for _, resource := range resources {
+		lower := strings.ToLower(strings.TrimSpace(resource))
+		found := false
+		for _, kind := range kinds {
+			group := apiGroup(kind.APIVersion)
+			if kind.Name == lower || strings.ToLower(kind.Kind) == lower || (group != "" && kind.Name+"."+group == lower) || slices.Contains(kind.ShortNames, lower) {
+				filtered = append(filtered, kind)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("알 수 없는 리소스 종류입니다: %s", resource)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// PolicyViolationError - deny 모드 정책 위반으로 적용이 차단되었을 때의 에러
+type PolicyViolationError struct {
+	Evaluation *model.PolicyEvaluation
+}
+
+func (e *PolicyViolationError) Error() string {
+	var denied []string
+	for _, finding := range e.Evaluation.Findings {
+		if finding.Mode == model.PolicyModeDeny {
+			denied = append(denied, fmt.Sprintf("[%s] %s/%s %s: %s", finding.Rule, finding.Kind, finding.Name, finding.FieldPath, finding.Message))
+		}
+	}
+	return fmt.Sprintf("정책 위반으로 적용이 차단되었습니다 (%d건): %s", len(denied), strings.Join(denied, "; "))
+}
+
+// PolicyService - 적용 전 정책 검사 서비스
+type PolicyService struct {
+	configPath string
+}
+
+// NewPolicyService - 정책 서비스 생성자
+func NewPolicyService() *PolicyService {
+	// 환경변수 POLICY_CONFIG 우선, 없으면 $HOME/.kube/mykubeapp-policy.yaml
+	configPath := os.Getenv("POLICY_CONFIG")
+	if configPath == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			homeDir = "."
+		}
+		configPath = filepath.Join(homeDir, ".kube", "mykubeapp-policy.yaml")
+	}
+
+	return &PolicyService{
+		configPath: configPath,
+	}
+}
+
+// DefaultPolicyConfig - 설정 파일이 없을 때 사용하는 기본 정책 (모두 경고)
+func DefaultPolicyConfig() model.PolicyConfig {
+	return model.PolicyConfig{
+		Default: model.PolicyProfile{
+			Rules: map[string]string{
+				model.PolicyRuleNoPrivileged:      model.PolicyModeWarn,
+				model.PolicyRuleNoHostPath:        model.PolicyModeWarn,
+				model.PolicyRuleNoHostNetwork:     model.PolicyModeWarn,
+				model.PolicyRuleNoLatestTag:       model.PolicyModeWarn,
+				model.PolicyRuleRequireResources:  model.PolicyModeWarn,
+				model.PolicyRuleRequiredLabels:    model.PolicyModeOff,
+				model.PolicyRuleAllowedRegistries: model.PolicyModeOff,
+			},
+		},
+	}
+}
+
+// GetConfig - 정책 설정 조회 (파일이 없으면 기본값)
+func (ps *PolicyService) GetConfig() (*model.PolicyConfig, error) {
+	if !utils.FileExists(ps.configPath) {
+		config := DefaultPolicyConfig()
+		return &config, nil
+	}
+
+	content, err := utils.ReadFile(ps.configPath)
+	if err != nil {
+		return nil, fmt.Errorf("정책 파일 읽기 실패: %v", err)
+	}
+
+	var config model.PolicyConfig
+	if err := yaml.Unmarshal([]byte(content), &config); err != nil {
+		return nil, fmt.Errorf("정책 파일 파싱 실패: %v", err)
+	}
+
+	return &config, nil
+}
+
+// SaveConfig - 정책 설정 저장
+func (ps *PolicyService) SaveConfig(config model.PolicyConfig) error {
+	if err := ps.validateConfig(config); err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("정책 직렬화 실패: %v", err)
+	}
+
+	if utils.FileExists(ps.configPath) {
+		if err := utils.BackupFile(ps.configPath); err != nil {
+			log.Printf("⚠️  정책 파일 백업 실패 (계속 진행): %v", err)
+		}
+	}
+
+	if err := utils.WriteFile(ps.configPath, string(data)); err != nil {
+		return fmt.Errorf("정책 파일 저장 실패: %v", err)
+	}
+
+	log.Printf("✅ 정책 설정 저장 완료: %s", ps.configPath)
+	return nil
+}
+
+// validateConfig - 규칙 이름과 모드 검증
+func (ps *PolicyService) validateConfig(config model.PolicyConfig) error {
+	profiles := []model.PolicyProfile{config.Default}
+	for _, override := range config.Overrides {
+		profiles = append(profiles, override.Profile)
+	}
+
+	knownRules := DefaultPolicyConfig().Default.Rules
+	for _, profile := range profiles {
+		for rule, mode := range profile.Rules {
+			if _, ok := knownRules[rule]; !ok {
+				return fmt.Errorf("알 수 없는 정책 규칙입니다: %s", rule)
+			}
+			if mode != model.PolicyModeOff && mode != model.PolicyModeWarn && mode != model.PolicyModeDeny {
+				return fmt.Errorf("잘못된 정책 모드입니다 (%s): %s", rule, mode)
+			}
+		}
+	}
+	return nil
+}
+
+// ResolveProfile - context/namespace에 적용될 최종 정책 계산
+func (ps *PolicyService) ResolveProfile(config model.PolicyConfig, contextName, namespace string) model.PolicyProfile {
+	resolved := model.PolicyProfile{
+		Rules:             make(map[string]string),
+		RequiredLabels:    config.Default.RequiredLabels,
+		AllowedRegistries: config.Default.AllowedRegistries,
+	}
+	for rule, mode := range config.Default.Rules {
+		resolved.Rules[rule] = mode
+	}
+
+	for _, override := range config.Overrides {
+		if override.Context != "" && override.Context != contextName {
+			continue
+		}
+		if override.Namespace != "" && override.Namespace != namespace {
+			continue
+		}
+
+		for rule, mode := range override.Profile.Rules {
+			resolved.Rules[rule] = mode
+		}
+		if override.Profile.RequiredLabels != nil {
+			resolved.RequiredLabels = override.Profile.RequiredLabels
+		}
+		if override.Profile.AllowedRegistries != nil {
+			resolved.AllowedRegistries = override.Profile.AllowedRegistries
+		}
+	}
+
+	return resolved
+}
+
+// Evaluate - YAML의 모든 도큐먼트에 대해 정책 평가
+func (ps *PolicyService) Evaluate(yamlContent, contextName, defaultNamespace string) (*model.PolicyEvaluation, error) {
+	log.Printf("🛡️ 정책 평가 시작 (context: %s)", contextName)
+
+	config, err := ps.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := utils.ParseYamlDocuments(yamlContent)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluation := &model.PolicyEvaluation{
+		Context:  contextName,
+		Allowed:  true,
+		Findings: []model.PolicyFinding{},
+	}
+
+	for index, document := range documents {
+		namespace := utils.GetNestedString(document, "metadata", "namespace")
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+		if namespace == "" {
+			namespace = "default"
+		}
+
+		profile := ps.ResolveProfile(*config, contextName, namespace)
+		checker := &policyChecker{
+			profile:   profile,
+			index:     index,
+			kind:      utils.GetNestedString(document, "kind"),
+			name:      utils.GetNestedString(document, "metadata", "name"),
+			namespace: namespace,
+		}
+		checker.checkDocument(document)
+		evaluation.Findings = append(evaluation.Findings, checker.findings...)
+	}
+
+	for _, finding := range evaluation.Findings {
+		if finding.Mode == model.PolicyModeDeny {
+			evaluation.Allowed = false
+			break
+		}
+	}
+
+	log.Printf("✅ 정책 평가 완료 (위반: %d건, 허용: %t)", len(evaluation.Findings), evaluation.Allowed)
+	return evaluation, nil
+}
+
+// policyChecker - 단일 도큐먼트 검사 상태
+type policyChecker struct {
+	profile   model.PolicyProfile
+	index     int
+	kind      string
+	name      string
+	namespace string
+	findings  []model.PolicyFinding
+}
+
+// mode - 규칙의 현재 모드 (미설정 시 off)
+func (pc *policyChecker) mode(rule string) string {
+	if mode, ok := pc.profile.Rules[rule]; ok {
+		return mode
+	}
+	return model.PolicyModeOff
+}
+
+// report - 위반 항목 추가 (off 모드는 무시)
+func (pc *policyChecker) report(rule, fieldPath, message string) {
+	mode := pc.mode(rule)
+	if mode == model.PolicyModeOff {
+		return
+	}
+	pc.findings = append(pc.findings, model.PolicyFinding{
+		DocumentIndex: pc.index,
+		Kind:          pc.kind,
+		Name:          pc.name,
+		Namespace:     pc.namespace,
+		Rule:          rule,
+		Mode:          mode,
+		FieldPath:     fieldPath,
+		Message:       message,
+	})
+}
+
+// checkDocument - 라벨 및 Pod 스펙 검사
+func (pc *policyChecker) checkDocument(document map[string]interface{}) {
+	labels := utils.GetNestedMap(document, "metadata", "labels")
+	for _, label := range pc.profile.RequiredLabels {
+		if _, ok := labels[label]; !ok {
+			pc.report(model.PolicyRuleRequiredLabels, "metadata.labels."+label, fmt.Sprintf("필수 라벨 '%s'이(가) 없습니다", label))
+		}
+	}
+
+	podSpec, specPath := PodSpecOf(document)
+	if podSpec == nil {
+		return
+	}
+
+	if hostNetwork, _ := podSpec["hostNetwork"].(bool); hostNetwork {
+		pc.report(model.PolicyRuleNoHostNetwork, specPath+".hostNetwork", "hostNetwork 사용이 허용되지 않습니다")
+	}
+
+	for i, volume := range utils.GetNestedSlice(podSpec, "volumes") {
+		if v, ok := volume.(map[string]interface{}); ok {
+			if _, ok := v["hostPath"]; ok {
+				pc.report(model.PolicyRuleNoHostPath, fmt.Sprintf("%s.volumes[%d].hostPath", specPath, i), "hostPath 볼륨 사용이 허용되지 않습니다")
+			}
+		}
+	}
+
+	for _, field := range []string{"initContainers", "containers"} {
+		for i, item := range utils.GetNestedSlice(podSpec, field) {
+			container, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			pc.checkContainer(container, fmt.Sprintf("%s.%s[%d]", specPath, field, i))
+		}
+	}
+}
+
+// checkContainer - 컨테이너 단위 검사
+func (pc *policyChecker) checkContainer(container map[string]interface{}, path string) {
+	if privileged, _ := utils.GetNestedValue(container, "securityContext", "privileged"); privileged == true {
+		pc.report(model.PolicyRuleNoPrivileged, path+".securityContext.privileged", "privileged 컨테이너는 허용되지 않습니다")
+	}
+
+	image := utils.GetNestedString(container, "image")
+	if image != "" {
+		if imageTag(image) == "latest" {
+			pc.report(model.PolicyRuleNoLatestTag, path+".image", fmt.Sprintf("이미지 '%s'에 고정 태그를 지정해야 합니다 (:latest 금지)", image))
+		}
+		if len(pc.profile.AllowedRegistries) > 0 && !registryAllowed(image, pc.profile.AllowedRegistries) {
+			pc.report(model.PolicyRuleAllowedRegistries, path+".image", fmt.Sprintf("이미지 '%s'의 레지스트리가 허용 목록에 없습니다", image))
+		}
+	}
+
+	for _, section := range []string{"requests", "limits"} {
+		values := utils.GetNestedMap(container, "resources", section)
+		for _, resource := range []string{"cpu", "memory"} {
+			if _, ok := values[resource]; !ok {
+				pc.report(model.PolicyRuleRequireResources, fmt.Sprintf("%s.resources.%s.%s", path, section, resource),
+					fmt.Sprintf("resources.%s.%s 값이 필요합니다", section, resource))
+			}
+		}
+	}
+}
+
+// PodSpecOf - 워크로드 종류별 Pod 스펙과 그 필드 경로 반환
+func PodSpecOf(document map[string]interface{}) (map[string]interface{}, string) {
+	switch utils.GetNestedString(document, "kind") {
+	case "Pod":
+		return utils.GetNestedMap(document, "spec"), "spec"
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "ReplicationController", "Job":
+		return utils.GetNestedMap(document, "spec", "template", "spec"), "spec.template.spec"
+	case "CronJob":
+		return utils.GetNestedMap(document, "spec", "jobTemplate", "spec", "template", "spec"), "spec.jobTemplate.spec.template.spec"
+	}
+	return nil, ""
+}
+
+// imageTag - 이미지 태그 추출 (태그와 digest가 모두 없으면 latest)
+func imageTag(image string) string {
+	if strings.Contains(image, "@") {
+		return ""
+	}
+	lastSlash := strings.LastIndex(image, "/")
+	if colon := strings.LastIndex(image, ":"); colon > lastSlash {
+		return image[colon+1:]
+	}
+	return "latest"
+}
+
+// imageRegistry - 이미지의 레지스트리 추출 (없으면 docker.io)
+func imageRegistry(image string) string {
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return parts[0]
+	}
+	return "docker.io"
+}
+
+// registryAllowed - 허용 목록에 포함된 레지스트리(또는 경로 접두사)인지 확인
+func registryAllowed(image string, allowed []string) bool {
+	registry := imageRegistry(image)
+	fullName := image
+	if registry == "docker.io" && !strings.HasPrefix(image, "docker.io/") {
+		fullName = "docker.io/" + image
+	}
+
+	for _, prefix := range allowed {
+		prefix = strings.TrimSuffix(prefix, "/")
+		if registry == prefix || strings.HasPrefix(fullName, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
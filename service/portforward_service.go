@@ -0,0 +1,314 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+)
+
+// 포트포워드 세션 제한
+const (
+	defaultPortForwardTTL   = 30 * time.Minute
+	maxPortForwardTTL       = 8 * time.Hour
+	maxPortForwardSessions  = 20
+	portForwardJanitorCycle = 30 * time.Second
+)
+
+// 오브젝트 이름 규칙 (DNS 서브도메인, kubectl 플래그로 해석되지 않도록 검증)
+var objectNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+
+// PortForwardNotFoundError - 세션이 없거나 이미 종료됨
+type PortForwardNotFoundError struct {
+	ID string
+}
+
+func (e *PortForwardNotFoundError) Error() string {
+	return fmt.Sprintf("포트포워드 세션을 찾을 수 없습니다: %s", e.ID)
+}
+
+// portForwardEntry - 세션 정보와 kubectl 프로세스, 프록시
+type portForwardEntry struct {
+	session     model.PortForwardSession
+	forward     *kubernetes.PortForward
+	proxy       *httputil.ReverseProxy
+	ttl         time.Duration
+	createdTime time.Time
+	lastUsed    time.Time
+	activeConns int // 진행 중인 프록시 요청/WebSocket 수 (사용 중에는 만료하지 않음)
+}
+
+// PortForwardService - 관리형 포트포워드 세션과 HTTP/WebSocket 리버스 프록시
+type PortForwardService struct {
+	mutex    sync.Mutex
+	sessions map[string]*portForwardEntry
+}
+
+// NewPortForwardService - 포트포워드 서비스 생성자 (만료 세션 정리 고루틴 시작)
+func NewPortForwardService() *PortForwardService {
+	pfs := &PortForwardService{
+		sessions: make(map[string]*portForwardEntry),
+	}
+	go pfs.runJanitor()
+	return pfs
+}
+
+// StartPortForward - 파드/서비스로 포트포워드 시작 후 세션 등록
+func (pfs *PortForwardService) StartPortForward(req model.PortForwardRequest) (*model.PortForwardSession, error) {
+	target, kind, err := normalizePortForwardRequest(&req)
+	if err != nil {
+		return nil, err
+	}
+	ttl := defaultPortForwardTTL
+	if req.TTL != "" {
+		ttl, err = time.ParseDuration(req.TTL)
+		if err != nil || ttl <= 0 {
+			return nil, fmt.Errorf("ttl 값이 올바르지 않습니다: %s", req.TTL)
+		}
+		if ttl > maxPortForwardTTL {
+			ttl = maxPortForwardTTL
+		}
+	}
+
+	pfs.mutex.Lock()
+	count := len(pfs.sessions)
+	pfs.mutex.Unlock()
+	if count >= maxPortForwardSessions {
+		return nil, fmt.Errorf("동시에 사용할 수 있는 포트포워드는 최대 %d개입니다", maxPortForwardSessions)
+	}
+
+	log.Printf("🔀 포트포워드 시작: %s/%s:%d", req.Namespace, target, req.Port)
+
+	forward, err := kubernetes.StartPortForward(req.Namespace, target, req.Port)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	id := fmt.Sprintf("pf_%d", now.UnixNano())
+	entry := &portForwardEntry{
+		session: model.PortForwardSession{
+			ID:          id,
+			Namespace:   req.Namespace,
+			Kind:        kind,
+			Name:        req.Name,
+			RemotePort:  req.Port,
+			LocalPort:   forward.LocalPort,
+			ProxyPath:   PortForwardProxyPath(id),
+			Status:      model.PortForwardStatusActive,
+			TTL:         ttl.String(),
+			CreatedTime: now.Format("2006-01-02 15:04:05"),
+		},
+		forward:     forward,
+		ttl:         ttl,
+		createdTime: now,
+		lastUsed:    now,
+	}
+	entry.proxy = newPortForwardProxy(entry.session.ProxyPath, forward.LocalPort)
+
+	pfs.mutex.Lock()
+	pfs.sessions[id] = entry
+	session := pfs.snapshot(entry)
+	pfs.mutex.Unlock()
+
+	// kubectl 프로세스가 스스로 종료되면 세션 제거
+	go func() {
+		<-forward.Done()
+		pfs.remove(id, model.PortForwardStatusFailed)
+	}()
+
+	log.Printf("✅ 포트포워드 세션 생성: %s (127.0.0.1:%d -> %s/%s:%d)", id, forward.LocalPort, req.Namespace, target, req.Port)
+	return &session, nil
+}
+
+// ListPortForwards - 활성 포트포워드 세션 목록 (생성 순)
+func (pfs *PortForwardService) ListPortForwards() []model.PortForwardSession {
+	pfs.mutex.Lock()
+	defer pfs.mutex.Unlock()
+
+	sessions := []model.PortForwardSession{}
+	for _, entry := range pfs.sessions {
+		sessions = append(sessions, pfs.snapshot(entry))
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].ID < sessions[j].ID
+	})
+	return sessions
+}
+
+// GetPortForward - 포트포워드 세션 조회
+func (pfs *PortForwardService) GetPortForward(id string) (*model.PortForwardSession, error) {
+	pfs.mutex.Lock()
+	defer pfs.mutex.Unlock()
+
+	entry, exists := pfs.sessions[id]
+	if !exists {
+		return nil, &PortForwardNotFoundError{ID: id}
+	}
+	session := pfs.snapshot(entry)
+	return &session, nil
+}
+
+// StopPortForward - 포트포워드 세션 중지
+func (pfs *PortForwardService) StopPortForward(id string) (*model.PortForwardSession, error) {
+	session, stopped := pfs.remove(id, model.PortForwardStatusStopped)
+	if !stopped {
+		return nil, &PortForwardNotFoundError{ID: id}
+	}
+	return session, nil
+}
+
+// ServeProxy - 세션의 로컬 포트로 HTTP/WebSocket 요청 중계
+func (pfs *PortForwardService) ServeProxy(id string, w http.ResponseWriter, r *http.Request) error {
+	pfs.mutex.Lock()
+	entry, exists := pfs.sessions[id]
+	if exists {
+		entry.activeConns++
+		entry.lastUsed = time.Now()
+	}
+	pfs.mutex.Unlock()
+	if !exists {
+		return &PortForwardNotFoundError{ID: id}
+	}
+
+	defer func() {
+		pfs.mutex.Lock()
+		entry.activeConns--
+		entry.lastUsed = time.Now()
+		pfs.mutex.Unlock()
+	}()
+
+	entry.proxy.ServeHTTP(w, r)
+	return nil
+}
+
+// PortForwardProxyPath - 세션 프록시 경로
+func PortForwardProxyPath(id string) string {
+	return "/api/portforwards/" + id + "/proxy/"
+}
+
+// remove - 세션을 목록에서 제거하고 kubectl 프로세스 종료
+func (pfs *PortForwardService) remove(id, status string) (*model.PortForwardSession, bool) {
+	pfs.mutex.Lock()
+	entry, exists := pfs.sessions[id]
+	if exists {
+		delete(pfs.sessions, id)
+	}
+	pfs.mutex.Unlock()
+	if !exists {
+		return nil, false
+	}
+
+	entry.forward.Stop()
+
+	session := entry.session
+	session.Status = status
+	session.LastUsedTime = entry.lastUsed.Format("2006-01-02 15:04:05")
+
+	switch status {
+	case model.PortForwardStatusFailed:
+		log.Printf("❌ 포트포워드 프로세스 종료: %s (%v)", id, entry.forward.Err())
+	case model.PortForwardStatusExpired:
+		log.Printf("⏰ 포트포워드 세션 만료: %s (%s 동안 미사용)", id, entry.ttl)
+	default:
+		log.Printf("🛑 포트포워드 세션 중지: %s", id)
+	}
+	return &session, true
+}
+
+// runJanitor - 유휴 시간이 TTL을 넘은 세션을 주기적으로 정리
+func (pfs *PortForwardService) runJanitor() {
+	ticker := time.NewTicker(portForwardJanitorCycle)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		var expired []string
+		pfs.mutex.Lock()
+		for id, entry := range pfs.sessions {
+			if entry.activeConns == 0 && now.Sub(entry.lastUsed) > entry.ttl {
+				expired = append(expired, id)
+			}
+		}
+		pfs.mutex.Unlock()
+
+		for _, id := range expired {
+			pfs.remove(id, model.PortForwardStatusExpired)
+		}
+	}
+}
+
+// snapshot - 응답용 세션 정보 (호출자가 mutex 보유)
+func (pfs *PortForwardService) snapshot(entry *portForwardEntry) model.PortForwardSession {
+	session := entry.session
+	session.LastUsedTime = entry.lastUsed.Format("2006-01-02 15:04:05")
+	session.ExpiresTime = entry.lastUsed.Add(entry.ttl).Format("2006-01-02 15:04:05")
+	return session
+}
+
+// normalizePortForwardRequest - 요청 검증 및 kubectl 대상 문자열(pod/이름, svc/이름) 생성
+func normalizePortForwardRequest(req *model.PortForwardRequest) (string, string, error) {
+	if req.Namespace == "" {
+		req.Namespace = "default"
+	}
+	if err := kubernetes.ValidateNamespaceName(req.Namespace); err != nil {
+		return "", "", err
+	}
+	if !objectNamePattern.MatchString(req.Name) {
+		return "", "", fmt.Errorf("잘못된 대상 이름입니다: %s", req.Name)
+	}
+	if req.Port <= 0 || req.Port > 65535 {
+		return "", "", fmt.Errorf("포트 번호가 올바르지 않습니다: %d", req.Port)
+	}
+
+	switch strings.ToLower(req.Kind) {
+	case "pod", "pods", "po":
+		return "pod/" + req.Name, "pod", nil
+	case "", "service", "services", "svc":
+		return "svc/" + req.Name, "service", nil
+	default:
+		return "", "", fmt.Errorf("지원하지 않는 대상 종류입니다: %s (pod 또는 service)", req.Kind)
+	}
+}
+
+// newPortForwardProxy - 프록시 경로를 제거하고 로컬 포트로 전달하는 리버스 프록시
+// WebSocket 업그레이드는 httputil.ReverseProxy가 그대로 중계
+func newPortForwardProxy(proxyPath string, localPort int) *httputil.ReverseProxy {
+	target := &url.URL{Scheme: "http", Host: fmt.Sprintf("127.0.0.1:%d", localPort)}
+	prefix := strings.TrimSuffix(proxyPath, "/")
+
+	return &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			path := strings.TrimPrefix(pr.In.URL.Path, prefix)
+			if !strings.HasPrefix(path, "/") {
+				path = "/" + path
+			}
+			pr.Out.URL.Path = path
+			pr.Out.URL.RawPath = ""
+			pr.SetURL(target)
+			pr.SetXForwarded()
+			pr.Out.Header.Set("X-Forwarded-Prefix", prefix)
+		},
+		// 스트리밍 응답(SSE 등)이 지연되지 않도록 즉시 flush
+		FlushInterval: -1,
+		ModifyResponse: func(resp *http.Response) error {
+			// 대상 앱의 절대 경로 리다이렉트를 프록시 경로 아래로 변환
+			if location := resp.Header.Get("Location"); strings.HasPrefix(location, "/") && !strings.HasPrefix(location, "//") {
+				resp.Header.Set("Location", prefix+location)
+			}
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			log.Printf("❌ 포트포워드 프록시 오류 (%s): %v", prefix, err)
+			http.Error(w, "포트포워드 대상에 연결할 수 없습니다: "+err.Error(), http.StatusBadGateway)
+		},
+	}
+}
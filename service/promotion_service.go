@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// PromotionService - 컨텍스트 간 리소스 복사/승격 서비스
+type PromotionService struct {
+	exportService    *ExportService
+	kubeService      *KubeService
+	namespaceManager *kubernetes.NamespaceManager
+}
+
+// NewPromotionService - 승격 서비스 생성자
+func NewPromotionService() *PromotionService {
+	return NewPromotionServiceWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewPromotionServiceWithExecutor - 지정한 명령 실행기를 사용하는 승격 서비스 생성자
+func NewPromotionServiceWithExecutor(executor utils.CommandExecutor) *PromotionService {
+	return &PromotionService{
+		exportService:    NewExportServiceWithExecutor(executor),
+		kubeService:      NewKubeServiceWithExecutor(executor),
+		namespaceManager: kubernetes.NewNamespaceManagerWithExecutor(executor),
+	}
+}
+
+// Promote - 원본에서 리소스를 내보내 변환한 뒤 대상과 diff, dry-run 수행 (apply=true면 적용까지)
+func (ps *PromotionService) Promote(ctx context.Context, req model.PromotionRequest) (*model.PromotionResult, error) {
+	if req.SourceNamespace == "" {
+		req.SourceNamespace = "default"
+	}
+	if req.TargetNamespace == "" {
+		req.TargetNamespace = req.SourceNamespace
+	}
+	if err := kubernetes.ValidateNamespaceName(req.TargetNamespace); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(req.TargetContext, "-") {
+		return nil, fmt.Errorf("잘못된 context 이름입니다: %s", req.TargetContext)
+	}
+
+	currentContext := ps.kubeService.GetCurrentContext(ctx)
+	sourceContext := defaultString(req.SourceContext, currentContext)
+	targetContext := defaultString(req.TargetContext, currentContext)
+	if sourceContext == targetContext && req.SourceNamespace == req.TargetNamespace {
+		return nil, fmt.Errorf("원본과 대상이 같습니다 (%s/%s)", sourceContext, req.SourceNamespace)
+	}
+
+	log.Printf("🚚 리소스 승격 시작: %s/%s → %s/%s (apply: %t)", sourceContext, req.SourceNamespace, targetContext, req.TargetNamespace, req.Apply)
+
+	documents, exported, err := ps.exportService.ExportObjects(ctx, model.ExportRequest{
+		Context:       req.SourceContext,
+		Namespace:     req.SourceNamespace,
+		Resources:     req.Resources,
+		LabelSelector: req.LabelSelector,
+		Kinds:         req.Kinds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("복사할 리소스가 없습니다")
+	}
+
+	changes := TransformPromotedObjects(documents, req.Transform, req.SourceNamespace, req.TargetNamespace)
+
+	yamlContent, err := utils.MarshalYamlDocuments(documents)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.PromotionResult{
+		SourceContext:   sourceContext,
+		SourceNamespace: req.SourceNamespace,
+		TargetContext:   targetContext,
+		TargetNamespace: req.TargetNamespace,
+		Resources:       exported.Resources,
+		Skipped:         exported.Skipped,
+		Changes:         changes,
+		YamlContent:     yamlContent,
+	}
+
+	// 대상 네임스페이스가 없으면 kubectl diff가 실패하므로 전체 신규 생성으로 표시
+	exists, err := ps.namespaceManager.ForContext(req.TargetContext).NamespaceExists(ctx, req.TargetNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		if !req.CreateNamespace {
+			return nil, fmt.Errorf("대상 네임스페이스가 없습니다: %s (createNamespace를 지정하세요)", req.TargetNamespace)
+		}
+		result.NamespaceMissing = true
+		result.HasChanges = true
+	} else {
+		result.Diff, result.HasChanges, err = kubernetes.Diff(ctx, req.TargetContext, req.TargetNamespace, yamlContent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 정책 검사를 포함한 dry-run (실패하면 적용하지 않음)
+	applyRequest := model.ApplyYamlRequest{
+		YamlContent:     yamlContent,
+		Namespace:       req.TargetNamespace,
+		Context:         req.TargetContext,
+		CreateNamespace: req.CreateNamespace,
+		DryRun:          true,
+	}
+	result.DryRun, err = ps.kubeService.ApplyYaml(ctx, applyRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Apply {
+		log.Printf("✅ 리소스 승격 미리보기 완료: %d개 (차이 있음: %t)", len(result.Resources), result.HasChanges)
+		return result, nil
+	}
+
+	applyRequest.DryRun = false
+	result.Applied, err = ps.kubeService.ApplyYaml(ctx, applyRequest)
+	if err != nil {
+		return nil, err
+	}
+	result.PromotedTime = time.Now().Format("2006-01-02 15:04:05")
+
+	log.Printf("✅ 리소스 승격 완료: %s/%s → %s/%s (%d개)", sourceContext, req.SourceNamespace, targetContext, req.TargetNamespace, len(result.Resources))
+	return result, nil
+}
+
+// TransformPromotedObjects - 네임스페이스 변경, 이미지 태그, 레플리카, 라벨 변환 후 변경 내역 반환
+func TransformPromotedObjects(documents []map[string]interface{}, transform model.PromotionTransform, sourceNamespace, targetNamespace string) []string {
+	changes := []string{}
+
+	for _, object := range documents {
+		kind := utils.GetNestedString(object, "kind")
+		name := utils.GetNestedString(object, "metadata", "name")
+		reference := strings.ToLower(kind) + "/" + name
+
+		// RoleBinding 등의 subjects가 원본 네임스페이스를 가리키면 대상 네임스페이스로 변경
+		if sourceNamespace != targetNamespace {
+			for _, item := range utils.GetNestedSlice(object, "subjects") {
+				subject, ok := item.(map[string]interface{})
+				if ok && subject["namespace"] == sourceNamespace {
+					subject["namespace"] = targetNamespace
+					changes = append(changes, fmt.Sprintf("%s: subject %s 네임스페이스 %s → %s", reference, subject["name"], sourceNamespace, targetNamespace))
+				}
+			}
+		}
+
+		if replicas, ok := promotionReplicas(transform.Replicas, kind, name, reference); ok {
+			if spec := utils.GetNestedMap(object, "spec"); spec != nil {
+				spec["replicas"] = replicas
+				changes = append(changes, fmt.Sprintf("%s: replicas → %d", reference, replicas))
+			}
+		}
+
+		for _, podSpec := range podSpecs(object) {
+			for _, field := range []string{"initContainers", "containers"} {
+				for _, item := range utils.GetNestedSlice(podSpec, field) {
+					container, ok := item.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					image, _ := container["image"].(string)
+					if updated, changed := overrideImageTag(image, transform.Images); changed {
+						container["image"] = updated
+						changes = append(changes, fmt.Sprintf("%s: %s 이미지 %s → %s", reference, container["name"], image, updated))
+					}
+				}
+			}
+		}
+
+		if len(transform.SetLabels) > 0 || len(transform.RemoveLabels) > 0 {
+			metadata := utils.GetNestedMap(object, "metadata")
+			labels, _ := metadata["labels"].(map[string]interface{})
+			if labels == nil {
+				labels = map[string]interface{}{}
+			}
+			for _, key := range transform.RemoveLabels {
+				delete(labels, key)
+			}
+			for key, value := range transform.SetLabels {
+				labels[key] = value
+			}
+			if len(labels) > 0 {
+				metadata["labels"] = labels
+			} else {
+				delete(metadata, "labels")
+			}
+		}
+	}
+
+	if len(transform.SetLabels) > 0 || len(transform.RemoveLabels) > 0 {
+		keys := make([]string, 0, len(transform.SetLabels))
+		for key := range transform.SetLabels {
+			keys = append(keys, key+"="+transform.SetLabels[key])
+		}
+		sort.Strings(keys)
+		changes = append(changes, fmt.Sprintf("모든 리소스: 라벨 설정 %v, 제거 %v", keys, transform.RemoveLabels))
+	}
+	if sourceNamespace != targetNamespace {
+		changes = append(changes, fmt.Sprintf("모든 리소스: 네임스페이스 %s → %s", sourceNamespace, targetNamespace))
+	}
+	return changes
+}
+
+// promotionReplicas - kind/name 또는 이름으로 지정된 레플리카 수 (Deployment, StatefulSet만)
+func promotionReplicas(replicas map[string]int, kind, name, reference string) (int, bool) {
+	if kind != "Deployment" && kind != "StatefulSet" {
+		return 0, false
+	}
+	if value, ok := replicas[reference]; ok {
+		return value, true
+	}
+	value, ok := replicas[name]
+	return value, ok
+}
+
+// podSpecs - 워크로드의 파드 템플릿 spec 목록 (CronJob은 jobTemplate 내부)
+func podSpecs(object map[string]interface{}) []map[string]interface{} {
+	var specs []map[string]interface{}
+	if spec := utils.GetNestedMap(object, "spec", "template", "spec"); spec != nil {
+		specs = append(specs, spec)
+	}
+	if spec := utils.GetNestedMap(object, "spec", "jobTemplate", "spec", "template", "spec"); spec != nil {
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// overrideImageTag - 저장소(전체 경로 또는 마지막 이름)가 일치하면 태그 교체 (다이제스트는 제거)
+func overrideImageTag(image string, tags map[string]string) (string, bool) {
+	if image == "" || len(tags) == 0 {
+		return image, false
+	}
+
+	repository := image
+	if index := strings.Index(repository, "@"); index >= 0 {
+		repository = repository[:index]
+	}
+	if index := strings.LastIndex(repository, ":"); index > strings.LastIndex(repository, "/") {
+		repository = repository[:index]
+	}
+
+	tag, ok := tags[repository]
+	if !ok {
+		tag, ok = tags[repository[strings.LastIndex(repository, "/")+1:]]
+	}
+	if !ok || tag == "" {
+		return image, false
+	}
+
+	updated := repository + ":" + tag
+	return updated, updated != image
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"mykubeapp/model"
+	"mykubeapp/utils/utilstest"
+)
+
+func TestPromoteKeepsPlaceholdersInExportedContent(t *testing.T) {
+	executor := utilstest.NewFakeExecutor().
+		On("kubectl config current-context", "dev\n").
+		On("kubectl get -n web -o json", `{"items": [{
+			"apiVersion": "v1", "kind": "ConfigMap",
+			"metadata": {"name": "nginx", "namespace": "web", "resourceVersion": "12"},
+			"data": {"default.conf": "proxy_pass http://${UPSTREAM_HOST}:${UPSTREAM_PORT};"}
+		}]}`).
+		On("kubectl --context prod get namespace web", "").
+		On("kubectl --context prod api-resources", "configmaps   cm   v1   true   ConfigMap\n").
+		On("kubectl --context prod create -f", `{"kind": "SelfSubjectAccessReview", "status": {"allowed": true}}`)
+	var applied []string
+	executor.OnFunc("kubectl --context prod apply", func(call utilstest.FakeCommandCall) (string, error) {
+		for i, arg := range call.Args {
+			if arg == "-f" && i+1 < len(call.Args) {
+				content, err := os.ReadFile(call.Args[i+1])
+				if err != nil {
+					t.Errorf("적용 파일 읽기 실패: %v", err)
+				}
+				applied = append(applied, string(content))
+			}
+		}
+		return "configmap/nginx created (dry run)\n", nil
+	})
+
+	result, err := NewPromotionServiceWithExecutor(executor).Promote(context.Background(), model.PromotionRequest{
+		SourceNamespace: "web",
+		TargetContext:   "prod",
+		Kinds:           []string{"configmaps"},
+		CreateNamespace: true,
+	})
+	if err != nil {
+		t.Fatalf("승격 실패: %v", err)
+	}
+	if !result.NamespaceMissing || len(applied) != 1 {
+		t.Fatalf("승격 결과 = %+v, 적용 %d회", result, len(applied))
+	}
+	if !strings.Contains(applied[0], "${UPSTREAM_HOST}:${UPSTREAM_PORT}") {
+		t.Fatalf("내보낸 내용의 ${...}가 그대로 적용되어야 합니다:\n%s", applied[0])
+	}
+}
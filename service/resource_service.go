@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// ResourceService - 읽기 전용 리소스 조회 서비스
+type ResourceService struct {
+	browser *kubernetes.ResourceBrowser
+}
+
+// NewResourceService - 리소스 조회 서비스 생성자
+func NewResourceService() *ResourceService {
+	return &ResourceService{
+		browser: kubernetes.NewResourceBrowser(),
+	}
+}
+
+// ListKinds - 조회 가능한 리소스 종류 목록
+func (rs *ResourceService) ListKinds(ctx context.Context, kubeContext string) ([]model.ResourceKind, error) {
+	if strings.HasPrefix(kubeContext, "-") {
+		return nil, fmt.Errorf("잘못된 context 이름입니다: %s", kubeContext)
+	}
+	return rs.browser.DiscoverKinds(ctx, kubeContext)
+}
+
+// ListResources - 종류별 리소스 목록을 요약 형태로 조회
+func (rs *ResourceService) ListResources(ctx context.Context, query model.ResourceListQuery) (*model.ResourceListResult, error) {
+	if strings.HasPrefix(query.Context, "-") {
+		return nil, fmt.Errorf("잘못된 context 이름입니다: %s", query.Context)
+	}
+	kind, err := rs.browser.ResolveKind(ctx, query.Context, query.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if !kind.Namespaced {
+		query.Namespace = ""
+	}
+
+	log.Printf("🔎 리소스 목록 조회: %s (네임스페이스: %s, 라벨: %s, 필드: %s)", kind.Name, query.Namespace, query.LabelSelector, query.FieldSelector)
+
+	list, err := rs.browser.List(ctx, kind, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.ResourceListResult{
+		Kind:       kind.Kind,
+		APIVersion: kind.APIVersion,
+		Namespace:  query.Namespace,
+		Items:      []model.ResourceSummary{},
+		Continue:   utils.GetNestedString(list, "metadata", "continue"),
+	}
+	if remaining, ok := utils.GetNestedValue(list, "metadata", "remainingItemCount"); ok {
+		if count, ok := remaining.(float64); ok {
+			value := int64(count)
+			result.RemainingItemCount = &value
+		}
+	}
+
+	for _, item := range utils.GetNestedSlice(list, "items") {
+		if object, ok := item.(map[string]interface{}); ok {
+			result.Items = append(result.Items, SummarizeResource(kind.Kind, object))
+		}
+	}
+
+	log.Printf("✅ 리소스 목록 조회 완료: %s (%d개)", kind.Name, len(result.Items))
+	return result, nil
+}
+
+// GetResource - 리소스 단건 조회
+func (rs *ResourceService) GetResource(ctx context.Context, kubeContext, kindName, namespace, name string) (*model.ResourceDetail, error) {
+	if strings.HasPrefix(kubeContext, "-") {
+		return nil, fmt.Errorf("잘못된 context 이름입니다: %s", kubeContext)
+	}
+	kind, err := rs.browser.ResolveKind(ctx, kubeContext, kindName)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := rs.browser.Get(ctx, kubeContext, kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ResourceDetail{
+		Summary: SummarizeResource(kind.Kind, object),
+		Object:  object,
+	}, nil
+}
+
+// SummarizeResource - 종류별 상태 컬럼을 포함한 요약 생성
+func SummarizeResource(kind string, object map[string]interface{}) model.ResourceSummary {
+	createdTime := utils.GetNestedString(object, "metadata", "creationTimestamp")
+	summary := model.ResourceSummary{
+		Name:        utils.GetNestedString(object, "metadata", "name"),
+		Namespace:   utils.GetNestedString(object, "metadata", "namespace"),
+		Kind:        kind,
+		Details:     map[string]string{},
+		Labels:      stringMap(utils.GetNestedMap(object, "metadata", "labels")),
+		CreatedTime: createdTime,
+		Age:         formatAge(createdTime),
+	}
+
+	status := utils.GetNestedMap(object, "status")
+	spec := utils.GetNestedMap(object, "spec")
+
+	switch kind {
+	case "Pod":
+		summarizePod(&summary, object)
+	case "Deployment":
+		summary.Status = fmt.Sprintf("%d/%d Ready", intValue(status["readyReplicas"]), intValueOr(spec["replicas"], 1))
+		summary.Details["upToDate"] = fmt.Sprint(intValue(status["updatedReplicas"]))
+		summary.Details["available"] = fmt.Sprint(intValue(status["availableReplicas"]))
+	case "StatefulSet", "ReplicaSet":
+		summary.Status = fmt.Sprintf("%d/%d Ready", intValue(status["readyReplicas"]), intValueOr(spec["replicas"], 1))
+	case "DaemonSet":
+		summary.Status = fmt.Sprintf("%d/%d Ready", intValue(status["numberReady"]), intValue(status["desiredNumberScheduled"]))
+		summary.Details["available"] = fmt.Sprint(intValue(status["numberAvailable"]))
+	case "Job":
+		summary.Status = fmt.Sprintf("%d/%d Completions", intValue(status["succeeded"]), intValueOr(spec["completions"], 1))
+		if intValue(status["failed"]) > 0 {
+			summary.Details["failed"] = fmt.Sprint(intValue(status["failed"]))
+		}
+	case "CronJob":
+		summary.Status = "Active"
+		if suspend, _ := spec["suspend"].(bool); suspend {
+			summary.Status = "Suspended"
+		}
+		summary.Details["schedule"] = utils.GetNestedString(spec, "schedule")
+		summary.Details["lastSchedule"] = utils.GetNestedString(status, "lastScheduleTime")
+		summary.Details["active"] = fmt.Sprint(len(utils.GetNestedSlice(status, "active")))
+	case "Service":
+		summary.Status = utils.GetNestedString(spec, "type")
+		summary.Details["clusterIP"] = utils.GetNestedString(spec, "clusterIP")
+		summary.Details["ports"] = servicePorts(spec)
+		summary.Details["externalIP"] = loadBalancerAddresses(status)
+	case "Ingress":
+		summary.Details["class"] = utils.GetNestedString(spec, "ingressClassName")
+		summary.Details["hosts"] = ingressHosts(spec)
+		summary.Details["address"] = loadBalancerAddresses(status)
+		summary.Status = "Pending"
+		if summary.Details["address"] != "" {
+			summary.Status = "Ready"
+		}
+	case "ConfigMap":
+		count := len(utils.GetNestedMap(object, "data")) + len(utils.GetNestedMap(object, "binaryData"))
+		summary.Status = fmt.Sprintf("%d keys", count)
+	case "Secret":
+		summary.Status = utils.GetNestedString(object, "type")
+		summary.Details["keys"] = fmt.Sprint(len(utils.GetNestedMap(object, "data")))
+	case "Event":
+		summary.Status = utils.GetNestedString(object, "type")
+		summary.Details["reason"] = utils.GetNestedString(object, "reason")
+		summary.Details["object"] = utils.GetNestedString(object, "involvedObject", "kind") + "/" + utils.GetNestedString(object, "involvedObject", "name")
+		summary.Details["message"] = utils.GetNestedString(object, "message")
+		summary.Details["count"] = fmt.Sprint(intValueOr(object["count"], 1))
+		summary.Details["lastSeen"] = utils.GetNestedString(object, "lastTimestamp")
+	case "Node":
+		summarizeNode(&summary, object)
+	case "PersistentVolumeClaim", "PersistentVolume":
+		summary.Status = utils.GetNestedString(status, "phase")
+		summary.Details["capacity"] = utils.GetNestedString(status, "capacity", "storage")
+		summary.Details["storageClass"] = utils.GetNestedString(spec, "storageClassName")
+	case "Namespace":
+		summary.Status = utils.GetNestedString(status, "phase")
+	default:
+		// 알 수 없는 종류는 status.phase 또는 Ready 조건으로 요약
+		summary.Status = utils.GetNestedString(status, "phase")
+		if summary.Status == "" {
+			summary.Status = conditionStatus(status, "Ready")
+		}
+	}
+
+	return summary
+}
+
+// summarizePod - Pod 상태 (kubectl get pods의 STATUS/READY/RESTARTS 컬럼과 유사)
+func summarizePod(summary *model.ResourceSummary, object map[string]interface{}) {
+	status := utils.GetNestedMap(object, "status")
+	summary.Status = utils.GetNestedString(status, "phase")
+	if reason := utils.GetNestedString(status, "reason"); reason != "" {
+		summary.Status = reason
+	}
+
+	containers := utils.GetNestedSlice(status, "containerStatuses")
+	ready, restarts := 0, 0
+	for _, item := range containers {
+		container, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if isReady, _ := container["ready"].(bool); isReady {
+			ready++
+		}
+		restarts += intValue(container["restartCount"])
+
+		// 대기 중/종료된 컨테이너의 사유가 더 구체적인 상태
+		if reason := utils.GetNestedString(container, "state", "waiting", "reason"); reason != "" {
+			summary.Status = reason
+		} else if reason := utils.GetNestedString(container, "state", "terminated", "reason"); reason != "" && summary.Status == "Running" {
+			summary.Status = reason
+		}
+	}
+	if utils.GetNestedString(object, "metadata", "deletionTimestamp") != "" {
+		summary.Status = "Terminating"
+	}
+
+	total := len(utils.GetNestedSlice(object, "spec", "containers"))
+	summary.Details["ready"] = fmt.Sprintf("%d/%d", ready, total)
+	summary.Details["restarts"] = fmt.Sprint(restarts)
+	summary.Details["node"] = utils.GetNestedString(object, "spec", "nodeName")
+	summary.Details["podIP"] = utils.GetNestedString(status, "podIP")
+}
+
+// summarizeNode - 노드 상태 (Ready 조건, 역할, kubelet 버전)
+func summarizeNode(summary *model.ResourceSummary, object map[string]interface{}) {
+	status := utils.GetNestedMap(object, "status")
+	summary.Status = "NotReady"
+	if conditionStatus(status, "Ready") == "True" {
+		summary.Status = "Ready"
+	}
+	if unschedulable, _ := utils.GetNestedValue(object, "spec", "unschedulable"); unschedulable == true {
+		summary.Status += ",SchedulingDisabled"
+	}
+
+	var roles []string
+	for label := range utils.GetNestedMap(object, "metadata", "labels") {
+		if strings.HasPrefix(label, "node-role.kubernetes.io/") {
+			roles = append(roles, strings.TrimPrefix(label, "node-role.kubernetes.io/"))
+		}
+	}
+	sort.Strings(roles)
+	summary.Details["roles"] = strings.Join(roles, ",")
+	summary.Details["version"] = utils.GetNestedString(status, "nodeInfo", "kubeletVersion")
+}
+
+// conditionStatus - status.conditions 에서 특정 조건의 상태 값
+func conditionStatus(status map[string]interface{}, conditionType string) string {
+	for _, item := range utils.GetNestedSlice(status, "conditions") {
+		condition, ok := item.(map[string]interface{})
+		if ok && utils.GetNestedString(condition, "type") == conditionType {
+			return utils.GetNestedString(condition, "status")
+		}
+	}
+	return ""
+}
+
+// servicePorts - "80/TCP,443:30443/TCP" 형식의 포트 목록
+func servicePorts(spec map[string]interface{}) string {
+	var ports []string
+	for _, item := range utils.GetNestedSlice(spec, "ports") {
+		port, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		value := fmt.Sprint(intValue(port["port"]))
+		if nodePort := intValue(port["nodePort"]); nodePort > 0 {
+			value += fmt.Sprintf(":%d", nodePort)
+		}
+		protocol := utils.GetNestedString(port, "protocol")
+		if protocol == "" {
+			protocol = "TCP"
+		}
+		ports = append(ports, value+"/"+protocol)
+	}
+	return strings.Join(ports, ",")
+}
+
+// ingressHosts - Ingress 규칙의 호스트 목록
+func ingressHosts(spec map[string]interface{}) string {
+	var hosts []string
+	for _, item := range utils.GetNestedSlice(spec, "rules") {
+		if rule, ok := item.(map[string]interface{}); ok {
+			if host := utils.GetNestedString(rule, "host"); host != "" {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+	if len(hosts) == 0 {
+		return "*"
+	}
+	return strings.Join(hosts, ",")
+}
+
+// loadBalancerAddresses - status.loadBalancer.ingress 의 IP/호스트 목록
+func loadBalancerAddresses(status map[string]interface{}) string {
+	var addresses []string
+	for _, item := range utils.GetNestedSlice(status, "loadBalancer", "ingress") {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if ip := utils.GetNestedString(entry, "ip"); ip != "" {
+			addresses = append(addresses, ip)
+		} else if hostname := utils.GetNestedString(entry, "hostname"); hostname != "" {
+			addresses = append(addresses, hostname)
+		}
+	}
+	return strings.Join(addresses, ",")
+}
+
+// stringMap - map[string]interface{} 를 map[string]string 으로 변환
+func stringMap(values map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[key] = fmt.Sprint(value)
+	}
+	return result
+}
+
+// intValue - JSON 숫자 값을 int로 변환 (없으면 0)
+func intValue(value interface{}) int {
+	return intValueOr(value, 0)
+}
+
+// intValueOr - JSON 숫자 값을 int로 변환 (없으면 기본값)
+func intValueOr(value interface{}, fallback int) int {
+	switch v := value.(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	case int64:
+		return int(v)
+	}
+	return fallback
+}
+
+// formatAge - 생성 시간으로부터 경과 시간 (kubectl AGE 컬럼 형식)
+func formatAge(timestamp string) string {
+	created, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return ""
+	}
+
+	elapsed := time.Since(created)
+	switch {
+	case elapsed < time.Minute:
+		return fmt.Sprintf("%ds", int(elapsed.Seconds()))
+	case elapsed < time.Hour:
+		return fmt.Sprintf("%dm", int(elapsed.Minutes()))
+	case elapsed < 24*time.Hour:
+		return fmt.Sprintf("%dh%dm", int(elapsed.Hours()), int(elapsed.Minutes())%60)
+	default:
+		days := int(elapsed.Hours()) / 24
+		return fmt.Sprintf("%dd%dh", days, int(elapsed.Hours())%24)
+	}
+}
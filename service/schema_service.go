@@ -0,0 +1,594 @@
+package service
+
+import (
+	"embed"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 번들된 쿠버네티스 스키마 (definitions.yaml: 스키마 정의, versions.yaml: 버전별 제공 API)
+//
+//go:embed schemas/*.yaml
+var bundledSchemas embed.FS
+
+// partialSchemaNotice - 번들 스키마로 검증했을 때 결과에 붙이는 안내
+const partialSchemaNotice = "번들 스키마로 검증한 부분 검증입니다. 정의된 필드의 오타와 타입은 오류로 보고하지만 affinity, securityContext 등 세부 구조를 정의하지 않은 필드와 버전별 필드 차이는 검사하지 않으며 서버 검증을 대신하지 않습니다"
+
+// SchemaValidationFailedError - 스키마 검증 오류로 적용을 진행할 수 없을 때의 에러
+type SchemaValidationFailedError struct {
+	Result *model.SchemaValidationResult
+}
+
+func (e *SchemaValidationFailedError) Error() string {
+	var messages []string
+	for _, item := range e.Result.Errors {
+		messages = append(messages, fmt.Sprintf("[%d] %s/%s %s: %s", item.DocumentIndex, item.Kind, item.Name, item.FieldPath, item.Message))
+	}
+	return fmt.Sprintf("스키마 검증 실패 (쿠버네티스 %s, %d건): %s", e.Result.KubernetesVersion, len(e.Result.Errors), strings.Join(messages, "; "))
+}
+
+// schemaKind - 버전별로 제공되는 apiVersion/kind 항목
+type schemaKind struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Definition string `yaml:"definition"`
+	Since      string `yaml:"since"`
+	RemovedIn  string `yaml:"removedIn"`
+}
+
+// schemaCatalog - versions.yaml 구조
+type schemaCatalog struct {
+	SupportedVersions []string     `yaml:"supportedVersions"`
+	DefaultVersion    string       `yaml:"defaultVersion"`
+	Kinds             []schemaKind `yaml:"kinds"`
+}
+
+// SchemaService - 오프라인 쿠버네티스 스키마 검증 서비스
+type SchemaService struct {
+	definitions map[string]interface{}
+	catalog     schemaCatalog
+	crdDir      string
+}
+
+// NewSchemaService - 스키마 서비스 생성자
+func NewSchemaService() *SchemaService {
+	ss := &SchemaService{
+		definitions: map[string]interface{}{},
+		crdDir:      os.Getenv("CRD_SCHEMA_DIR"), // CRD 정의 YAML을 모아둔 디렉토리 (선택사항)
+	}
+
+	if err := ss.loadBundledSchemas(); err != nil {
+		log.Printf("⚠️  번들 스키마 로드 실패: %v", err)
+	}
+
+	// 환경변수 KUBERNETES_VERSION으로 기본 검증 버전 변경 가능
+	if version := os.Getenv("KUBERNETES_VERSION"); version != "" {
+		ss.catalog.DefaultVersion = normalizeKubernetesVersion(version)
+	}
+
+	return ss
+}
+
+// loadBundledSchemas - 내장 스키마 파일 로드
+func (ss *SchemaService) loadBundledSchemas() error {
+	content, err := bundledSchemas.ReadFile("schemas/definitions.yaml")
+	if err != nil {
+		return err
+	}
+	documents, err := utils.ParseYamlDocuments(string(content))
+	if err != nil || len(documents) == 0 {
+		return fmt.Errorf("definitions.yaml 파싱 실패: %v", err)
+	}
+	ss.definitions = utils.GetNestedMap(documents[0], "definitions")
+
+	content, err = bundledSchemas.ReadFile("schemas/versions.yaml")
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(content, &ss.catalog); err != nil {
+		return fmt.Errorf("versions.yaml 파싱 실패: %v", err)
+	}
+	return nil
+}
+
+// SupportedVersions - 검증 가능한 쿠버네티스 버전 목록
+func (ss *SchemaService) SupportedVersions() []string {
+	return ss.catalog.SupportedVersions
+}
+
+// Validate - YAML의 모든 도큐먼트를 지정한 서버 버전의 스키마로 검증
+func (ss *SchemaService) Validate(yamlContent, kubernetesVersion, crdContent string) (*model.SchemaValidationResult, error) {
+	version := normalizeKubernetesVersion(kubernetesVersion)
+	if version == "" {
+		version = ss.catalog.DefaultVersion
+	}
+	if !ss.isSupportedVersion(version) {
+		return nil, fmt.Errorf("지원하지 않는 쿠버네티스 버전입니다: %s (지원: %s)", kubernetesVersion, strings.Join(ss.catalog.SupportedVersions, ", "))
+	}
+
+	log.Printf("📐 스키마 검증 시작 (쿠버네티스 %s)", version)
+
+	documents, err := utils.ParseYamlDocuments(yamlContent)
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 YAML 형식: %v", err)
+	}
+
+	crdSchemas, err := ss.loadCRDSchemas(crdContent, documents)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.SchemaValidationResult{
+		KubernetesVersion: version,
+		DocumentCount:     len(documents),
+		Errors:            []model.SchemaValidationError{},
+		Warnings:          []model.SchemaValidationError{},
+		CheckedTime:       time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	for index, document := range documents {
+		validator := &schemaValidator{
+			definitions: ss.definitions,
+			result:      result,
+			index:       index,
+			apiVersion:  utils.GetNestedString(document, "apiVersion"),
+			kind:        utils.GetNestedString(document, "kind"),
+			name:        utils.GetNestedString(document, "metadata", "name"),
+		}
+		ss.validateDocument(validator, document, version, crdSchemas)
+	}
+
+	if result.Partial {
+		result.Notice = partialSchemaNotice
+	}
+	result.IsValid = len(result.Errors) == 0
+	log.Printf("✅ 스키마 검증 완료 (오류: %d건, 경고: %d건)", len(result.Errors), len(result.Warnings))
+	return result, nil
+}
+
+// validateDocument - 도큐먼트의 apiVersion/kind에 맞는 스키마를 찾아 검증
+func (ss *SchemaService) validateDocument(validator *schemaValidator, document map[string]interface{}, version string, crdSchemas map[string]map[string]interface{}) {
+	if validator.apiVersion == "" {
+		validator.report("apiVersion", "apiVersion 필드가 필요합니다")
+	}
+	if validator.kind == "" {
+		validator.report("kind", "kind 필드가 필요합니다")
+	}
+	if validator.apiVersion == "" || validator.kind == "" {
+		return
+	}
+
+	servedElsewhere := false
+	for _, item := range ss.catalog.Kinds {
+		if item.APIVersion != validator.apiVersion || item.Kind != validator.kind {
+			continue
+		}
+		if !kindServedIn(item, version) {
+			servedElsewhere = true
+			continue
+		}
+		validator.result.Partial = true
+		validator.validate(document, map[string]interface{}{"$ref": item.Definition}, "")
+		return
+	}
+
+	if servedElsewhere {
+		validator.report("apiVersion", fmt.Sprintf("%s %s 는 쿠버네티스 %s 에서 제공되지 않습니다", validator.apiVersion, validator.kind, version))
+		return
+	}
+
+	if schema, ok := crdSchemas[validator.apiVersion+"/"+validator.kind]; ok {
+		validator.validate(document, schema, "")
+		return
+	}
+
+	// CRD 정의 자체는 서버에서 검증되므로 경고하지 않음
+	if validator.kind == "CustomResourceDefinition" {
+		return
+	}
+
+	validator.warn("", "스키마를 찾을 수 없어 검증하지 않았습니다 (CRD라면 crdContent 또는 CRD_SCHEMA_DIR로 정의를 제공하세요)")
+}
+
+// loadCRDSchemas - CRD 디렉토리, 요청의 crdContent, 검증 대상 YAML에 포함된 CRD에서 스키마 수집
+func (ss *SchemaService) loadCRDSchemas(crdContent string, documents []map[string]interface{}) (map[string]map[string]interface{}, error) {
+	schemas := make(map[string]map[string]interface{})
+
+	if ss.crdDir != "" {
+		files, err := filepath.Glob(filepath.Join(ss.crdDir, "*.y*ml"))
+		if err != nil {
+			return nil, fmt.Errorf("CRD 디렉토리 조회 실패: %v", err)
+		}
+		for _, file := range files {
+			content, err := utils.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("CRD 파일 읽기 실패 (%s): %v", file, err)
+			}
+			crdDocuments, err := utils.ParseYamlDocuments(content)
+			if err != nil {
+				return nil, fmt.Errorf("CRD 파일 파싱 실패 (%s): %v", file, err)
+			}
+			collectCRDSchemas(crdDocuments, schemas)
+		}
+	}
+
+	if strings.TrimSpace(crdContent) != "" {
+		crdDocuments, err := utils.ParseYamlDocuments(crdContent)
+		if err != nil {
+			return nil, fmt.Errorf("CRD 내용 파싱 실패: %v", err)
+		}
+		collectCRDSchemas(crdDocuments, schemas)
+	}
+
+	collectCRDSchemas(documents, schemas)
+	return schemas, nil
+}
+
+// collectCRDSchemas - CustomResourceDefinition 도큐먼트에서 버전별 openAPIV3Schema 추출
+func collectCRDSchemas(documents []map[string]interface{}, schemas map[string]map[string]interface{}) {
+	for _, document := range documents {
+		if utils.GetNestedString(document, "kind") != "CustomResourceDefinition" {
+			continue
+		}
+		group := utils.GetNestedString(document, "spec", "group")
+		kind := utils.GetNestedString(document, "spec", "names", "kind")
+		if group == "" || kind == "" {
+			continue
+		}
+		for _, item := range utils.GetNestedSlice(document, "spec", "versions") {
+			version, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			schema := utils.GetNestedMap(version, "schema", "openAPIV3Schema")
+			if schema == nil {
+				continue
+			}
+			schemas[group+"/"+utils.GetNestedString(version, "name")+"/"+kind] = schema
+		}
+	}
+}
+
+// isSupportedVersion - 번들된 버전인지 확인
+func (ss *SchemaService) isSupportedVersion(version string) bool {
+	for _, supported := range ss.catalog.SupportedVersions {
+		if supported == version {
+			return true
+		}
+	}
+	return false
+}
+
+// kindServedIn - 해당 서버 버전에서 apiVersion/kind가 제공되는지 확인
+func kindServedIn(item schemaKind, version string) bool {
+	if item.Since != "" && compareKubernetesVersions(version, item.Since) < 0 {
+		return false
+	}
+	if item.RemovedIn != "" && compareKubernetesVersions(version, item.RemovedIn) >= 0 {
+		return false
+	}
+	return true
+}
+
+// normalizeKubernetesVersion - "v1.29.3" 같은 값을 "1.29"로 정규화
+func normalizeKubernetesVersion(version string) string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	parts := strings.Split(version, ".")
+	if len(parts) < 2 {
+		return version
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// compareKubernetesVersions - "major.minor" 버전 비교 (a<b: -1, a==b: 0, a>b: 1)
+func compareKubernetesVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < 2; i++ {
+		var aValue, bValue int
+		if i < len(aParts) {
+			aValue, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bValue, _ = strconv.Atoi(bParts[i])
+		}
+		if aValue != bValue {
+			if aValue < bValue {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// schemaValidator - 단일 도큐먼트 검증 상태
+type schemaValidator struct {
+	definitions map[string]interface{}
+	result      *model.SchemaValidationResult
+	index       int
+	apiVersion  string
+	kind        string
+	name        string
+}
+
+// report - 검증 오류 추가
+func (sv *schemaValidator) report(fieldPath, message string) {
+	sv.result.Errors = append(sv.result.Errors, sv.item(fieldPath, message))
+}
+
+// warn - 검증 경고 추가
+func (sv *schemaValidator) warn(fieldPath, message string) {
+	sv.result.Warnings = append(sv.result.Warnings, sv.item(fieldPath, message))
+}
+
+// item - 현재 도큐먼트의 검증 결과 항목
+func (sv *schemaValidator) item(fieldPath, message string) model.SchemaValidationError {
+	return model.SchemaValidationError{
+		DocumentIndex: sv.index,
+		APIVersion:    sv.apiVersion,
+		Kind:          sv.kind,
+		Name:          sv.name,
+		FieldPath:     fieldPath,
+		Message:       message,
+	}
+}
+
+// resolve - $ref를 따라가 실제 스키마 반환
+func (sv *schemaValidator) resolve(schema map[string]interface{}) map[string]interface{} {
+	for i := 0; i < 16; i++ {
+		ref, ok := schema["$ref"].(string)
+		if !ok {
+			return schema
+		}
+		definition, ok := sv.definitions[strings.TrimPrefix(ref, "#/definitions/")].(map[string]interface{})
+		if !ok {
+			return map[string]interface{}{}
+		}
+		schema = definition
+	}
+	return schema
+}
+
+// validate - 값이 스키마를 만족하는지 재귀적으로 검사 (null 값은 생략된 것으로 간주)
+func (sv *schemaValidator) validate(value interface{}, schema map[string]interface{}, path string) {
+	schema = sv.resolve(schema)
+	if value == nil {
+		return
+	}
+
+	if intOrString, _ := schema["x-kubernetes-int-or-string"].(bool); intOrString {
+		if _, ok := value.(string); !ok && !isInteger(value) {
+			sv.report(path, fmt.Sprintf("정수 또는 문자열이어야 합니다 (현재: %s)", describeValue(value)))
+		}
+		return
+	}
+	if numberOrString, _ := schema["x-number-or-string"].(bool); numberOrString {
+		if _, ok := value.(string); !ok {
+			if _, ok := toFloat(value); !ok {
+				sv.report(path, fmt.Sprintf("숫자 또는 문자열이어야 합니다 (현재: %s)", describeValue(value)))
+			}
+		}
+		return
+	}
+
+	expected, _ := schema["type"].(string)
+	if expected != "" && !matchesType(value, expected) {
+		sv.report(path, fmt.Sprintf("%s 타입이어야 합니다 (현재: %s)", expected, describeValue(value)))
+		return
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok && len(enum) > 0 {
+		allowed := make([]string, len(enum))
+		found := false
+		for i, item := range enum {
+			allowed[i] = fmt.Sprint(item)
+			if allowed[i] == fmt.Sprint(value) {
+				found = true
+			}
+		}
+		if !found {
+			sv.report(path, fmt.Sprintf("허용되지 않는 값입니다: %v (허용: %s)", value, strings.Join(allowed, ", ")))
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(v) {
+				sv.report(path, fmt.Sprintf("형식이 올바르지 않습니다: %q (패턴: %s)", v, pattern))
+			}
+		}
+	case map[string]interface{}:
+		sv.validateObject(v, schema, path)
+	case []interface{}:
+		items, _ := schema["items"].(map[string]interface{})
+		if items != nil {
+			for i, item := range v {
+				sv.validate(item, items, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	default:
+		if number, ok := toFloat(value); ok {
+			if minimum, ok := toFloat(schema["minimum"]); ok && number < minimum {
+				sv.report(path, fmt.Sprintf("%v 이상이어야 합니다 (현재: %v)", schema["minimum"], value))
+			}
+			if maximum, ok := toFloat(schema["maximum"]); ok && number > maximum {
+				sv.report(path, fmt.Sprintf("%v 이하여야 합니다 (현재: %v)", schema["maximum"], value))
+			}
+		}
+	}
+}
+
+// validateObject - 필수 필드, 알려진 필드, 추가 필드 검사
+func (sv *schemaValidator) validateObject(object map[string]interface{}, schema map[string]interface{}, path string) {
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, item := range required {
+			field := fmt.Sprint(item)
+			if object[field] == nil {
+				sv.report(joinFieldPath(path, field), "필수 필드가 없습니다")
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	preserveUnknown, _ := schema["x-kubernetes-preserve-unknown-fields"].(bool)
+	for _, key := range keys {
+		fieldPath := joinFieldPath(path, key)
+		if propertySchema, ok := properties[key].(map[string]interface{}); ok {
+			sv.validate(object[key], propertySchema, fieldPath)
+			continue
+		}
+
+		switch additional := schema["additionalProperties"].(type) {
+		case map[string]interface{}:
+			sv.validate(object[key], additional, fieldPath)
+		case bool:
+			if !additional && !preserveUnknown {
+				message := "알 수 없는 필드입니다"
+				if suggestion := closestField(key, properties); suggestion != "" {
+					message += fmt.Sprintf(" ('%s'을(를) 의도했나요?)", suggestion)
+				}
+				sv.report(fieldPath, message)
+			}
+		}
+	}
+}
+
+// joinFieldPath - 필드 경로 연결 (spec + replicas → spec.replicas)
+func joinFieldPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// closestField - 오타로 보이는 필드에 대해 가장 가까운 알려진 필드 이름 반환
+func closestField(field string, properties map[string]interface{}) string {
+	best := ""
+	bestDistance := 3 // 편집 거리 2 이하만 제안
+	for name := range properties {
+		distance := editDistance(strings.ToLower(field), strings.ToLower(name))
+		if distance < bestDistance || (distance == bestDistance && best != "" && name < best) {
+			best = name
+			bestDistance = distance
+		}
+	}
+	return best
+}
+
+// editDistance - 레벤슈타인 편집 거리
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// matchesType - JSON 스키마 타입 일치 여부
+func matchesType(value interface{}, expected string) bool {
+	switch expected {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "integer":
+		return isInteger(value)
+	case "number":
+		_, ok := toFloat(value)
+		return ok
+	}
+	return true
+}
+
+// isInteger - 정수 값 여부 (yaml.v2는 int/int64/uint64, 소수점 없는 float도 허용)
+func isInteger(value interface{}) bool {
+	switch v := value.(type) {
+	case int, int64, uint64:
+		return true
+	case float64:
+		return v == math.Trunc(v)
+	}
+	return false
+}
+
+// toFloat - 숫자 값을 float64로 변환
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// describeValue - 오류 메시지용 값 설명
+func describeValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return fmt.Sprintf("string %q", v)
+	case bool:
+		return fmt.Sprintf("boolean %t", v)
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	}
+	if isInteger(value) {
+		return fmt.Sprintf("integer %v", value)
+	}
+	return fmt.Sprintf("%T %v", value, value)
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+// schemaTestDeployment - resources와 replicas 값을 바꿔 끼우는 Deployment
+func schemaTestDeployment(replicas, cpu, memory string) string {
+	return `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: ` + replicas + `
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      containers:
+      - name: web
+        image: nginx:1.25
+        resources:
+          requests:
+            cpu: ` + cpu + `
+            memory: ` + memory + `
+`
+}
+
+func TestSchemaValidateQuantities(t *testing.T) {
+	schemaService := NewSchemaService()
+
+	for _, test := range []struct {
+		name     string
+		yaml     string
+		errorAt  string // 비어 있으면 오류 없음
+		errorMsg string
+	}{
+		{name: "소수 CPU", yaml: schemaTestDeployment("2", "0.5", "128Mi")},
+		{name: "정수 CPU", yaml: schemaTestDeployment("2", "2", "1Gi")},
+		{name: "문자열 CPU", yaml: schemaTestDeployment("2", `"500m"`, "512Mi")},
+		{name: "불리언 CPU", yaml: schemaTestDeployment("2", "true", "128Mi"), errorAt: "spec.template.spec.containers[0].resources.requests.cpu", errorMsg: "숫자 또는 문자열"},
+		{name: "소수 replicas", yaml: schemaTestDeployment("1.5", "1", "128Mi"), errorAt: "spec.replicas", errorMsg: "integer"},
+		{name: "음수 replicas", yaml: schemaTestDeployment("-1", "1", "128Mi"), errorAt: "spec.replicas", errorMsg: "이상"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := schemaService.Validate(test.yaml, "", "")
+			if err != nil {
+				t.Fatalf("검증 실패: %v", err)
+			}
+			if test.errorAt == "" {
+				if !result.IsValid {
+					t.Fatalf("유효해야 합니다: %+v", result.Errors)
+				}
+				return
+			}
+			if result.IsValid || len(result.Errors) != 1 {
+				t.Fatalf("오류 1건이 있어야 합니다: %+v", result.Errors)
+			}
+			if found := result.Errors[0]; found.FieldPath != test.errorAt || !strings.Contains(found.Message, test.errorMsg) {
+				t.Fatalf("오류 = %s: %s, 기대값 %s (%s)", found.FieldPath, found.Message, test.errorAt, test.errorMsg)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateIntOrString(t *testing.T) {
+	schemaService := NewSchemaService()
+	service := func(targetPort string) string {
+		return "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\nspec:\n  ports:\n  - port: 80\n    targetPort: " + targetPort + "\n"
+	}
+
+	for targetPort, valid := range map[string]bool{"8080": true, "http": true, "80.5": false} {
+		result, err := schemaService.Validate(service(targetPort), "", "")
+		if err != nil {
+			t.Fatalf("검증 실패: %v", err)
+		}
+		if result.IsValid != valid {
+			t.Fatalf("targetPort %s 유효 여부 = %t, 기대값 %t (%+v)", targetPort, result.IsValid, valid, result.Errors)
+		}
+	}
+}
+
+func TestSchemaValidateUnknownFieldAndVersion(t *testing.T) {
+	schemaService := NewSchemaService()
+
+	result, err := schemaService.Validate("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\ndatta:\n  a: b\n", "", "")
+	if err != nil {
+		t.Fatalf("검증 실패: %v", err)
+	}
+	// 번들 스키마로 검증하면 부분 검증임을 안내하되 정의된 객체의 알 수 없는 필드는 오류
+	if result.IsValid || !result.Partial || result.Notice == "" || !strings.Contains(result.Errors[0].Message, "data") {
+		t.Fatalf("알 수 없는 필드는 비슷한 필드 안내와 함께 오류여야 합니다: %+v", result)
+	}
+
+	// 요청 예시: replicas 오타와 문자열 값
+	result, err = schemaService.Validate(strings.Replace(schemaTestDeployment("2", "1", "128Mi"), "replicas: 2", `replica: "three"`, 1), "", "")
+	if err != nil {
+		t.Fatalf("검증 실패: %v", err)
+	}
+	if result.IsValid || len(result.Errors) != 1 || result.Errors[0].FieldPath != "spec.replica" || !strings.Contains(result.Errors[0].Message, "'replicas'") {
+		t.Fatalf("spec.replica 오타는 오류여야 합니다: %+v", result)
+	}
+
+	// 사용자가 제공한 CRD 스키마의 알 수 없는 필드는 오류
+	crd := `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+spec:
+  group: example.com
+  names: {kind: Widget}
+  versions:
+  - name: v1
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          apiVersion: {type: string}
+          kind: {type: string}
+          metadata: {type: object}
+          size: {type: integer}
+        additionalProperties: false
+`
+	result, err = schemaService.Validate("apiVersion: example.com/v1\nkind: Widget\nmetadata:\n  name: w\nsise: 3\n", "", crd)
+	if err != nil {
+		t.Fatalf("검증 실패: %v", err)
+	}
+	if result.IsValid || result.Partial || !strings.Contains(result.Errors[0].Message, "size") {
+		t.Fatalf("CRD 스키마의 알 수 없는 필드는 오류여야 합니다: %+v", result)
+	}
+
+	result, err = schemaService.Validate("apiVersion: policy/v1beta1\nkind: PodDisruptionBudget\nmetadata:\n  name: web\n", "1.25", "")
+	if err != nil {
+		t.Fatalf("검증 실패: %v", err)
+	}
+	if result.IsValid {
+		t.Fatalf("1.25에서 제거된 API는 오류여야 합니다")
+	}
+
+	if _, err := schemaService.Validate("apiVersion: v1\nkind: ConfigMap\n", "1.10", ""); err == nil {
+		t.Fatalf("지원하지 않는 버전은 오류여야 합니다")
+	}
+}
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+)
+
+// Secret/ConfigMap 저장 데이터(base64 인코딩 후) 전체 최대 크기
+// kubectl apply는 매니페스트 전체를 last-applied-configuration 어노테이션에 저장하고
+// 어노테이션 합계는 256KiB로 제한되므로 나머지 필드 몫을 남겨 둠
+const maxAppliedDataSize = 240 << 10 // 240KiB
+
+// 기본 Docker 레지스트리 주소 (kubectl create secret docker-registry와 동일)
+const defaultDockerServer = "https://index.docker.io/v1/"
+
+// 리소스 이름 (DNS subdomain) 및 Secret/ConfigMap data 키 규칙
+var (
+	dnsSubdomainPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	dataKeyPattern      = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+)
+
+// secretManifest - Secret 매니페스트 (필드 순서 고정)
+type secretManifest struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name        string            `yaml:"name"`
+		Namespace   string            `yaml:"namespace"`
+		Labels      map[string]string `yaml:"labels,omitempty"`
+		Annotations map[string]string `yaml:"annotations,omitempty"`
+	} `yaml:"metadata"`
+	Type string            `yaml:"type"`
+	Data map[string]string `yaml:"data"`
+}
+
+// BuiltSecret - 인코딩된 Secret 매니페스트와 값이 빠진 요약
+type BuiltSecret struct {
+	YamlContent string             // 적용할 매니페스트 (값 포함, 응답/로그 금지)
+	Summary     model.SecretResult // 응답용 요약 (값 미포함)
+}
+
+// SecretService - Secret 생성 서비스 (인코딩은 서버에서 수행, 값은 응답/로그에 남기지 않음)
+type SecretService struct {
+	kubeService *KubeService
+}
+
+// NewSecretService - Secret 서비스 생성자
+func NewSecretService() *SecretService {
+	return NewSecretServiceWithKubeService(NewKubeService())
+}
+
+// NewSecretServiceWithKubeService - 지정한 KubeService를 사용하는 생성자 (테스트에서 가짜 실행기 주입)
+func NewSecretServiceWithKubeService(kubeService *KubeService) *SecretService {
+	return &SecretService{kubeService: kubeService}
+}
+
+// BuildSecret - 요청을 검증하고 Secret 매니페스트 생성
+func (ss *SecretService) BuildSecret(request model.SecretRequest) (*BuiltSecret, error) {
+	if len(request.Name) > 253 || !dnsSubdomainPattern.MatchString(request.Name) {
+		return nil, fmt.Errorf("잘못된 Secret 이름입니다: %q (소문자, 숫자, '-', '.'만 사용)", request.Name)
+	}
+	if request.Namespace == "" {
+		request.Namespace = "default"
+	}
+	if err := kubernetes.ValidateNamespaceName(request.Namespace); err != nil {
+		return nil, err
+	}
+	if request.Type == "" {
+		request.Type = model.SecretTypeGeneric
+	}
+
+	manifest := secretManifest{APIVersion: "v1", Kind: "Secret"}
+	manifest.Metadata.Name = request.Name
+	manifest.Metadata.Namespace = request.Namespace
+	manifest.Metadata.Labels = request.Labels
+	manifest.Metadata.Annotations = request.Annotations
+
+	var data map[string][]byte
+	var certificate *model.SecretCertificateInfo
+	var err error
+	switch request.Type {
+	case model.SecretTypeGeneric:
+		manifest.Type = "Opaque"
+		data, err = buildGenericSecretData(request)
+	case model.SecretTypeDockerRegistry:
+		manifest.Type = "kubernetes.io/dockerconfigjson"
+		data, err = buildDockerSecretData(request)
+	case model.SecretTypeTLS:
+		manifest.Type = "kubernetes.io/tls"
+		data, certificate, err = buildTLSSecretData(request)
+	default:
+		return nil, fmt.Errorf("type은 generic, docker-registry, tls 중 하나여야 합니다: %s", request.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	manifest.Data = make(map[string]string, len(data))
+	summary := model.SecretResult{
+		Name:        request.Name,
+		Namespace:   request.Namespace,
+		Type:        manifest.Type,
+		Sizes:       make(map[string]int, len(data)),
+		Certificate: certificate,
+	}
+	for key, value := range data {
+		manifest.Data[key] = base64.StdEncoding.EncodeToString(value)
+		summary.Keys = append(summary.Keys, key)
+		summary.Sizes[key] = len(value)
+		total += len(manifest.Data[key])
+	}
+	sort.Strings(summary.Keys)
+	if total > maxAppliedDataSize {
+		return nil, fmt.Errorf("Secret 데이터가 너무 큽니다 (인코딩 후 %d바이트, 최대 %d바이트)", total, maxAppliedDataSize)
+	}
+	if certificate != nil && certificate.Expired {
+		summary.Warnings = append(summary.Warnings, "인증서가 만료되었습니다 ("+certificate.NotAfter+")")
+	}
+
+	content, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("Secret 직렬화 실패: %v", err)
+	}
+
+	// 응답용 미리보기는 값 대신 크기만 표시
+	redacted := manifest
+	redacted.Data = make(map[string]string, len(data))
+	for key, value := range data {
+		redacted.Data[key] = fmt.Sprintf("<%d bytes>", len(value))
+	}
+	redactedContent, err := yaml.Marshal(redacted)
+	if err != nil {
+		return nil, fmt.Errorf("Secret 직렬화 실패: %v", err)
+	}
+	summary.RedactedYaml = string(redactedContent)
+
+	return &BuiltSecret{YamlContent: string(content), Summary: summary}, nil
+}
+
+// ApplySecret - 생성한 Secret 적용 (정책 검사 포함)
+func (ss *SecretService) ApplySecret(ctx context.Context, request model.SecretRequest, built *BuiltSecret) (*model.SecretResult, error) {
+	log.Printf("🔐 Secret 적용 시작: %s/%s (type: %s, 키 %d개, DryRun: %t)", built.Summary.Namespace, built.Summary.Name, built.Summary.Type, len(built.Summary.Keys), request.DryRun)
+
+	applyResult, err := ss.kubeService.ApplyYaml(ctx, model.ApplyYamlRequest{
+		YamlContent: built.YamlContent,
+		Namespace:   built.Summary.Namespace,
+		DryRun:      request.DryRun,
+		Context:     request.Context,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := built.Summary
+	result.ApplyResult = applyResult
+	log.Printf("✅ Secret 적용 완료: %s/%s", result.Namespace, result.Name)
+	return &result, nil
+}
+
+// buildGenericSecretData - 리터럴과 파일로 data 구성 (키 중복 불가)
+func buildGenericSecretData(request model.SecretRequest) (map[string][]byte, error) {
+	data := make(map[string][]byte)
+	add := func(key string, value []byte) error {
+		if err := validateDataKey(key); err != nil {
+			return err
+		}
+		if _, exists := data[key]; exists {
+			return fmt.Errorf("중복된 키입니다: %s", key)
+		}
+		data[key] = value
+		return nil
+	}
+
+	for key, value := range request.Literals {
+		if err := add(key, []byte(value)); err != nil {
+			return nil, err
+		}
+	}
+	for key, value := range request.Files {
+		if err := add(key, value); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("generic Secret에는 literals 또는 files가 필요합니다")
+	}
+	return data, nil
+}
+
+// buildDockerSecretData - .dockerconfigjson 구성
+func buildDockerSecretData(request model.SecretRequest) (map[string][]byte, error) {
+	if request.DockerUsername == "" || request.DockerPassword == "" {
+		return nil, fmt.Errorf("docker-registry Secret에는 dockerUsername과 dockerPassword가 필요합니다")
+	}
+	server := request.DockerServer
+	if server == "" {
+		server = defaultDockerServer
+	}
+
+	entry := map[string]string{
+		"username": request.DockerUsername,
+		"password": request.DockerPassword,
+		"auth":     base64.StdEncoding.EncodeToString([]byte(request.DockerUsername + ":" + request.DockerPassword)),
+	}
+	if request.DockerEmail != "" {
+		entry["email"] = request.DockerEmail
+	}
+
+	config, err := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{server: entry},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("docker 설정 직렬화 실패: %v", err)
+	}
+	return map[string][]byte{".dockerconfigjson": config}, nil
+}
+
+// buildTLSSecretData - 인증서/키 쌍 검증 후 tls.crt, tls.key 구성
+func buildTLSSecretData(request model.SecretRequest) (map[string][]byte, *model.SecretCertificateInfo, error) {
+	if strings.TrimSpace(request.TLSCert) == "" || strings.TrimSpace(request.TLSKey) == "" {
+		return nil, nil, fmt.Errorf("tls Secret에는 tlsCert와 tlsKey PEM이 필요합니다")
+	}
+
+	// 오류 메시지에 PEM 내용이 포함되지 않도록 원인만 전달
+	pair, err := tls.X509KeyPair([]byte(request.TLSCert), []byte(request.TLSKey))
+	if err != nil {
+		return nil, nil, fmt.Errorf("인증서와 개인 키가 올바른 PEM 쌍이 아닙니다: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("인증서 파싱 실패: %v", err)
+	}
+
+	info := &model.SecretCertificateInfo{
+		Subject:   leaf.Subject.String(),
+		Issuer:    leaf.Issuer.String(),
+		DNSNames:  leaf.DNSNames,
+		NotBefore: leaf.NotBefore.Local().Format("2006-01-02 15:04:05"),
+		NotAfter:  leaf.NotAfter.Local().Format("2006-01-02 15:04:05"),
+		Expired:   time.Now().After(leaf.NotAfter),
+	}
+	if info.DNSNames == nil {
+		info.DNSNames = []string{}
+	}
+
+	return map[string][]byte{
+		"tls.crt": []byte(request.TLSCert),
+		"tls.key": []byte(request.TLSKey),
+	}, info, nil
+}
+
+// validateDataKey - Secret/ConfigMap data 키 규칙 검사
+func validateDataKey(key string) error {
+	if len(key) > 253 || !dataKeyPattern.MatchString(key) || key == "." || key == ".." {
+		return fmt.Errorf("잘못된 키 이름입니다: %q (영문, 숫자, '-', '_', '.'만 사용)", key)
+	}
+	return nil
+}
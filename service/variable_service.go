@@ -0,0 +1,181 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// ${VAR}, ${VAR:-기본값}, $${VAR}(이스케이프) 패턴
+var variablePattern = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// 변수 이름 규칙
+var variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// UndefinedVariablesError - 정의되지 않은 변수가 있을 때의 에러
+type UndefinedVariablesError struct {
+	Names []string
+}
+
+func (e *UndefinedVariablesError) Error() string {
+	return fmt.Sprintf("정의되지 않은 변수가 있습니다: %s", strings.Join(e.Names, ", "))
+}
+
+// VariableService - 매니페스트 변수 치환 및 환경별 변수 세트 관리 서비스
+type VariableService struct {
+	configPath string
+}
+
+// NewVariableService - 변수 서비스 생성자
+func NewVariableService() *VariableService {
+	// 환경변수 ENVIRONMENTS_CONFIG 우선, 없으면 $HOME/.kube/mykubeapp-environments.yaml
+	configPath := os.Getenv("ENVIRONMENTS_CONFIG")
+	if configPath == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			homeDir = "."
+		}
+		configPath = filepath.Join(homeDir, ".kube", "mykubeapp-environments.yaml")
+	}
+
+	return &VariableService{
+		configPath: configPath,
+	}
+}
+
+// GetEnvironments - 저장된 환경 변수 세트 조회
+func (vs *VariableService) GetEnvironments() (*model.EnvironmentConfig, error) {
+	config := &model.EnvironmentConfig{Environments: map[string]map[string]string{}}
+	if !utils.FileExists(vs.configPath) {
+		return config, nil
+	}
+
+	content, err := utils.ReadFile(vs.configPath)
+	if err != nil {
+		return nil, fmt.Errorf("환경 설정 파일 읽기 실패: %v", err)
+	}
+	if err := yaml.Unmarshal([]byte(content), config); err != nil {
+		return nil, fmt.Errorf("환경 설정 파일 파싱 실패: %v", err)
+	}
+	if config.Environments == nil {
+		config.Environments = map[string]map[string]string{}
+	}
+	return config, nil
+}
+
+// SaveEnvironments - 환경 변수 세트 저장
+func (vs *VariableService) SaveEnvironments(config model.EnvironmentConfig) error {
+	for envName, variables := range config.Environments {
+		for name := range variables {
+			if !variableNamePattern.MatchString(name) {
+				return fmt.Errorf("잘못된 변수 이름입니다 (%s): %s", envName, name)
+			}
+		}
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("환경 설정 직렬화 실패: %v", err)
+	}
+
+	if utils.FileExists(vs.configPath) {
+		if err := utils.BackupFile(vs.configPath); err != nil {
+			log.Printf("⚠️  환경 설정 파일 백업 실패 (계속 진행): %v", err)
+		}
+	}
+
+	if err := utils.WriteFile(vs.configPath, string(data)); err != nil {
+		return fmt.Errorf("환경 설정 파일 저장 실패: %v", err)
+	}
+
+	log.Printf("✅ 환경 설정 저장 완료: %s (%d개 환경)", vs.configPath, len(config.Environments))
+	return nil
+}
+
+// ResolveVariables - 환경 변수 세트와 직접 지정한 변수를 병합 (직접 지정한 값 우선)
+func (vs *VariableService) ResolveVariables(environment string, variables map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string)
+
+	if environment != "" {
+		config, err := vs.GetEnvironments()
+		if err != nil {
+			return nil, err
+		}
+		envVariables, ok := config.Environments[environment]
+		if !ok {
+			return nil, fmt.Errorf("존재하지 않는 환경입니다: %s", environment)
+		}
+		for name, value := range envVariables {
+			resolved[name] = value
+		}
+	}
+
+	for name, value := range variables {
+		resolved[name] = value
+	}
+	return resolved, nil
+}
+
+// Substitute - ${VAR} 자리표시자 치환. 정의되지 않은 변수가 있으면 UndefinedVariablesError 반환
+func (vs *VariableService) Substitute(content string, variables map[string]string) (string, map[string]string, error) {
+	used := make(map[string]string)
+	undefined := make(map[string]bool)
+
+	result := variablePattern.ReplaceAllStringFunc(content, func(match string) string {
+		// $${VAR}는 치환하지 않고 ${VAR}로 남김
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+
+		groups := variablePattern.FindStringSubmatch(match)
+		name := groups[1]
+		if value, ok := variables[name]; ok {
+			used[name] = value
+			return value
+		}
+		if groups[2] != "" {
+			used[name] = groups[3]
+			return groups[3]
+		}
+
+		undefined[name] = true
+		return match
+	})
+
+	if len(undefined) > 0 {
+		var names []string
+		for name := range undefined {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", nil, &UndefinedVariablesError{Names: names}
+	}
+
+	return result, used, nil
+}
+
+// Apply - 환경/변수를 병합하여 치환 (환경과 변수가 모두 없으면 원본 그대로 반환)
+// 치환은 환경이나 변수를 지정한 요청에만 적용하므로 command/args의 셸 변수(${HOME} 등)는 지정하지 않으면 그대로 적용되고,
+// 지정한 경우 남은 ${VAR}는 UndefinedVariablesError (셸 변수는 $${HOME}으로 이스케이프)
+func (vs *VariableService) Apply(content, environment string, variables map[string]string) (string, map[string]string, error) {
+	if environment == "" && len(variables) == 0 {
+		return content, nil, nil
+	}
+
+	resolved, err := vs.ResolveVariables(environment, variables)
+	if err != nil {
+		return "", nil, err
+	}
+
+	log.Printf("🔤 변수 치환 (환경: %s, 변수 수: %d)", environment, len(resolved))
+	return vs.Substitute(content, resolved)
+}
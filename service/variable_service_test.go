@@ -0,0 +1,33 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestVariableApplyWithoutVariablesKeepsContent(t *testing.T) {
+	variableService := NewVariableService()
+
+	// 환경/변수를 지정하지 않으면 셸 스크립트의 ${...}를 그대로 둠
+	original := "command: [sh, -c, 'echo ${HOME} ${PORT:-8080} $${KEEP}']\n"
+	content, used, err := variableService.Apply(original, "", nil)
+	if err != nil || content != original || len(used) != 0 {
+		t.Fatalf("치환 결과 = %q, %v, %v", content, used, err)
+	}
+}
+
+func TestVariableApplyReportsUndefinedVariables(t *testing.T) {
+	variableService := NewVariableService()
+
+	_, _, err := variableService.Apply("image: nginx:${TAG}\nreplicas: ${REPLICAS:-2}\nname: ${APP}\n", "", map[string]string{"APP": "web"})
+	var undefinedErr *UndefinedVariablesError
+	if !errors.As(err, &undefinedErr) || !reflect.DeepEqual(undefinedErr.Names, []string{"TAG"}) {
+		t.Fatalf("정의되지 않은 변수 에러여야 합니다: %v", err)
+	}
+
+	content, _, err := variableService.Apply("command: echo $${HOME}\nreplicas: ${REPLICAS:-2}\nname: ${APP}\n", "", map[string]string{"APP": "web"})
+	if err != nil || content != "command: echo ${HOME}\nreplicas: 2\nname: web\n" {
+		t.Fatalf("치환 결과 = %q, %v", content, err)
+	}
+}
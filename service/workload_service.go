@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// 작업 로그 최대 보관 개수 (오래된 항목부터 삭제)
+const maxWorkloadOperationLog = 500
+
+// kubectl rollout history 출력의 리비전 줄: "2         kubectl set image ..."
+var rolloutRevisionPattern = regexp.MustCompile(`^(\d+)\s+(.*)$`)
+
+// WorkloadService - Deployment/StatefulSet/DaemonSet 운영 작업 서비스
+type WorkloadService struct {
+	executor   utils.CommandExecutor
+	mutex      sync.Mutex
+	operations []model.WorkloadOperation
+}
+
+// NewWorkloadService - 워크로드 서비스 생성자
+func NewWorkloadService() *WorkloadService {
+	return NewWorkloadServiceWithExecutor(utils.NewSystemExecutor())
+}
+
+// NewWorkloadServiceWithExecutor - 지정한 명령 실행기를 사용하는 워크로드 서비스 생성자
+func NewWorkloadServiceWithExecutor(executor utils.CommandExecutor) *WorkloadService {
+	return &WorkloadService{executor: executor}
+}
+
+// Scale - 레플리카 수 변경 (Deployment, StatefulSet)
+func (ws *WorkloadService) Scale(ctx context.Context, kind, name string, req model.WorkloadOperationRequest) (*model.WorkloadOperation, error) {
+	operation, err := ws.newOperation(model.WorkloadOperationScale, kind, name, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if operation.Kind == "DaemonSet" {
+		return nil, fmt.Errorf("DaemonSet은 레플리카 수를 변경할 수 없습니다")
+	}
+	if req.Replicas == nil || *req.Replicas < 0 {
+		return nil, fmt.Errorf("replicas는 0 이상이어야 합니다")
+	}
+
+	target := workloadTarget(operation)
+	if current, err := ws.executor.Execute(ctx, "kubectl", "get", target, "-n", operation.Namespace, "-o", "jsonpath={.spec.replicas}"); err == nil {
+		if value, err := strconv.Atoi(strings.TrimSpace(current)); err == nil {
+			operation.PreviousReplicas = &value
+		}
+	}
+	operation.Replicas = req.Replicas
+
+	return ws.run(ctx, operation, "scale", target, "-n", operation.Namespace, fmt.Sprintf("--replicas=%d", *req.Replicas))
+}
+
+// Restart - 롤링 재시작 (kubectl rollout restart)
+func (ws *WorkloadService) Restart(ctx context.Context, kind, name string, req model.WorkloadOperationRequest) (*model.WorkloadOperation, error) {
+	operation, err := ws.newOperation(model.WorkloadOperationRestart, kind, name, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return ws.run(ctx, operation, "rollout", "restart", workloadTarget(operation), "-n", operation.Namespace)
+}
+
+// Pause - 롤아웃 일시 중지 (Deployment 전용)
+func (ws *WorkloadService) Pause(ctx context.Context, kind, name string, req model.WorkloadOperationRequest) (*model.WorkloadOperation, error) {
+	return ws.pauseOrResume(ctx, model.WorkloadOperationPause, kind, name, req)
+}
+
+// Resume - 롤아웃 재개 (Deployment 전용)
+func (ws *WorkloadService) Resume(ctx context.Context, kind, name string, req model.WorkloadOperationRequest) (*model.WorkloadOperation, error) {
+	return ws.pauseOrResume(ctx, model.WorkloadOperationResume, kind, name, req)
+}
+
+func (ws *WorkloadService) pauseOrResume(ctx context.Context, operationType, kind, name string, req model.WorkloadOperationRequest) (*model.WorkloadOperation, error) {
+	operation, err := ws.newOperation(operationType, kind, name, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if operation.Kind != "Deployment" {
+		return nil, fmt.Errorf("롤아웃 일시 중지/재개는 Deployment만 지원합니다")
+	}
+	return ws.run(ctx, operation, "rollout", operationType, workloadTarget(operation), "-n", operation.Namespace)
+}
+
+// Undo - 지정한 리비전으로 롤백 (revision이 0이면 직전 리비전)
+func (ws *WorkloadService) Undo(ctx context.Context, kind, name string, req model.WorkloadOperationRequest) (*model.WorkloadOperation, error) {
+	operation, err := ws.newOperation(model.WorkloadOperationUndo, kind, name, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if req.Revision < 0 {
+		return nil, fmt.Errorf("revision은 0 이상이어야 합니다")
+	}
+	operation.Revision = req.Revision
+
+	args := []string{"rollout", "undo", workloadTarget(operation), "-n", operation.Namespace}
+	if req.Revision > 0 {
+		args = append(args, fmt.Sprintf("--to-revision=%d", req.Revision))
+	}
+	return ws.run(ctx, operation, args...)
+}
+
+// History - 롤아웃 리비전 목록
+func (ws *WorkloadService) History(ctx context.Context, kind, name, namespace string) (*model.RolloutHistory, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.QueryCommandTimeout)
+	defer cancel()
+
+	operation, err := ws.newOperation("", kind, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := ws.executor.Execute(ctx, "kubectl", "rollout", "history", workloadTarget(operation), "-n", operation.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("롤아웃 이력 조회 실패: %w", err)
+	}
+
+	return &model.RolloutHistory{
+		Kind:      operation.Kind,
+		Name:      operation.Name,
+		Namespace: operation.Namespace,
+		Revisions: ParseRolloutHistory(output),
+	}, nil
+}
+
+// ListOperations - 작업 로그 조회 (최신 순, 빈 조건은 전체)
+func (ws *WorkloadService) ListOperations(namespace, kind, name string, limit int) []model.WorkloadOperation {
+	if kind != "" {
+		if normalized, err := normalizeWorkloadKind(kind); err == nil {
+			kind = normalized
+		}
+	}
+
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	operations := []model.WorkloadOperation{}
+	for i := len(ws.operations) - 1; i >= 0; i-- {
+		operation := ws.operations[i]
+		if (namespace != "" && operation.Namespace != namespace) ||
+			(kind != "" && operation.Kind != kind) ||
+			(name != "" && operation.Name != name) {
+			continue
+		}
+		operations = append(operations, operation)
+		if limit > 0 && len(operations) >= limit {
+			break
+		}
+	}
+	return operations
+}
+
+// ParseRolloutHistory - kubectl rollout history 출력 파싱 (가장 큰 리비전이 현재 리비전)
+func ParseRolloutHistory(output string) []model.RolloutRevision {
+	revisions := []model.RolloutRevision{}
+	for _, line := range strings.Split(output, "\n") {
+		match := rolloutRevisionPattern.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+		revision, _ := strconv.Atoi(match[1])
+		changeCause := strings.TrimSpace(match[2])
+		if changeCause == "<none>" {
+			changeCause = ""
+		}
+		revisions = append(revisions, model.RolloutRevision{Revision: revision, ChangeCause: changeCause})
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	if len(revisions) > 0 {
+		revisions[len(revisions)-1].Current = true
+	}
+	return revisions
+}
+
+// newOperation - 입력 검증 후 작업 항목 생성
+func (ws *WorkloadService) newOperation(operationType, kind, name, namespace string) (*model.WorkloadOperation, error) {
+	normalizedKind, err := normalizeWorkloadKind(kind)
+	if err != nil {
+		return nil, err
+	}
+	if !objectNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("잘못된 워크로드 이름입니다: %s", name)
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	if err := kubernetes.ValidateNamespaceName(namespace); err != nil {
+		return nil, err
+	}
+
+	return &model.WorkloadOperation{
+		ID:        fmt.Sprintf("op_%d", time.Now().UnixNano()),
+		Operation: operationType,
+		Kind:      normalizedKind,
+		Name:      name,
+		Namespace: namespace,
+	}, nil
+}
+
+// run - kubectl 실행 후 결과를 작업 로그에 기록 (실패한 작업도 기록)
+func (ws *WorkloadService) run(ctx context.Context, operation *model.WorkloadOperation, args ...string) (*model.WorkloadOperation, error) {
+	ctx, cancel := context.WithTimeout(ctx, utils.ApplyCommandTimeout)
+	defer cancel()
+
+	log.Printf("⚙️  워크로드 작업: %s %s/%s (네임스페이스: %s)", operation.Operation, operation.Kind, operation.Name, operation.Namespace)
+
+	output, err := ws.executor.Execute(ctx, "kubectl", args...)
+	operation.Output = strings.TrimSpace(output)
+	operation.Success = err == nil
+	operation.ExecutedTime = time.Now().Format("2006-01-02 15:04:05")
+	if err != nil {
+		operation.Error = err.Error()
+	}
+
+	ws.mutex.Lock()
+	ws.operations = append(ws.operations, *operation)
+	if len(ws.operations) > maxWorkloadOperationLog {
+		ws.operations = ws.operations[len(ws.operations)-maxWorkloadOperationLog:]
+	}
+	ws.mutex.Unlock()
+
+	if err != nil {
+		log.Printf("❌ 워크로드 작업 실패: %s %s/%s - %v", operation.Operation, operation.Kind, operation.Name, err)
+		return operation, fmt.Errorf("%s 작업 실패: %w", operation.Operation, err)
+	}
+	log.Printf("✅ 워크로드 작업 완료: %s %s/%s", operation.Operation, operation.Kind, operation.Name)
+	return operation, nil
+}
+
+// normalizeWorkloadKind - 워크로드 종류 정규화 (축약/복수형 허용)
+func normalizeWorkloadKind(kind string) (string, error) {
+	switch strings.ToLower(kind) {
+	case "deployment", "deployments", "deploy":
+		return "Deployment", nil
+	case "statefulset", "statefulsets", "sts":
+		return "StatefulSet", nil
+	case "daemonset", "daemonsets", "ds":
+		return "DaemonSet", nil
+	default:
+		return "", fmt.Errorf("지원하지 않는 워크로드 종류입니다: %s (deployment, statefulset, daemonset)", kind)
+	}
+}
+
+// workloadTarget - kubectl 대상 문자열 (예: deployment/web)
+func workloadTarget(operation *model.WorkloadOperation) string {
+	return strings.ToLower(operation.Kind) + "/" + operation.Name
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"mykubeapp/model"
+	"mykubeapp/utils/utilstest"
+)
+
+func TestWorkloadScaleUsesInjectedExecutor(t *testing.T) {
+	executor := utilstest.NewFakeExecutor().
+		On("kubectl get deployment/web -n demo", "2").
+		On("kubectl scale deployment/web -n demo --replicas=5", "deployment.apps/web scaled\n")
+	workloadService := NewWorkloadServiceWithExecutor(executor)
+
+	replicas := 5
+	operation, err := workloadService.Scale(context.Background(), "deployment", "web", model.WorkloadOperationRequest{Namespace: "demo", Replicas: &replicas})
+	if err != nil || !operation.Success {
+		t.Fatalf("scale 실패: %+v, %v", operation, err)
+	}
+	if operation.PreviousReplicas == nil || *operation.PreviousReplicas != 2 || operation.Output != "deployment.apps/web scaled" {
+		t.Fatalf("작업 결과 = %+v", operation)
+	}
+	if calls := executor.Calls(); len(calls) != 2 {
+		t.Fatalf("명령 호출 수 = %d, 기대값 2", len(calls))
+	}
+}
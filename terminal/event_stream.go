@@ -0,0 +1,392 @@
+package terminal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// kubeEvent - kubectl get events -o json 의 필요한 필드
+type kubeEvent struct {
+	Metadata struct {
+		Namespace string `json:"namespace"`
+	} `json:"metadata"`
+	Type           string `json:"type"`
+	Reason         string `json:"reason"`
+	Message        string `json:"message"`
+	Count          int    `json:"count"`
+	FirstTimestamp string `json:"firstTimestamp"`
+	LastTimestamp  string `json:"lastTimestamp"`
+	EventTime      string `json:"eventTime"`
+	InvolvedObject struct {
+		Kind string `json:"kind"`
+		Name string `json:"name"`
+	} `json:"involvedObject"`
+	Source struct {
+		Component string `json:"component"`
+	} `json:"source"`
+	ReportingComponent string `json:"reportingComponent"`
+	Series             *struct {
+		Count            int    `json:"count"`
+		LastObservedTime string `json:"lastObservedTime"`
+	} `json:"series"`
+}
+
+// ClusterEventsHandler - 이벤트 스트리밍 핸들러 (WebSocket 업그레이드 요청이면 WebSocket, 아니면 SSE)
+func ClusterEventsHandler(w http.ResponseWriter, r *http.Request) {
+	options, err := ParseEventStreamOptions(r)
+	if err != nil {
+		http.Error(w, "잘못된 이벤트 요청입니다: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	serveStream(w, r, func(ctx context.Context, sink streamSink) {
+		streamEvents(ctx, options, sink)
+	})
+}
+
+// ParseEventStreamOptions - 쿼리 파라미터를 이벤트 옵션으로 변환
+// object는 여러 번 지정 가능하며 ApplyYamlResult.Resources 형식(deployment.apps/web)을 그대로 받음
+func ParseEventStreamOptions(r *http.Request) (model.EventStreamOptions, error) {
+	query := r.URL.Query()
+	options := model.EventStreamOptions{
+		Namespace:     query.Get("namespace"),
+		AllNamespaces: query.Get("allNamespaces") == "true",
+		Related:       query.Get("related") == "true",
+		Type:          query.Get("type"),
+		WatchOnly:     query.Get("watchOnly") == "true",
+	}
+
+	if options.Namespace == "" {
+		options.Namespace = "default"
+	}
+	if err := kubernetes.ValidateNamespaceName(options.Namespace); err != nil {
+		return options, err
+	}
+
+	switch strings.ToLower(options.Type) {
+	case "":
+	case "normal":
+		options.Type = "Normal"
+	case "warning":
+		options.Type = "Warning"
+	default:
+		return options, fmt.Errorf("type은 Normal 또는 Warning이어야 합니다: %s", options.Type)
+	}
+
+	for _, value := range query["reason"] {
+		for _, reason := range strings.Split(value, ",") {
+			if reason = strings.TrimSpace(reason); reason != "" {
+				options.Reasons = append(options.Reasons, reason)
+			}
+		}
+	}
+
+	for _, value := range query["object"] {
+		object, err := ParseEventObjectRef(value)
+		if err != nil {
+			return options, err
+		}
+		options.Objects = append(options.Objects, object)
+	}
+
+	return options, nil
+}
+
+// ParseEventObjectRef - kind[.group]/name 형식을 대상 오브젝트로 변환
+func ParseEventObjectRef(value string) (model.EventObjectRef, error) {
+	parts := strings.Split(strings.TrimSpace(value), "/")
+	if len(parts) != 2 || parts[0] == "" || !logObjectNamePattern.MatchString(parts[1]) {
+		return model.EventObjectRef{}, fmt.Errorf("object는 kind/name 형식이어야 합니다: %s", value)
+	}
+
+	kind := strings.ToLower(parts[0])
+	if index := strings.IndexByte(kind, '.'); index > 0 {
+		kind = kind[:index]
+	}
+	return model.EventObjectRef{Kind: kind, Name: parts[1]}, nil
+}
+
+// BuildEventArgs - kubectl get events --watch 인자 구성
+// 필드 셀렉터로 서버에서 거를 수 있는 조건(type, 단일 reason, 단일 오브젝트)은 셀렉터로 전달
+func BuildEventArgs(options model.EventStreamOptions) []string {
+	args := []string{"get", "events", "--watch", "-o", "json"}
+	if options.AllNamespaces {
+		args = append(args, "--all-namespaces")
+	} else {
+		args = append(args, "-n", options.Namespace)
+	}
+	if options.WatchOnly {
+		args = append(args, "--watch-only")
+	}
+
+	if selector := eventFieldSelector(options); selector != "" {
+		args = append(args, "--field-selector", selector)
+	}
+	return args
+}
+
+// BuildEventWatchPath - API 백엔드에서 사용할 이벤트 watch REST 경로 (BuildEventArgs와 같은 필드 셀렉터)
+func BuildEventWatchPath(options model.EventStreamOptions, resourceVersion string) string {
+	path := "/api/v1/events"
+	if !options.AllNamespaces {
+		path = "/api/v1/namespaces/" + url.PathEscape(options.Namespace) + "/events"
+	}
+
+	params := url.Values{}
+	if selector := eventFieldSelector(options); selector != "" {
+		params.Set("fieldSelector", selector)
+	}
+	if resourceVersion != "" {
+		params.Set("resourceVersion", resourceVersion)
+	}
+	if encoded := params.Encode(); encoded != "" {
+		path += "?" + encoded
+	}
+	return path
+}
+
+// eventFieldSelector - 서버에서 거를 수 있는 조건(type, 단일 reason, 단일 오브젝트)의 필드 셀렉터
+func eventFieldSelector(options model.EventStreamOptions) string {
+	var selectors []string
+	if options.Type != "" {
+		selectors = append(selectors, "type="+options.Type)
+	}
+	if len(options.Reasons) == 1 {
+		selectors = append(selectors, "reason="+options.Reasons[0])
+	}
+	if len(options.Objects) == 1 && !options.Related {
+		selectors = append(selectors, "involvedObject.name="+options.Objects[0].Name)
+	}
+	return strings.Join(selectors, ",")
+}
+
+// MatchEvent - 옵션의 필터 조건과 이벤트 비교
+func MatchEvent(options model.EventStreamOptions, event model.ClusterEventMessage) bool {
+	if options.Type != "" && event.EventType != options.Type {
+		return false
+	}
+	if len(options.Reasons) > 0 {
+		matched := false
+		for _, reason := range options.Reasons {
+			if strings.EqualFold(reason, event.Reason) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(options.Objects) == 0 {
+		return true
+	}
+
+	for _, object := range options.Objects {
+		if strings.EqualFold(object.Kind, event.ObjectKind) && object.Name == event.ObjectName {
+			return true
+		}
+		// Deployment → ReplicaSet → Pod 처럼 이름을 이어받는 하위 오브젝트
+		if options.Related && strings.HasPrefix(event.ObjectName, object.Name+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// toEventMessage - kubectl 이벤트 오브젝트를 스트림 메시지로 변환
+func toEventMessage(event kubeEvent) model.ClusterEventMessage {
+	message := model.ClusterEventMessage{
+		Type:           model.EventMessageTypeEvent,
+		EventType:      event.Type,
+		Reason:         event.Reason,
+		Note:           event.Message,
+		Namespace:      event.Metadata.Namespace,
+		ObjectKind:     event.InvolvedObject.Kind,
+		ObjectName:     event.InvolvedObject.Name,
+		Source:         event.Source.Component,
+		Count:          event.Count,
+		FirstTimestamp: event.FirstTimestamp,
+		LastTimestamp:  event.LastTimestamp,
+	}
+
+	// events.k8s.io 방식으로 기록된 이벤트는 series/eventTime/reportingComponent 사용
+	if message.Source == "" {
+		message.Source = event.ReportingComponent
+	}
+	if message.LastTimestamp == "" {
+		message.LastTimestamp = event.EventTime
+	}
+	if event.Series != nil {
+		message.Count = event.Series.Count
+		if event.Series.LastObservedTime != "" {
+			message.LastTimestamp = event.Series.LastObservedTime
+		}
+	}
+	return message
+}
+
+// streamEvents - kubectl get events --watch 실행 후 조건에 맞는 이벤트를 sink로 전달
+func streamEvents(parent context.Context, options model.EventStreamOptions, sink streamSink) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	send := func(message model.ClusterEventMessage) error {
+		return sink.Send(message.Type, message)
+	}
+
+	if kubernetes.BackendFromEnv() == kubernetes.BackendAPI {
+		streamEventsFromAPI(ctx, options, send)
+		return
+	}
+
+	args := BuildEventArgs(options)
+	log.Printf("📣 이벤트 스트리밍 시작: kubectl %s", strings.Join(args, " "))
+
+	cmd := utils.CommandContext(ctx, "kubectl", args...)
+	cmd.Env = os.Environ()
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		send(model.ClusterEventMessage{Type: model.EventMessageTypeError, Message: err.Error()})
+		return
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		send(model.ClusterEventMessage{Type: model.EventMessageTypeError, Message: err.Error()})
+		return
+	}
+	if err := cmd.Start(); err != nil {
+		send(model.ClusterEventMessage{Type: model.EventMessageTypeError, Message: "kubectl 실행 실패: " + err.Error()})
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	// --watch -o json 출력은 이벤트 JSON 오브젝트가 연속으로 이어지는 스트림
+	go func() {
+		defer wg.Done()
+		decoder := json.NewDecoder(stdout)
+		for {
+			var event kubeEvent
+			if err := decoder.Decode(&event); err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					send(model.ClusterEventMessage{Type: model.EventMessageTypeError, Message: "이벤트 파싱 실패: " + err.Error()})
+				}
+				cancel()
+				io.Copy(io.Discard, stdout)
+				return
+			}
+
+			message := toEventMessage(event)
+			if !MatchEvent(options, message) {
+				continue
+			}
+			if err := send(message); err != nil {
+				cancel()
+				io.Copy(io.Discard, stdout)
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		forEachLine(stderr, cancel, func(line string) error {
+			return send(model.ClusterEventMessage{Type: model.EventMessageTypeError, Message: line})
+		})
+	}()
+
+	wg.Wait()
+	err = cmd.Wait()
+
+	if parent.Err() != nil {
+		log.Printf("🔌 클라이언트 연결 종료로 이벤트 스트리밍 중단 (kubectl 종료)")
+		return
+	}
+
+	end := model.ClusterEventMessage{Type: model.EventMessageTypeEnd, Message: "이벤트 스트림 종료"}
+	if err != nil && ctx.Err() == nil {
+		end.Message = "kubectl get events 종료: " + err.Error()
+	}
+	send(end)
+	log.Printf("✅ 이벤트 스트리밍 종료")
+}
+
+// streamEventsFromAPI - API 서버 watch로 이벤트 스트리밍 (KUBE_BACKEND=api)
+// watchOnly이면 목록의 resourceVersion부터 구독하여 기존 이벤트(초기 ADDED)를 생략
+func streamEventsFromAPI(ctx context.Context, options model.EventStreamOptions, send func(model.ClusterEventMessage) error) {
+	backend := kubernetes.NewAPIBackend()
+
+	resourceVersion := ""
+	if options.WatchOnly {
+		listPath := BuildEventWatchPath(options, "")
+		if strings.Contains(listPath, "?") {
+			listPath += "&limit=1"
+		} else {
+			listPath += "?limit=1"
+		}
+		output, err := backend.GetRaw(ctx, "", listPath)
+		if err != nil {
+			send(model.ClusterEventMessage{Type: model.EventMessageTypeError, Message: "이벤트 목록 조회 실패: " + err.Error()})
+			return
+		}
+		var list struct {
+			Metadata struct {
+				ResourceVersion string `json:"resourceVersion"`
+			} `json:"metadata"`
+		}
+		json.Unmarshal(output, &list)
+		resourceVersion = list.Metadata.ResourceVersion
+	}
+
+	path := BuildEventWatchPath(options, resourceVersion)
+	log.Printf("📣 이벤트 스트리밍 시작: watch %s", path)
+
+	err := backend.Watch(ctx, "", path, func(event kubernetes.WatchEvent) error {
+		if event.Type == "ERROR" {
+			var status struct {
+				Message string `json:"message"`
+			}
+			json.Unmarshal(event.Object, &status)
+			return send(model.ClusterEventMessage{Type: model.EventMessageTypeError, Message: status.Message})
+		}
+		if event.Type != "ADDED" && event.Type != "MODIFIED" {
+			return nil
+		}
+
+		var kubeEvent kubeEvent
+		if err := json.Unmarshal(event.Object, &kubeEvent); err != nil {
+			return send(model.ClusterEventMessage{Type: model.EventMessageTypeError, Message: "이벤트 파싱 실패: " + err.Error()})
+		}
+		message := toEventMessage(kubeEvent)
+		if !MatchEvent(options, message) {
+			return nil
+		}
+		return send(message)
+	})
+
+	if ctx.Err() != nil {
+		log.Printf("🔌 클라이언트 연결 종료로 이벤트 스트리밍 중단")
+		return
+	}
+
+	end := model.ClusterEventMessage{Type: model.EventMessageTypeEnd, Message: "이벤트 스트림 종료"}
+	if err != nil {
+		end.Message = "이벤트 watch 종료: " + err.Error()
+	}
+	send(end)
+	log.Printf("✅ 이벤트 스트리밍 종료")
+}
@@ -0,0 +1,318 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// exec 세션 유휴 타임아웃 기본값과 최대값
+const (
+	defaultExecIdleTimeout = 10 * time.Minute
+	maxExecIdleTimeout     = time.Hour
+)
+
+// 명령을 지정하지 않으면 bash가 있으면 bash, 없으면 sh 실행
+var defaultExecCommand = []string{"sh", "-c", "command -v bash >/dev/null 2>&1 && exec bash || exec sh"}
+
+// ExecSession - 파드 컨테이너에 대한 대화형 exec 세션
+type ExecSession struct {
+	ID          string
+	Conn        *websocket.Conn
+	Options     model.ExecOptions
+	pty         *os.File // TTY 모드의 pty master
+	idleTimeout time.Duration
+	writeMutex  sync.Mutex
+	idleTimer   *time.Timer
+	idleExpired bool
+	stateMutex  sync.Mutex
+}
+
+// PodExecHandler - 파드 exec WebSocket 핸들러 (kubectl exec -it)
+func PodExecHandler(w http.ResponseWriter, r *http.Request) {
+	options, idleTimeout, err := ParseExecOptions(r)
+	if err != nil {
+		http.Error(w, "잘못된 exec 요청입니다: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("❌ WebSocket 업그레이드 실패: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	session := &ExecSession{
+		ID:          generateSessionID(),
+		Conn:        conn,
+		Options:     options,
+		idleTimeout: idleTimeout,
+	}
+
+	log.Printf("🖥️ exec 세션 시작: %s (%s/%s, tty=%v)", session.ID, options.Namespace, options.Pod, options.TTY)
+	session.Run(r.Context())
+	log.Printf("🔌 exec 세션 종료: %s", session.ID)
+}
+
+// ParseExecOptions - 쿼리 파라미터를 exec 옵션으로 변환
+func ParseExecOptions(r *http.Request) (model.ExecOptions, time.Duration, error) {
+	query := r.URL.Query()
+	options := model.ExecOptions{
+		Namespace:   query.Get("namespace"),
+		Pod:         query.Get("pod"),
+		Container:   query.Get("container"),
+		Command:     query["command"],
+		TTY:         query.Get("tty") != "false",
+		IdleTimeout: query.Get("idleTimeout"),
+	}
+
+	if options.Namespace == "" {
+		options.Namespace = "default"
+	}
+	if err := kubernetes.ValidateNamespaceName(options.Namespace); err != nil {
+		return options, 0, err
+	}
+	if !logObjectNamePattern.MatchString(options.Pod) {
+		return options, 0, fmt.Errorf("잘못된 파드 이름입니다: %s", options.Pod)
+	}
+	if options.Container != "" && !logObjectNamePattern.MatchString(options.Container) {
+		return options, 0, fmt.Errorf("잘못된 컨테이너 이름입니다: %s", options.Container)
+	}
+	if len(options.Command) == 0 {
+		options.Command = defaultExecCommand
+	}
+
+	for name, target := range map[string]*uint16{"cols": &options.Cols, "rows": &options.Rows} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return options, 0, fmt.Errorf("%s 값이 올바르지 않습니다: %s", name, value)
+		}
+		*target = uint16(parsed)
+	}
+
+	idleTimeout := defaultExecIdleTimeout
+	if options.IdleTimeout != "" {
+		parsed, err := time.ParseDuration(options.IdleTimeout)
+		if err != nil || parsed <= 0 {
+			return options, 0, fmt.Errorf("idleTimeout 값이 올바르지 않습니다: %s", options.IdleTimeout)
+		}
+		if parsed > maxExecIdleTimeout {
+			parsed = maxExecIdleTimeout
+		}
+		idleTimeout = parsed
+	}
+
+	return options, idleTimeout, nil
+}
+
+// BuildExecArgs - kubectl exec 인자 구성
+func BuildExecArgs(options model.ExecOptions) []string {
+	args := []string{"exec", "-i", "-n", options.Namespace, options.Pod}
+	if options.TTY {
+		args = append(args, "-t")
+	}
+	if options.Container != "" {
+		args = append(args, "-c", options.Container)
+	}
+	args = append(args, "--")
+	return append(args, options.Command...)
+}
+
+// Run - kubectl exec 실행 후 입출력 중계, 종료 시 종료 코드 전송
+func (s *ExecSession) Run(parent context.Context) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	// 세션 종료 시 kubectl뿐 아니라 credential 플러그인 등 자식 프로세스까지 프로세스 그룹 단위로 종료
+	cmd := utils.CommandContext(ctx, "kubectl", BuildExecArgs(s.Options)...)
+
+	var stdin io.WriteCloser
+	var outputs sync.WaitGroup
+
+	if s.Options.TTY {
+		master, err := startWithPTY(cmd, s.Options.Cols, s.Options.Rows)
+		if err != nil {
+			s.sendError(fmt.Sprintf("exec 시작 실패: %v", err))
+			return
+		}
+		defer master.Close()
+		s.pty = master
+		stdin = master
+
+		outputs.Add(1)
+		go s.pumpOutput(master, model.ExecMessageStdout, &outputs)
+	} else {
+		stdinPipe, err := cmd.StdinPipe()
+		if err != nil {
+			s.sendError(fmt.Sprintf("stdin 파이프 생성 실패: %v", err))
+			return
+		}
+		stdoutPipe, err := cmd.StdoutPipe()
+		if err != nil {
+			s.sendError(fmt.Sprintf("stdout 파이프 생성 실패: %v", err))
+			return
+		}
+		stderrPipe, err := cmd.StderrPipe()
+		if err != nil {
+			s.sendError(fmt.Sprintf("stderr 파이프 생성 실패: %v", err))
+			return
+		}
+		if err := cmd.Start(); err != nil {
+			s.sendError(fmt.Sprintf("exec 시작 실패: %v", err))
+			return
+		}
+		stdin = stdinPipe
+
+		outputs.Add(2)
+		go s.pumpOutput(stdoutPipe, model.ExecMessageStdout, &outputs)
+		go s.pumpOutput(stderrPipe, model.ExecMessageStderr, &outputs)
+	}
+
+	s.startIdleTimer(cancel)
+	defer s.stopIdleTimer()
+
+	// 클라이언트 입력 처리 (연결이 끊기면 프로세스 종료)
+	go func() {
+		defer cancel()
+		s.readInput(stdin)
+	}()
+
+	outputs.Wait()
+	waitErr := cmd.Wait()
+
+	exitCode := 0
+	reason := ""
+	var exitErr *exec.ExitError
+	switch {
+	case s.isIdleExpired():
+		exitCode = -1
+		reason = fmt.Sprintf("%s 동안 입출력이 없어 세션을 종료했습니다", s.idleTimeout)
+	case errors.As(waitErr, &exitErr):
+		exitCode = exitErr.ExitCode()
+	case waitErr != nil:
+		exitCode = -1
+		reason = waitErr.Error()
+	}
+
+	s.send(model.ExecMessage{Type: model.ExecMessageExit, ExitCode: &exitCode, Reason: reason})
+	s.writeMutex.Lock()
+	s.Conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	s.writeMutex.Unlock()
+}
+
+// readInput - 클라이언트 메시지를 stdin 입력 또는 터미널 크기 변경으로 처리
+func (s *ExecSession) readInput(stdin io.WriteCloser) {
+	defer stdin.Close()
+
+	for {
+		var message model.ExecMessage
+		if err := s.Conn.ReadJSON(&message); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				log.Printf("❌ exec WebSocket 읽기 오류: %v", err)
+			}
+			return
+		}
+		s.touch()
+
+		switch message.Type {
+		case model.ExecMessageStdin:
+			if _, err := io.WriteString(stdin, message.Data); err != nil {
+				return
+			}
+		case model.ExecMessageResize:
+			if s.pty == nil || message.Cols == 0 || message.Rows == 0 {
+				continue
+			}
+			if err := resizePTY(s.pty, message.Cols, message.Rows); err != nil {
+				log.Printf("⚠️ 터미널 크기 변경 실패: %v", err)
+			}
+		default:
+			s.sendError(fmt.Sprintf("알 수 없는 메시지 타입입니다: %s", message.Type))
+		}
+	}
+}
+
+// pumpOutput - 프로세스 출력을 WebSocket 메시지로 전달
+func (s *ExecSession) pumpOutput(reader io.Reader, messageType string, done *sync.WaitGroup) {
+	defer done.Done()
+
+	buffer := make([]byte, 32*1024)
+	for {
+		n, err := reader.Read(buffer)
+		if n > 0 {
+			s.touch()
+			s.send(model.ExecMessage{Type: messageType, Data: string(buffer[:n])})
+		}
+		if err != nil {
+			// pty master는 자식 종료 후 EIO를 반환하므로 EOF와 동일하게 처리
+			return
+		}
+	}
+}
+
+// send - 메시지 전송 (동시 쓰기 방지)
+func (s *ExecSession) send(message model.ExecMessage) error {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+	return s.Conn.WriteJSON(message)
+}
+
+func (s *ExecSession) sendError(reason string) {
+	log.Printf("❌ exec 세션 오류 (%s): %s", s.ID, reason)
+	s.send(model.ExecMessage{Type: model.ExecMessageError, Reason: reason})
+}
+
+// startIdleTimer - 유휴 타임아웃이 지나면 프로세스 종료
+func (s *ExecSession) startIdleTimer(cancel context.CancelFunc) {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+	s.idleTimer = time.AfterFunc(s.idleTimeout, func() {
+		s.stateMutex.Lock()
+		s.idleExpired = true
+		s.stateMutex.Unlock()
+		log.Printf("⏰ exec 세션 유휴 타임아웃: %s", s.ID)
+		cancel()
+	})
+}
+
+func (s *ExecSession) stopIdleTimer() {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+	if s.idleTimer != nil {
+		s.idleTimer.Stop()
+	}
+}
+
+// touch - 입출력이 있을 때 유휴 타이머 재설정
+func (s *ExecSession) touch() {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+	if s.idleTimer != nil && !s.idleExpired {
+		s.idleTimer.Reset(s.idleTimeout)
+	}
+}
+
+func (s *ExecSession) isIdleExpired() bool {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+	return s.idleExpired
+}
@@ -0,0 +1,35 @@
+package terminal
+
+import (
+	"context"
+	"net/http"
+
+	"mykubeapp/model"
+)
+
+// JobEventsHandler - 작업 이벤트 스트리밍 (현재 상태 전송 후 작업이 끝날 때까지 진행/로그 이벤트 전달)
+// events는 작업이 종료되면 닫히며, 스트림이 끝나면 unsubscribe로 구독을 해제함
+func JobEventsHandler(w http.ResponseWriter, r *http.Request, job model.Job, events <-chan model.JobEvent, unsubscribe func()) {
+	defer unsubscribe()
+
+	serveStream(w, r, func(ctx context.Context, sink streamSink) {
+		snapshot := model.JobEvent{Type: model.JobEventSnapshot, JobID: job.ID, Job: &job}
+		if err := sink.Send(snapshot.Type, snapshot); err != nil {
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if err := sink.Send(event.Type, event); err != nil {
+					return
+				}
+			}
+		}
+	})
+}
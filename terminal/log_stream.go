@@ -0,0 +1,236 @@
+package terminal
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mykubeapp/kubernetes"
+	"mykubeapp/model"
+	"mykubeapp/utils"
+)
+
+// kubectl logs --prefix 출력 형식: [pod/이름/컨테이너] 내용
+var logPrefixPattern = regexp.MustCompile(`^\[pod/([^/\]]+)/([^\]]+)\] ?(.*)$`)
+
+// 파드/컨테이너 이름 규칙 (kubectl 플래그로 해석되지 않도록 검증)
+var logObjectNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+
+// PodLogsHandler - 파드 로그 스트리밍 핸들러 (WebSocket 업그레이드 요청이면 WebSocket, 아니면 SSE)
+func PodLogsHandler(w http.ResponseWriter, r *http.Request) {
+	options, err := ParsePodLogOptions(r)
+	if err != nil {
+		http.Error(w, "잘못된 로그 요청입니다: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	serveStream(w, r, func(ctx context.Context, sink streamSink) {
+		streamLogs(ctx, options, sink)
+	})
+}
+
+// ParsePodLogOptions - 쿼리 파라미터를 로그 옵션으로 변환
+func ParsePodLogOptions(r *http.Request) (model.PodLogOptions, error) {
+	query := r.URL.Query()
+	options := model.PodLogOptions{
+		Namespace:     query.Get("namespace"),
+		Pod:           query.Get("pod"),
+		Selector:      query.Get("selector"),
+		Container:     query.Get("container"),
+		Follow:        query.Get("follow") == "true",
+		TailLines:     -1,
+		Since:         query.Get("since"),
+		SinceTime:     query.Get("sinceTime"),
+		Previous:      query.Get("previous") == "true",
+		Timestamps:    query.Get("timestamps") == "true",
+		MaxLogStreams: 10,
+	}
+
+	if options.Namespace == "" {
+		options.Namespace = "default"
+	}
+	if err := kubernetes.ValidateNamespaceName(options.Namespace); err != nil {
+		return options, err
+	}
+
+	if (options.Pod == "") == (options.Selector == "") {
+		return options, fmt.Errorf("pod 또는 selector 중 하나를 지정해야 합니다")
+	}
+	if options.Pod != "" && !logObjectNamePattern.MatchString(options.Pod) {
+		return options, fmt.Errorf("잘못된 파드 이름입니다: %s", options.Pod)
+	}
+	if options.Selector != "" && strings.HasPrefix(options.Selector, "-") {
+		return options, fmt.Errorf("잘못된 셀렉터입니다: %s", options.Selector)
+	}
+	if options.Container != "" && !logObjectNamePattern.MatchString(options.Container) {
+		return options, fmt.Errorf("잘못된 컨테이너 이름입니다: %s", options.Container)
+	}
+
+	if tail := query.Get("tail"); tail != "" {
+		value, err := strconv.Atoi(tail)
+		if err != nil || value < 0 {
+			return options, fmt.Errorf("tail 값이 올바르지 않습니다: %s", tail)
+		}
+		options.TailLines = value
+	}
+	if options.Since != "" && options.SinceTime != "" {
+		return options, fmt.Errorf("since와 sinceTime은 함께 사용할 수 없습니다")
+	}
+	if options.Since != "" {
+		if _, err := time.ParseDuration(options.Since); err != nil {
+			return options, fmt.Errorf("since 값이 올바르지 않습니다: %s", options.Since)
+		}
+	}
+	if options.SinceTime != "" {
+		if _, err := time.Parse(time.RFC3339, options.SinceTime); err != nil {
+			return options, fmt.Errorf("sinceTime은 RFC3339 형식이어야 합니다: %s", options.SinceTime)
+		}
+	}
+	if maxStreams := query.Get("maxStreams"); maxStreams != "" {
+		value, err := strconv.Atoi(maxStreams)
+		if err != nil || value <= 0 {
+			return options, fmt.Errorf("maxStreams 값이 올바르지 않습니다: %s", maxStreams)
+		}
+		options.MaxLogStreams = value
+	}
+
+	return options, nil
+}
+
+// BuildLogsArgs - kubectl logs 인자 구성 (항상 --prefix로 파드/컨테이너 구분)
+func BuildLogsArgs(options model.PodLogOptions) []string {
+	args := []string{"logs", "-n", options.Namespace, "--prefix"}
+
+	if options.Pod != "" {
+		args = append(args, options.Pod)
+	} else {
+		args = append(args, "-l", options.Selector, fmt.Sprintf("--max-log-requests=%d", options.MaxLogStreams))
+	}
+
+	if options.Container != "" {
+		args = append(args, "-c", options.Container)
+	} else {
+		args = append(args, "--all-containers=true")
+	}
+	if options.Follow {
+		args = append(args, "-f")
+	}
+	if options.TailLines >= 0 {
+		args = append(args, fmt.Sprintf("--tail=%d", options.TailLines))
+	}
+	if options.Since != "" {
+		args = append(args, "--since="+options.Since)
+	}
+	if options.SinceTime != "" {
+		args = append(args, "--since-time="+options.SinceTime)
+	}
+	if options.Previous {
+		args = append(args, "--previous")
+	}
+	if options.Timestamps {
+		args = append(args, "--timestamps")
+	}
+	return args
+}
+
+// ParseLogLine - --prefix 출력 한 줄을 메시지로 변환
+func ParseLogLine(line string, timestamps bool) model.PodLogMessage {
+	message := model.PodLogMessage{Type: model.LogMessageTypeLog, Line: line}
+	if match := logPrefixPattern.FindStringSubmatch(line); match != nil {
+		message.Pod = match[1]
+		message.Container = match[2]
+		message.Line = match[3]
+	}
+	if timestamps {
+		if index := strings.IndexByte(message.Line, ' '); index > 0 {
+			message.Timestamp = message.Line[:index]
+			message.Line = message.Line[index+1:]
+		}
+	}
+	return message
+}
+
+// streamLogs - kubectl logs 실행 후 출력 줄을 sink로 전달 (ctx 취소 또는 전송 실패 시 프로세스 종료)
+func streamLogs(parent context.Context, options model.PodLogOptions, sink streamSink) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	send := func(message model.PodLogMessage) error {
+		return sink.Send(message.Type, message)
+	}
+
+	args := BuildLogsArgs(options)
+	log.Printf("📜 로그 스트리밍 시작: kubectl %s", strings.Join(args, " "))
+
+	cmd := utils.CommandContext(ctx, "kubectl", args...)
+	cmd.Env = os.Environ()
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		send(model.PodLogMessage{Type: model.LogMessageTypeError, Message: err.Error()})
+		return
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		send(model.PodLogMessage{Type: model.LogMessageTypeError, Message: err.Error()})
+		return
+	}
+	if err := cmd.Start(); err != nil {
+		send(model.PodLogMessage{Type: model.LogMessageTypeError, Message: "kubectl 실행 실패: " + err.Error()})
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		forEachLine(stdout, cancel, func(line string) error {
+			return send(ParseLogLine(line, options.Timestamps))
+		})
+	}()
+
+	go func() {
+		defer wg.Done()
+		forEachLine(stderr, cancel, func(line string) error {
+			return send(model.PodLogMessage{Type: model.LogMessageTypeError, Message: line})
+		})
+	}()
+
+	wg.Wait()
+	err = cmd.Wait()
+
+	if ctx.Err() != nil {
+		log.Printf("🔌 클라이언트 연결 종료로 로그 스트리밍 중단 (kubectl 종료)")
+		return
+	}
+
+	end := model.PodLogMessage{Type: model.LogMessageTypeEnd, Message: "로그 스트림 종료"}
+	if err != nil {
+		end.Message = "kubectl logs 종료: " + err.Error()
+	}
+	send(end)
+	log.Printf("✅ 로그 스트리밍 종료")
+}
+
+// forEachLine - 줄 단위로 읽어 콜백 호출 (긴 줄도 처리, 콜백 실패 시 프로세스 종료 후 파이프 비움)
+func forEachLine(reader io.Reader, stop context.CancelFunc, callback func(line string) error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if err := callback(scanner.Text()); err != nil {
+			stop()
+			io.Copy(io.Discard, reader)
+			return
+		}
+	}
+}
@@ -0,0 +1,75 @@
+//go:build linux
+
+package terminal
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+	"unsafe"
+)
+
+// ptyWinsize - struct winsize (TIOCSWINSZ)
+type ptyWinsize struct {
+	Rows uint16
+	Cols uint16
+	X    uint16
+	Y    uint16
+}
+
+// startWithPTY - 의사 터미널을 할당하고 명령을 그 터미널의 세션 리더로 실행 (master 반환)
+func startWithPTY(cmd *exec.Cmd, cols, rows uint16) (*os.File, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("pty 열기 실패: %v", err)
+	}
+
+	var unlock int32
+	if err := ptyIoctl(master, syscall.TIOCSPTLCK, uintptr(unsafe.Pointer(&unlock))); err != nil {
+		master.Close()
+		return nil, fmt.Errorf("pty 잠금 해제 실패: %v", err)
+	}
+	var number uint32
+	if err := ptyIoctl(master, syscall.TIOCGPTN, uintptr(unsafe.Pointer(&number))); err != nil {
+		master.Close()
+		return nil, fmt.Errorf("pty 번호 조회 실패: %v", err)
+	}
+
+	slave, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", number), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		return nil, fmt.Errorf("pty slave 열기 실패: %v", err)
+	}
+	defer slave.Close()
+
+	if cols > 0 && rows > 0 {
+		resizePTY(master, cols, rows)
+	}
+
+	cmd.Stdin = slave
+	cmd.Stdout = slave
+	cmd.Stderr = slave
+	// 세션 리더는 pid와 같은 프로세스 그룹을 가지므로 utils.CommandContext의 그룹 종료(kill -pid)가 그대로 동작
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true, Ctty: 0}
+
+	if err := cmd.Start(); err != nil {
+		master.Close()
+		return nil, err
+	}
+	return master, nil
+}
+
+// resizePTY - 터미널 크기 변경 (자식 프로세스에 SIGWINCH 전달됨)
+func resizePTY(master *os.File, cols, rows uint16) error {
+	size := ptyWinsize{Rows: rows, Cols: cols}
+	return ptyIoctl(master, syscall.TIOCSWINSZ, uintptr(unsafe.Pointer(&size)))
+}
+
+func ptyIoctl(file *os.File, request, argument uintptr) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, file.Fd(), request, argument)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
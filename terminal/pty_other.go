@@ -0,0 +1,19 @@
+//go:build !linux
+
+package terminal
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// startWithPTY - pty 할당은 리눅스에서만 지원 (tty=false로 사용)
+func startWithPTY(cmd *exec.Cmd, cols, rows uint16) (*os.File, error) {
+	return nil, fmt.Errorf("이 플랫폼에서는 TTY exec를 지원하지 않습니다 (tty=false 사용)")
+}
+
+// resizePTY - 지원하지 않는 플랫폼에서는 무시
+func resizePTY(master *os.File, cols, rows uint16) error {
+	return nil
+}
@@ -0,0 +1,137 @@
+package terminal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// SSE 연결 유지를 위한 주석 전송 주기
+const sseHeartbeatInterval = 30 * time.Second
+
+// streamSink - 스트림 메시지 전송 대상 (WebSocket 또는 SSE)
+type streamSink interface {
+	Send(event string, message interface{}) error
+}
+
+// serveStream - WebSocket 업그레이드 요청이면 WebSocket, 아니면 SSE로 스트림 실행
+// 클라이언트 연결이 끊기면 ctx가 취소되므로 run은 ctx로 하위 프로세스를 종료해야 함
+func serveStream(w http.ResponseWriter, r *http.Request, run func(ctx context.Context, sink streamSink)) {
+	if websocket.IsWebSocketUpgrade(r) {
+		serveWebSocketStream(w, r, run)
+		return
+	}
+	serveSSEStream(w, r, run)
+}
+
+// wsStreamSink - WebSocket 텍스트 프레임으로 JSON 메시지 전송
+type wsStreamSink struct {
+	conn  *websocket.Conn
+	mutex sync.Mutex
+}
+
+func (s *wsStreamSink) Send(event string, message interface{}) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	return s.conn.WriteJSON(message)
+}
+
+// serveWebSocketStream - WebSocket으로 스트림 전송 (클라이언트가 끊으면 ctx 취소)
+func serveWebSocketStream(w http.ResponseWriter, r *http.Request, run func(ctx context.Context, sink streamSink)) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("❌ WebSocket 업그레이드 실패: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// 클라이언트 메시지는 사용하지 않지만 연결 종료 감지를 위해 읽기 루프 유지
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	run(ctx, &wsStreamSink{conn: conn})
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// sseStreamSink - Server-Sent Events로 JSON 메시지 전송
+type sseStreamSink struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	mutex   sync.Mutex
+}
+
+func (s *sseStreamSink) Send(event string, message interface{}) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return s.write(fmt.Sprintf("event: %s\ndata: %s\n\n", event, data))
+}
+
+func (s *sseStreamSink) write(payload string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, err := io.WriteString(s.w, payload); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// serveSSEStream - SSE로 스트림 전송 (요청 컨텍스트 취소 시 ctx 취소)
+func serveSSEStream(w http.ResponseWriter, r *http.Request, run func(ctx context.Context, sink streamSink)) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "스트리밍을 지원하지 않는 연결입니다", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	sink := &sseStreamSink{w: w, flusher: flusher}
+
+	// 주기적으로 주석을 보내 프록시 타임아웃 방지 및 끊긴 연결 감지
+	go func() {
+		ticker := time.NewTicker(sseHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := sink.write(": heartbeat\n\n"); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	run(ctx, sink)
+}
@@ -2,6 +2,7 @@ package terminal
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"log"
@@ -13,6 +14,8 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+
+	"mykubeapp/utils"
 )
 
 // WebSocket 업그레이더 설정
@@ -158,22 +161,27 @@ func (s *TerminalSession) runCommand(command string) {
 		return
 	}
 
-	cmd := exec.Command(parts[0], parts[1:]...)
+	// 응답 없는 명령이 세션을 붙잡지 않도록 기본 타임아웃 적용 (초과 시 프로세스 트리 종료)
+	ctx, cancel := context.WithTimeout(context.Background(), utils.DefaultCommandTimeout)
+	cmd := utils.CommandContext(ctx, parts[0], parts[1:]...)
 	cmd.Env = os.Environ()
 
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
+		cancel()
 		s.SendMessage(fmt.Sprintf("\r\n❌ 명령어 실행 실패: %v\r\n", err))
 		return
 	}
 
 	stderr, err := cmd.StderrPipe()
 	if err != nil {
+		cancel()
 		s.SendMessage(fmt.Sprintf("\r\n❌ 명령어 실행 실패: %v\r\n", err))
 		return
 	}
 
 	if err := cmd.Start(); err != nil {
+		cancel()
 		s.SendMessage(fmt.Sprintf("\r\n❌ 명령어 시작 실패: %v\r\n", err))
 		return
 	}
@@ -201,8 +209,13 @@ func (s *TerminalSession) runCommand(command string) {
 
 	// 명령어 완료 대기
 	go func() {
+		defer cancel()
 		wg.Wait()
 		cmd.Wait()
+		if ctx.Err() == context.DeadlineExceeded {
+			s.SendMessage(fmt.Sprintf("\r\n⏰ 명령어 시간 초과 (%s)\r\n", utils.DefaultCommandTimeout))
+			return
+		}
 		s.SendMessage("\r\n✅ Command completed\r")
 	}()
 }
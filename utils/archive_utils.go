@@ -0,0 +1,159 @@
+package utils
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// 업로드 아카이브 최대 크기 (압축 해제 후 전체)
+const maxExtractedSize = 200 << 20 // 200MB
+
+// ExtractArchive - 업로드된 아카이브(.zip, .tar, .tar.gz, .tgz)를 destDir에 압축 해제
+func ExtractArchive(filename string, reader io.Reader, destDir string) error {
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return fmt.Errorf("디렉토리 생성 실패: %v", err)
+	}
+
+	lower := strings.ToLower(filename)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		return extractZip(reader, destDir)
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return fmt.Errorf("gzip 해제 실패: %v", err)
+		}
+		defer gz.Close()
+		return extractTar(gz, destDir)
+	case strings.HasSuffix(lower, ".tar"):
+		return extractTar(reader, destDir)
+	}
+
+	return fmt.Errorf("지원하지 않는 아카이브 형식입니다: %s (.zip, .tar, .tar.gz, .tgz 지원)", filename)
+}
+
+// extractTar - tar 스트림 압축 해제
+func extractTar(reader io.Reader, destDir string) error {
+	tr := tar.NewReader(reader)
+	var total int64
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("tar 읽기 실패: %v", err)
+		}
+
+		target, err := safeJoin(destDir, header.Name)
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return fmt.Errorf("디렉토리 생성 실패: %v", err)
+			}
+		case tar.TypeReg:
+			total += header.Size
+			if total > maxExtractedSize {
+				return fmt.Errorf("아카이브가 너무 큽니다 (최대 %dMB)", maxExtractedSize>>20)
+			}
+			if err := writeExtractedFile(target, tr, header.Size); err != nil {
+				return err
+			}
+		default:
+			// 심볼릭 링크 등은 무시
+		}
+	}
+}
+
+// extractZip - zip 압축 해제 (zip은 임의 접근이 필요하므로 임시 파일에 저장 후 처리)
+func extractZip(reader io.Reader, destDir string) error {
+	tempFile, err := os.CreateTemp("", "upload-*.zip")
+	if err != nil {
+		return fmt.Errorf("임시 파일 생성 실패: %v", err)
+	}
+	defer os.Remove(tempFile.Name())
+	defer tempFile.Close()
+
+	size, err := io.Copy(tempFile, io.LimitReader(reader, maxExtractedSize+1))
+	if err != nil {
+		return fmt.Errorf("업로드 파일 저장 실패: %v", err)
+	}
+	if size > maxExtractedSize {
+		return fmt.Errorf("아카이브가 너무 큽니다 (최대 %dMB)", maxExtractedSize>>20)
+	}
+
+	zr, err := zip.NewReader(tempFile, size)
+	if err != nil {
+		return fmt.Errorf("zip 읽기 실패: %v", err)
+	}
+
+	var total int64
+	for _, file := range zr.File {
+		target, err := safeJoin(destDir, file.Name)
+		if err != nil {
+			return err
+		}
+
+		if file.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return fmt.Errorf("디렉토리 생성 실패: %v", err)
+			}
+			continue
+		}
+
+		total += int64(file.UncompressedSize64)
+		if total > maxExtractedSize {
+			return fmt.Errorf("아카이브가 너무 큽니다 (최대 %dMB)", maxExtractedSize>>20)
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return fmt.Errorf("zip 항목 열기 실패: %v", err)
+		}
+		err = writeExtractedFile(target, rc, int64(file.UncompressedSize64))
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeExtractedFile - 압축 해제된 파일 쓰기
+func writeExtractedFile(target string, reader io.Reader, size int64) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("디렉토리 생성 실패: %v", err)
+	}
+
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("파일 생성 실패: %v", err)
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, io.LimitReader(reader, size)); err != nil {
+		return fmt.Errorf("파일 쓰기 실패: %v", err)
+	}
+	return nil
+}
+
+// safeJoin - 아카이브 항목이 destDir 밖으로 나가지 않도록 검증 (zip slip 방지)
+func safeJoin(destDir, name string) (string, error) {
+	target := filepath.Join(destDir, name)
+	rel, err := filepath.Rel(destDir, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+		return "", fmt.Errorf("잘못된 아카이브 경로입니다: %s", name)
+	}
+	return target, nil
+}
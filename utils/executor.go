@@ -0,0 +1,29 @@
+package utils
+
+import "context"
+
+// CommandExecutor - 외부 명령(kubectl, git 등) 실행기 (서비스에 주입하여 테스트에서 가짜 실행기로 대체)
+type CommandExecutor interface {
+	// Execute - 명령 실행 후 출력 반환 (ExecuteCommand와 동일한 의미)
+	Execute(ctx context.Context, name string, args ...string) (string, error)
+	// ExecuteSensitive - 인자/출력을 로그에 남기지 않고 실행 (ExecuteSensitiveCommand와 동일한 의미)
+	ExecuteSensitive(ctx context.Context, name string, args ...string) (string, error)
+}
+
+// SystemExecutor - 실제 프로세스를 실행하는 기본 실행기
+type SystemExecutor struct{}
+
+// NewSystemExecutor - 기본 실행기 생성자
+func NewSystemExecutor() CommandExecutor {
+	return SystemExecutor{}
+}
+
+// Execute - ExecuteCommand로 실행
+func (SystemExecutor) Execute(ctx context.Context, name string, args ...string) (string, error) {
+	return ExecuteCommand(ctx, name, args...)
+}
+
+// ExecuteSensitive - ExecuteSensitiveCommand로 실행
+func (SystemExecutor) ExecuteSensitive(ctx context.Context, name string, args ...string) (string, error) {
+	return ExecuteSensitiveCommand(ctx, name, args...)
+}
@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"gopkg.in/yaml.v2"
 	"io/ioutil"
@@ -9,6 +12,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 // FileExists - 파일 존재 여부 확인
@@ -38,17 +42,84 @@ func WriteFile(filename, content string) error {
 	return ioutil.WriteFile(filename, []byte(content), 0644)
 }
 
-// ExecuteCommand - 외부 명령어 실행
-func ExecuteCommand(name string, args ...string) (string, error) {
+// 외부 명령 실행 제한 시간 (작업별로 ctx에 더 짧거나 긴 제한을 지정할 수 있음)
+const (
+	DefaultCommandTimeout = 2 * time.Minute  // ctx에 제한 시간이 없을 때 기본값
+	ConfigCommandTimeout  = 15 * time.Second // kubectl config 등 로컬 kubeconfig 작업
+	QueryCommandTimeout   = 45 * time.Second // 클러스터 조회 (get, api-resources, rollout history 등)
+	ApplyCommandTimeout   = 3 * time.Minute  // 적용/삭제/롤아웃 변경
+	GitCommandTimeout     = 3 * time.Minute  // git clone
+	HelmCommandTimeout    = 10 * time.Minute // helm install/upgrade (--wait 포함)
+	processWaitDelay      = 5 * time.Second  // 종료 신호 후 출력 파이프를 기다리는 최대 시간
+)
+
+// CommandTimeoutError - 외부 명령이 제한 시간 안에 끝나지 않아 강제 종료됨
+type CommandTimeoutError struct {
+	Command string
+	Timeout time.Duration
+	Output  string
+}
+
+func (e *CommandTimeoutError) Error() string {
+	if e.Timeout > 0 {
+		return fmt.Sprintf("명령어 실행 시간 초과 (%s): %s", e.Timeout, e.Command)
+	}
+	return fmt.Sprintf("명령어 실행 시간 초과: %s", e.Command)
+}
+
+// IsCommandTimeout - 외부 명령 시간 초과 오류 여부
+func IsCommandTimeout(err error) bool {
+	var timeoutErr *CommandTimeoutError
+	return errors.As(err, &timeoutErr)
+}
+
+// CommandContext - ctx 취소 시 프로세스 트리 전체를 종료하는 exec.Cmd 생성
+func CommandContext(ctx context.Context, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	configureProcessTree(cmd)
+	cmd.WaitDelay = processWaitDelay
+	return cmd
+}
+
+// withCommandTimeout - ctx에 제한 시간이 없으면 기본 제한 시간 적용
+func withCommandTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, DefaultCommandTimeout)
+}
+
+// commandError - 실행 실패 원인 구분 (제한 시간 초과, 요청 취소, 명령 실패)
+func commandError(ctx context.Context, started time.Time, name string, args []string, err error, output string) error {
+	command := strings.TrimSpace(name + " " + strings.Join(args, " "))
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		timeout := time.Duration(0)
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout = deadline.Sub(started).Round(time.Second)
+		}
+		return &CommandTimeoutError{Command: command, Timeout: timeout, Output: output}
+	case context.Canceled:
+		return fmt.Errorf("명령어 실행 취소: %w", context.Canceled)
+	}
+	return fmt.Errorf("명령어 실행 실패: %v, 출력: %s", err, output)
+}
+
+// ExecuteCommand - 외부 명령어 실행 (ctx 취소 또는 제한 시간 초과 시 프로세스 트리 종료)
+func ExecuteCommand(ctx context.Context, name string, args ...string) (string, error) {
 	log.Printf("🔧 명령어 실행: %s %s", name, strings.Join(args, " "))
 
-	cmd := exec.Command(name, args...)
+	ctx, cancel := withCommandTimeout(ctx)
+	defer cancel()
+
+	started := time.Now()
+	cmd := CommandContext(ctx, name, args...)
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
 		log.Printf("❌ 명령어 실행 실패: %v", err)
 		log.Printf("📄 출력: %s", string(output))
-		return "", fmt.Errorf("명령어 실행 실패: %v, 출력: %s", err, string(output))
+		return "", commandError(ctx, started, name, args, err, string(output))
 	}
 
 	result := string(output)
@@ -58,6 +129,32 @@ func ExecuteCommand(name string, args ...string) (string, error) {
 	return result, nil
 }
 
+// ExecuteSensitiveCommand - 토큰 등 민감 정보가 인자나 출력에 포함된 명령 실행 (인자/출력을 로그에 남기지 않음)
+func ExecuteSensitiveCommand(ctx context.Context, name string, args ...string) (string, error) {
+	subcommand := ""
+	if len(args) > 0 {
+		subcommand = args[0]
+	}
+	log.Printf("🔧 명령어 실행: %s %s ... (민감 정보 생략)", name, subcommand)
+
+	ctx, cancel := withCommandTimeout(ctx)
+	defer cancel()
+
+	started := time.Now()
+	cmd := CommandContext(ctx, name, args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		log.Printf("❌ 명령어 실행 실패: %v", err)
+		return "", commandError(ctx, started, name, []string{subcommand}, err, stderr.String())
+	}
+
+	log.Printf("✅ 명령어 실행 성공")
+	return stdout.String(), nil
+}
+
 // IsKubectlAvailable - kubectl 명령어 사용 가능 여부 확인
 func IsKubectlAvailable() bool {
 	_, err := exec.LookPath("kubectl")
@@ -69,20 +166,27 @@ func GetHomeDir() (string, error) {
 	return os.UserHomeDir()
 }
 
-// GetKubeConfigPath - kube config 파일 경로 반환
-func GetKubeConfigPath() (string, error) {
-	// 환경변수 KUBECONFIG 확인
-	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
-		return kubeconfig, nil
+// GetKubeConfigPaths - kube config 파일 경로 목록 반환
+// KUBECONFIG는 kubectl처럼 경로 구분자(유닉스 ':', 윈도우 ';')로 여러 파일을 지정할 수 있음
+func GetKubeConfigPaths() ([]string, error) {
+	// 환경변수 KUBECONFIG 확인 (빈 항목은 무시)
+	var paths []string
+	for _, path := range filepath.SplitList(os.Getenv("KUBECONFIG")) {
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) > 0 {
+		return paths, nil
 	}
 
 	// 기본 경로 ($HOME/.kube/config)
 	homeDir, err := GetHomeDir()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return filepath.Join(homeDir, ".kube", "config"), nil
+	return []string{filepath.Join(homeDir, ".kube", "config")}, nil
 }
 
 // BackupFile - 파일 백업
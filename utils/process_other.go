@@ -0,0 +1,8 @@
+//go:build !unix
+
+package utils
+
+import "os/exec"
+
+// configureProcessTree - 프로세스 그룹을 지원하지 않는 플랫폼에서는 명령 프로세스만 종료
+func configureProcessTree(cmd *exec.Cmd) {}
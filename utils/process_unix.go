@@ -0,0 +1,17 @@
+//go:build unix
+
+package utils
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// configureProcessTree - 명령을 새 프로세스 그룹으로 실행하고 취소 시 그룹 전체 종료
+// (kubectl exec credential 플러그인, git credential helper 등 자식 프로세스까지 정리)
+func configureProcessTree(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
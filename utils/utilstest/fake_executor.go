@@ -0,0 +1,139 @@
+// Package utilstest - 테스트에서만 사용하는 명령 실행 보조 도구 (운영 코드에서는 import하지 않음)
+package utilstest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// FakeCommandCall - 가짜 실행기가 기록한 명령 호출
+type FakeCommandCall struct {
+	Name      string   // 실행 파일 (kubectl, git 등)
+	Args      []string // 인자
+	Sensitive bool     // ExecuteSensitive로 호출되었는지 여부
+}
+
+// Command - 호출을 한 줄 명령 문자열로 표현
+func (c FakeCommandCall) Command() string {
+	return strings.TrimSpace(c.Name + " " + strings.Join(c.Args, " "))
+}
+
+// fakeCommandRule - 명령 접두어별 응답 규칙
+type fakeCommandRule struct {
+	prefix  []string
+	handler func(call FakeCommandCall) (string, error)
+}
+
+// FakeExecutor - 스크립트된 출력을 돌려주고 호출을 기록하는 테스트용 실행기
+//
+// 규칙은 "kubectl config current-context"처럼 명령 접두어로 등록하며,
+// 여러 규칙이 일치하면 가장 긴 접두어가, 길이가 같으면 나중에 등록한 규칙이 사용됩니다.
+// 일치하는 규칙이 없는 명령은 오류를 반환하므로 예상하지 못한 호출이 드러납니다.
+type FakeExecutor struct {
+	mutex sync.Mutex
+	rules []fakeCommandRule
+	calls []FakeCommandCall
+}
+
+// NewFakeExecutor - 가짜 실행기 생성자
+func NewFakeExecutor() *FakeExecutor {
+	return &FakeExecutor{}
+}
+
+// On - 명령 접두어에 대한 성공 출력 등록
+func (f *FakeExecutor) On(command, output string) *FakeExecutor {
+	return f.OnFunc(command, func(FakeCommandCall) (string, error) {
+		return output, nil
+	})
+}
+
+// OnError - 명령 접두어에 대한 실패 등록
+func (f *FakeExecutor) OnError(command string, err error) *FakeExecutor {
+	return f.OnFunc(command, func(FakeCommandCall) (string, error) {
+		return "", err
+	})
+}
+
+// OnFunc - 명령 접두어에 대한 응답 함수 등록 (임시 파일 내용 확인 등 호출 시점 검사용)
+func (f *FakeExecutor) OnFunc(command string, handler func(call FakeCommandCall) (string, error)) *FakeExecutor {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.rules = append(f.rules, fakeCommandRule{prefix: strings.Fields(command), handler: handler})
+	return f
+}
+
+// Execute - 등록된 규칙으로 응답
+func (f *FakeExecutor) Execute(ctx context.Context, name string, args ...string) (string, error) {
+	return f.run(ctx, FakeCommandCall{Name: name, Args: args})
+}
+
+// ExecuteSensitive - 등록된 규칙으로 응답 (호출에 Sensitive 표시)
+func (f *FakeExecutor) ExecuteSensitive(ctx context.Context, name string, args ...string) (string, error) {
+	return f.run(ctx, FakeCommandCall{Name: name, Args: args, Sensitive: true})
+}
+
+// run - 호출 기록 후 규칙 선택 및 실행 (규칙 함수는 잠금 밖에서 실행)
+func (f *FakeExecutor) run(ctx context.Context, call FakeCommandCall) (string, error) {
+	call.Args = append([]string(nil), call.Args...)
+
+	f.mutex.Lock()
+	f.calls = append(f.calls, call)
+	rule := f.match(call)
+	f.mutex.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("명령어 실행 취소: %w", err)
+	}
+	if rule == nil {
+		return "", fmt.Errorf("등록되지 않은 명령입니다: %s", call.Command())
+	}
+	return rule.handler(call)
+}
+
+// match - 호출과 일치하는 가장 구체적인 규칙
+func (f *FakeExecutor) match(call FakeCommandCall) *fakeCommandRule {
+	var best *fakeCommandRule
+	for i := range f.rules {
+		rule := &f.rules[i]
+		if hasCommandPrefix(call, rule.prefix) && (best == nil || len(rule.prefix) >= len(best.prefix)) {
+			best = rule
+		}
+	}
+	return best
+}
+
+// hasCommandPrefix - 호출(실행 파일 + 인자)이 토큰 접두어로 시작하는지 확인
+func hasCommandPrefix(call FakeCommandCall, prefix []string) bool {
+	tokens := append([]string{call.Name}, call.Args...)
+	if len(prefix) > len(tokens) {
+		return false
+	}
+	for i, token := range prefix {
+		if tokens[i] != token {
+			return false
+		}
+	}
+	return true
+}
+
+// Calls - 기록된 전체 호출 (호출 순서)
+func (f *FakeExecutor) Calls() []FakeCommandCall {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]FakeCommandCall(nil), f.calls...)
+}
+
+// CallsTo - 명령 접두어와 일치하는 호출만 반환
+func (f *FakeExecutor) CallsTo(command string) []FakeCommandCall {
+	prefix := strings.Fields(command)
+
+	var result []FakeCommandCall
+	for _, call := range f.Calls() {
+		if hasCommandPrefix(call, prefix) {
+			result = append(result, call)
+		}
+	}
+	return result
+}
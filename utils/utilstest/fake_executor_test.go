@@ -0,0 +1,65 @@
+package utilstest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFakeExecutorPrefersLongestPrefix(t *testing.T) {
+	executor := NewFakeExecutor().
+		On("kubectl", "generic").
+		On("kubectl config current-context", "dev").
+		On("kubectl config", "config")
+
+	cases := map[string][]string{
+		"generic": {"get", "pods"},
+		"dev":     {"config", "current-context"},
+		"config":  {"config", "get-contexts"},
+	}
+	for expected, args := range cases {
+		output, err := executor.Execute(context.Background(), "kubectl", args...)
+		if err != nil || output != expected {
+			t.Fatalf("kubectl %v = (%q, %v), 기대값 %q", args, output, err, expected)
+		}
+	}
+}
+
+func TestFakeExecutorLaterRuleOverrides(t *testing.T) {
+	failure := errors.New("boom")
+	executor := NewFakeExecutor().On("git clone", "ok").OnError("git clone", failure)
+
+	if _, err := executor.Execute(context.Background(), "git", "clone", "repo"); !errors.Is(err, failure) {
+		t.Fatalf("나중에 등록한 규칙이 사용되어야 합니다: %v", err)
+	}
+}
+
+func TestFakeExecutorRecordsCalls(t *testing.T) {
+	executor := NewFakeExecutor().On("kubectl", "")
+
+	executor.Execute(context.Background(), "kubectl", "apply", "-f", "a.yaml")
+	executor.ExecuteSensitive(context.Background(), "kubectl", "create", "token", "sa")
+
+	calls := executor.Calls()
+	if len(calls) != 2 || calls[0].Command() != "kubectl apply -f a.yaml" || calls[0].Sensitive || !calls[1].Sensitive {
+		t.Fatalf("호출 기록이 올바르지 않습니다: %+v", calls)
+	}
+	if token := executor.CallsTo("kubectl create token"); len(token) != 1 {
+		t.Fatalf("CallsTo 결과 = %d, 기대값 1", len(token))
+	}
+}
+
+func TestFakeExecutorRejectsUnknownAndCancelled(t *testing.T) {
+	executor := NewFakeExecutor().On("kubectl get", "pods")
+
+	if _, err := executor.Execute(context.Background(), "helm", "list"); err == nil || !strings.Contains(err.Error(), "helm list") {
+		t.Fatalf("등록되지 않은 명령은 실패해야 합니다: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := executor.Execute(ctx, "kubectl", "get", "pods"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("취소된 ctx는 실패해야 합니다: %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ParseYamlDocuments - 멀티 도큐먼트 YAML(--- 구분)을 맵 목록으로 파싱
+func ParseYamlDocuments(yamlContent string) ([]map[string]interface{}, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(yamlContent))
+
+	var documents []map[string]interface{}
+	for {
+		var raw interface{}
+		err := decoder.Decode(&raw)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("YAML 파싱 실패 (도큐먼트 %d): %v", len(documents), err)
+		}
+
+		// 빈 도큐먼트 스킵
+		if raw == nil {
+			continue
+		}
+
+		document, ok := NormalizeYamlValue(raw).(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("도큐먼트 %d가 객체 형식이 아닙니다", len(documents))
+		}
+		documents = append(documents, document)
+	}
+
+	return documents, nil
+}
+
+// NormalizeYamlValue - yaml.v2의 map[interface{}]interface{}를 map[string]interface{}로 변환 (JSON 직렬화 가능하도록)
+func NormalizeYamlValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[fmt.Sprintf("%v", key)] = NormalizeYamlValue(item)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = NormalizeYamlValue(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = NormalizeYamlValue(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// MarshalYamlDocuments - 맵 목록을 멀티 도큐먼트 YAML 문자열로 직렬화
+func MarshalYamlDocuments(documents []map[string]interface{}) (string, error) {
+	var parts []string
+	for i, document := range documents {
+		data, err := yaml.Marshal(document)
+		if err != nil {
+			return "", fmt.Errorf("YAML 직렬화 실패 (도큐먼트 %d): %v", i, err)
+		}
+		parts = append(parts, strings.TrimSpace(string(data)))
+	}
+	return strings.Join(parts, "\n---\n") + "\n", nil
+}
+
+// GetNestedValue - 점(.) 경로 대신 키 목록으로 중첩 맵 값 조회
+func GetNestedValue(object map[string]interface{}, keys ...string) (interface{}, bool) {
+	var current interface{} = object
+	for _, key := range keys {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// GetNestedMap - 중첩 맵 조회 (없으면 nil)
+func GetNestedMap(object map[string]interface{}, keys ...string) map[string]interface{} {
+	value, ok := GetNestedValue(object, keys...)
+	if !ok {
+		return nil
+	}
+	m, _ := value.(map[string]interface{})
+	return m
+}
+
+// GetNestedString - 중첩 문자열 조회 (없으면 빈 문자열)
+func GetNestedString(object map[string]interface{}, keys ...string) string {
+	value, ok := GetNestedValue(object, keys...)
+	if !ok || value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// GetNestedSlice - 중첩 배열 조회 (없으면 nil)
+func GetNestedSlice(object map[string]interface{}, keys ...string) []interface{} {
+	value, ok := GetNestedValue(object, keys...)
+	if !ok {
+		return nil
+	}
+	s, _ := value.([]interface{})
+	return s
+}